@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"shylock/internal/cache"
+	"shylock/internal/errors"
+)
+
+var (
+	// Cache flags (shared by the cache subcommands and estimate)
+	cacheDir string
+
+	// Cache command
+	cacheCmd = &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the on-disk pricing cache",
+		Long: `Manage the on-disk pricing cache shared by estimate runs. Pricing API
+responses are stored under the user cache directory so repeated estimates
+do not pay the full API latency again.`,
+		Example: `  # Show cache statistics
+  shylock cache stats
+
+  # Remove expired entries and enforce size limits
+  shylock cache prune
+
+  # Remove all cached pricing data
+  shylock cache clear`,
+	}
+
+	cacheStatsCmd = &cobra.Command{
+		Use:   "stats",
+		Short: "Show pricing cache statistics",
+		Args:  cobra.NoArgs,
+		RunE:  runCacheStats,
+	}
+
+	cacheClearCmd = &cobra.Command{
+		Use:   "clear",
+		Short: "Remove all cached pricing data",
+		Args:  cobra.NoArgs,
+		RunE:  runCacheClear,
+	}
+
+	cachePruneCmd = &cobra.Command{
+		Use:   "prune",
+		Short: "Remove expired entries and enforce cache size limits",
+		Args:  cobra.NoArgs,
+		RunE:  runCachePrune,
+	}
+)
+
+func init() {
+	cacheCmd.PersistentFlags().StringVar(&cacheDir, "cache-dir", "", "Pricing cache directory (defaults to the user cache directory)")
+
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+// runCacheStats handles the cache stats command
+func runCacheStats(cmd *cobra.Command, args []string) error {
+	diskCache, err := openDiskCache(0)
+	if err != nil {
+		return err
+	}
+
+	stats := diskCache.Stats()
+
+	if outputFormat == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]interface{}{
+			"directory":      diskCache.Dir(),
+			"totalEntries":   stats.TotalEntries,
+			"expiredEntries": stats.ExpiredEntries,
+			"sizeBytes":      stats.SizeBytes,
+			"maxEntries":     stats.MaxEntries,
+			"maxSizeBytes":   stats.MaxSizeBytes,
+			"ttl":            stats.TTL.String(),
+		})
+	}
+
+	fmt.Println("Pricing Cache Statistics")
+	fmt.Println("========================")
+	fmt.Printf("Directory:       %s\n", diskCache.Dir())
+	fmt.Printf("Entries:         %d / %d\n", stats.TotalEntries, stats.MaxEntries)
+	fmt.Printf("Expired Entries: %d\n", stats.ExpiredEntries)
+	fmt.Printf("Size:            %s / %s\n", formatBytes(stats.SizeBytes), formatBytes(stats.MaxSizeBytes))
+	fmt.Printf("TTL:             %s\n", stats.TTL)
+
+	return nil
+}
+
+// runCacheClear handles the cache clear command
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	diskCache, err := openDiskCache(0)
+	if err != nil {
+		return err
+	}
+
+	removed := diskCache.Clear()
+	fmt.Printf("🗑️  Removed %d cache entries from %s\n", removed, diskCache.Dir())
+	return nil
+}
+
+// runCachePrune handles the cache prune command
+func runCachePrune(cmd *cobra.Command, args []string) error {
+	diskCache, err := openDiskCache(0)
+	if err != nil {
+		return err
+	}
+
+	removed := diskCache.Prune()
+	fmt.Printf("🧹 Pruned %d cache entries from %s\n", removed, diskCache.Dir())
+	return nil
+}
+
+// openDiskCache opens the pricing cache in --cache-dir or the default directory.
+// A zero TTL uses the cache's default entry lifetime.
+func openDiskCache(ttl time.Duration) (*cache.DiskCache, error) {
+	diskCache, err := cache.NewDiskCache(&cache.DiskCacheConfig{Dir: cacheDir, TTL: ttl})
+	if err != nil {
+		return nil, errors.WrapError(err, "", "failed to open pricing cache").
+			WithContext("cacheDir", cacheDir)
+	}
+	return diskCache, nil
+}
+
+// formatBytes formats a byte count in human-readable units
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"shylock/internal/cache"
+	"shylock/internal/interfaces"
+)
+
+func TestCacheSubcommands(t *testing.T) {
+	expectedCommands := []string{"stats", "clear", "prune"}
+
+	for _, cmdName := range expectedCommands {
+		t.Run("command_"+cmdName, func(t *testing.T) {
+			cmd, _, err := rootCmd.Find([]string{"cache", cmdName})
+			if err != nil {
+				t.Errorf("command 'cache %s' not found: %v", cmdName, err)
+			}
+			if cmd.Name() != cmdName {
+				t.Errorf("expected command name '%s', got '%s'", cmdName, cmd.Name())
+			}
+		})
+	}
+
+	if flag := cacheCmd.PersistentFlags().Lookup("cache-dir"); flag == nil {
+		t.Error("flag 'cache-dir' not found")
+	}
+}
+
+func TestCacheCommands(t *testing.T) {
+	defer func() { cacheDir = "" }()
+	cacheDir = t.TempDir()
+
+	diskCache, err := cache.NewDiskCache(&cache.DiskCacheConfig{Dir: cacheDir})
+	if err != nil {
+		t.Fatalf("failed to create disk cache: %v", err)
+	}
+	diskCache.Set("AmazonEC2", map[string]string{"instanceType": "t3.micro"}, []interfaces.PricingProduct{{SKU: "TEST123"}})
+	diskCache.Set("AmazonS3", nil, []interfaces.PricingProduct{{SKU: "TEST456"}})
+
+	// Silence command output
+	oldStdout := os.Stdout
+	devNull, _ := os.Open(os.DevNull)
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = oldStdout
+		devNull.Close()
+	}()
+
+	if err := runCacheStats(cacheStatsCmd, nil); err != nil {
+		t.Errorf("unexpected stats error: %v", err)
+	}
+
+	if err := runCachePrune(cachePruneCmd, nil); err != nil {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+	if stats := diskCache.Stats(); stats.TotalEntries != 2 {
+		t.Errorf("expected prune to keep 2 fresh entries, got %d", stats.TotalEntries)
+	}
+
+	if err := runCacheClear(cacheClearCmd, nil); err != nil {
+		t.Errorf("unexpected clear error: %v", err)
+	}
+	if stats := diskCache.Stats(); stats.TotalEntries != 0 {
+		t.Errorf("expected empty cache after clear, got %d entries", stats.TotalEntries)
+	}
+}
+
+func TestCachePruneKeepsLongTTLEntries(t *testing.T) {
+	defer func() { cacheDir = "" }()
+	cacheDir = t.TempDir()
+
+	// Written by estimate --cache-ttl 168h
+	diskCache, err := cache.NewDiskCache(&cache.DiskCacheConfig{Dir: cacheDir, TTL: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("failed to create disk cache: %v", err)
+	}
+	diskCache.Set("AmazonEC2", nil, []interfaces.PricingProduct{{SKU: "TEST123"}})
+
+	// Age the entry by three days, past the 24h default TTL of the cache subcommands
+	paths, _ := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+	if len(paths) != 1 {
+		t.Fatalf("expected 1 entry file, got %v", paths)
+	}
+	data, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatalf("failed to read entry: %v", err)
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		t.Fatalf("failed to decode entry: %v", err)
+	}
+	for _, field := range []string{"timestamp", "expiresAt"} {
+		when, err := time.Parse(time.RFC3339Nano, entry[field].(string))
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", field, err)
+		}
+		entry[field] = when.Add(-72 * time.Hour)
+	}
+	data, _ = json.Marshal(entry)
+	if err := os.WriteFile(paths[0], data, 0644); err != nil {
+		t.Fatalf("failed to write entry: %v", err)
+	}
+
+	// Silence command output
+	oldStdout := os.Stdout
+	devNull, _ := os.Open(os.DevNull)
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = oldStdout
+		devNull.Close()
+	}()
+
+	if err := runCachePrune(cachePruneCmd, nil); err != nil {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+	if stats := diskCache.Stats(); stats.TotalEntries != 1 || stats.ExpiredEntries != 0 {
+		t.Errorf("expected prune to keep the 7-day entry, got %+v", stats)
+	}
+}
+
+func TestWithDiskCache(t *testing.T) {
+	defer func() {
+		cacheDir = ""
+		priceListPath = ""
+		noCache = false
+	}()
+	cacheDir = t.TempDir()
+
+	client := &MockAWSClient{}
+
+	wrapped, diskCache := withDiskCache(client)
+	if _, ok := wrapped.(*cache.CachedAWSClient); !ok {
+		t.Error("expected client to be wrapped with the pricing cache")
+	}
+	if diskCache == nil || diskCache.Dir() != cacheDir {
+		t.Errorf("expected disk cache in %s, got %v", cacheDir, diskCache)
+	}
+
+	noCache = true
+	if wrapped, diskCache := withDiskCache(client); wrapped != client || diskCache != nil {
+		t.Error("expected --no-cache to disable the pricing cache")
+	}
+
+	noCache = false
+	priceListPath = "offers"
+	if wrapped, diskCache := withDiskCache(client); wrapped != client || diskCache != nil {
+		t.Error("expected offline price list client not to be cached")
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{2048, "2.0 KB"},
+		{256 * 1024 * 1024, "256.0 MB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := formatBytes(tt.input); result != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
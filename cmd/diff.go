@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"shylock/internal/diff"
+	"shylock/internal/errors"
+	"shylock/internal/models"
+)
+
+// Diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff [old-config] [new-config]",
+	Short: "Compare the estimated costs of two configurations",
+	Long: `Estimate two configuration files and report the cost difference per resource
+and in total. Resources are matched by name and reported as added, removed,
+changed or unchanged. The markdown output format is meant for pull request
+comments.`,
+	Example: `  # Compare two versions of a configuration
+  shylock diff old.json new.json
+
+  # Render a pull request comment
+  shylock diff old.json new.json --output markdown
+
+  # Compare the configuration on the main branch with the working copy
+  git show main:infra/costs.json > /tmp/costs-main.json
+  shylock diff /tmp/costs-main.json infra/costs.json -o markdown`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	addPricingFlags(diffCmd)
+	rootCmd.AddCommand(diffCmd)
+}
+
+// runDiff handles the diff command
+func runDiff(cmd *cobra.Command, args []string) error {
+	if err := validateOutputFormat(diff.Formats); err != nil {
+		return err
+	}
+
+	configs := make([]*models.EstimationConfig, len(args))
+	for i, configFile := range args {
+		cfg, err := loadConfigFile(configFile)
+		if err != nil {
+			return err
+		}
+		applyConfigOverrides(cfg)
+		configs[i] = cfg
+	}
+
+	// One session prices both configurations so shared resources hit the cache
+	ctx := context.Background()
+	session, err := newEstimationSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	results := make([]*models.EstimationResult, len(configs))
+	for i, cfg := range configs {
+		results[i], err = session.estimate(ctx, cfg)
+		if err != nil {
+			return errors.WrapError(err, "", "failed to estimate configuration").
+				WithContext("configFile", args[i])
+		}
+	}
+
+	if err := session.finish(); err != nil {
+		return err
+	}
+
+	result, err := diff.Compare(results[0], results[1])
+	if err != nil {
+		return err
+	}
+
+	if verbose {
+		fmt.Printf("🔀 Compared %d resources\n\n", len(result.Resources))
+	}
+
+	return diff.Write(os.Stdout, result, outputFormat)
+}
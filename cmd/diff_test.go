@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"shylock/internal/errors"
+)
+
+func TestDiffCommand(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"diff"})
+	if err != nil || cmd.Name() != "diff" {
+		t.Fatalf("command 'diff' not found: %v", err)
+	}
+
+	if err := cmd.Args(cmd, []string{"old.json"}); err == nil {
+		t.Error("expected error for a single configuration file")
+	}
+
+	expectedFlags := []string{"price-list", "cache-dir", "concurrency", "batch-size", "cache-ttl", "timeout", "no-cache", "record-pricing", "replay-pricing", "spot-price-history", "exchange-rates"}
+	for _, flagName := range expectedFlags {
+		if flag := diffCmd.Flags().Lookup(flagName); flag == nil {
+			t.Errorf("flag '%s' not found", flagName)
+		}
+	}
+}
+
+func TestRunDiffErrors(t *testing.T) {
+	defer func() { outputFormat = "table" }()
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	config := `{"version": "1.0", "resources": [{"type": "S3", "name": "bucket", "region": "us-east-1", "properties": {"storageClass": "STANDARD"}}]}`
+	if err := os.WriteFile(configFile, []byte(config), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		args      []string
+		errorType errors.ErrorType
+	}{
+		{
+			name:      "unsupported output format",
+			format:    "yaml",
+			args:      []string{configFile, configFile},
+			errorType: errors.ValidationErrorType,
+		},
+		{
+			name:      "missing new configuration",
+			format:    "markdown",
+			args:      []string{configFile, filepath.Join(dir, "missing.json")},
+			errorType: errors.FileErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFormat = tt.format
+			if err := runDiff(diffCmd, tt.args); !errors.IsErrorType(err, tt.errorType) {
+				t.Errorf("expected %s error, got %v", tt.errorType, err)
+			}
+		})
+	}
+}
+
+func TestRunDiffFailedResource(t *testing.T) {
+	defer func() {
+		outputFormat = "table"
+		replayPricing = ""
+	}()
+
+	// Only us-east-1 EKS pricing is recorded, so the new configuration's
+	// us-west-2 cluster cannot be priced
+	dir := t.TempDir()
+	replayPricing = filepath.Join(dir, "fixture.json")
+	fixture := `{"version": 1, "interactions": [{"serviceCode": "AmazonEKS",
+		"filters": {"servicecode": "AmazonEKS", "location": "US East (N. Virginia)"},
+		"products": [{"sku": "EKS", "attributes": {"usageType": "USE1-AmazonEKS-Hours:perCluster"},
+			"terms": {"OnDemand": {"EKS.JRTCKXETXF": {"priceDimensions": {"EKS.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "pricePerUnit": {"USD": "0.10"}}}}}}}]}]}`
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+		return path
+	}
+	writeFile("fixture.json", fixture)
+	oldConfig := writeFile("old.json", `{"version": "1.0", "resources": [
+		{"type": "EKS", "name": "platform", "region": "us-east-1", "properties": {"clusters": 1}},
+		{"type": "EKS", "name": "batch", "region": "us-east-1", "properties": {"clusters": 1}}]}`)
+	newConfig := writeFile("new.json", `{"version": "1.0", "resources": [
+		{"type": "EKS", "name": "platform", "region": "us-east-1", "properties": {"clusters": 1}},
+		{"type": "EKS", "name": "batch", "region": "us-west-2", "properties": {"clusters": 1}}]}`)
+
+	outputFormat = "markdown"
+	if err := runDiff(diffCmd, []string{oldConfig, oldConfig}); err != nil {
+		t.Fatalf("unexpected error for a configuration that prices: %v", err)
+	}
+
+	// A resource that fails to price must not be reported as removed
+	err := runDiff(diffCmd, []string{oldConfig, newConfig})
+	if err == nil {
+		t.Fatal("expected error for a resource that cannot be priced")
+	}
+	for _, expected := range []string{newConfig, "batch"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got %v", expected, err)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"shylock/internal/models"
+	"shylock/internal/money"
+	"shylock/internal/output"
 )
 
 // outputTable formats results as a human-readable table
@@ -16,17 +18,35 @@ func outputTable(result *models.EstimationResult) error {
 	fmt.Println("AWS Cost Estimation Results")
 	fmt.Println("===========================")
 	fmt.Printf("Generated: %s\n", result.GeneratedAt.Format("2006-01-02 15:04:05 MST"))
-	fmt.Printf("Currency: %s\n\n", result.Currency)
+	fmt.Printf("Currency: %s\n\n", formatCurrency(result))
 
 	// Summary section
 	fmt.Println("💰 Cost Summary")
 	fmt.Println("---------------")
-	fmt.Printf("Hourly Cost:  $%.4f\n", result.TotalHourlyCost)
-	fmt.Printf("Daily Cost:   $%.4f\n", result.TotalDailyCost)
-	fmt.Printf("Monthly Cost: $%.4f\n\n", result.TotalMonthlyCost)
+	emphasized := result.EmphasizedTimeFrame()
+	for _, timeFrame := range models.TimeFrames {
+		line := fmt.Sprintf("%-13s %s", formatTimeFrame(timeFrame)+" Cost:", money.Format(result.TotalCost(timeFrame), result.Currency))
+		if timeFrame == models.TimeFrameMonthly && result.Month != "" {
+			line += fmt.Sprintf(" (%s, %.0f hours)", result.Month, result.HoursPerMonth)
+		}
+		if timeFrame == emphasized {
+			line += "  ◀"
+		}
+		fmt.Println(line)
+	}
+	if result.TotalUpfrontCost.Sign() > 0 {
+		fmt.Printf("Upfront Cost: %s (one-time, amortized into the costs above)\n", money.Format(result.TotalUpfrontCost, result.Currency))
+	}
+	if result.FreeTierCredit.Sign() > 0 {
+		fmt.Printf("Free Tier:    -%s/month (already subtracted from the costs above)\n", money.Format(result.FreeTierCredit, result.Currency))
+	}
+	fmt.Println()
 
 	if len(result.ResourceCosts) == 0 {
 		fmt.Println("No resources found in estimation.")
+		outputSkippedTable(result.Skipped)
+		outputSavingsPlansTable(result.SavingsPlans, result.Currency)
+		outputBudgetViolationsTable(result.BudgetViolations, result.Currency)
 		return nil
 	}
 
@@ -57,26 +77,29 @@ func outputTable(result *models.EstimationResult) error {
 	maxRegionWidth += 2
 
 	// Print header
-	headerFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%12s %%12s %%12s\n",
+	headerFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%12s %%12s %%12s %%14s\n",
 		maxNameWidth, maxTypeWidth, maxRegionWidth)
-	fmt.Printf(headerFormat, "Resource Name", "Type", "Region", "Hourly", "Daily", "Monthly")
+	fmt.Printf(headerFormat, "Resource Name", "Type", "Region", "Hourly", "Daily", "Monthly", "Annual")
 
 	// Print separator
-	separator := strings.Repeat("-", maxNameWidth+maxTypeWidth+maxRegionWidth+36+6)
+	separator := strings.Repeat("-", maxNameWidth+maxTypeWidth+maxRegionWidth+50+7)
 	fmt.Println(separator)
 
 	// Print resource rows
-	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds $%%10.4f $%%10.4f $%%10.4f\n",
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%11s %%11s %%11s %%13s\n",
 		maxNameWidth, maxTypeWidth, maxRegionWidth)
 
-	for _, cost := range result.ResourceCosts {
+	// Round each column so the rows add up to the summary
+	columns := output.RoundColumns(result.ResourceCosts, money.Decimals(result.Currency))
+	for i, cost := range result.ResourceCosts {
 		fmt.Printf(rowFormat,
 			cost.ResourceName,
 			cost.ResourceType,
 			cost.Region,
-			cost.HourlyCost,
-			cost.DailyCost,
-			cost.MonthlyCost)
+			money.Format(columns[0][i], result.Currency),
+			money.Format(columns[1][i], result.Currency),
+			money.Format(columns[2][i], result.Currency),
+			money.Format(columns[3][i], result.Currency))
 	}
 
 	// Show detailed information if verbose
@@ -107,9 +130,70 @@ func outputTable(result *models.EstimationResult) error {
 		}
 	}
 
+	outputSkippedTable(result.Skipped)
+	outputSavingsPlansTable(result.SavingsPlans, result.Currency)
+	outputBudgetViolationsTable(result.BudgetViolations, result.Currency)
+
 	return nil
 }
 
+// outputSkippedTable lists imported resources that were not estimated
+func outputSkippedTable(skipped []models.SkippedResource) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	fmt.Printf("\n⏭️  Skipped Resources (%d)\n", len(skipped))
+	fmt.Println("-----------------------")
+	for _, resource := range skipped {
+		fmt.Printf("• %s (%s): %s\n", resource.Name, resource.Type, resource.Reason)
+	}
+}
+
+// outputSavingsPlansTable shows how the Savings Plan commitment was applied
+func outputSavingsPlansTable(coverage *models.SavingsPlansCoverage, currency string) {
+	if coverage == nil {
+		return
+	}
+
+	fmt.Printf("\n💵 %s Savings Plan (%s/hour commitment)\n", coverage.Type, money.Format(coverage.HourlyCommitment, currency))
+	fmt.Println("-----------------------")
+	fmt.Printf("Covered spend:     %s/hour on-demand, billed %s/hour\n",
+		money.Format(coverage.CoveredOnDemand, currency), money.Format(coverage.UsedCommitment, currency))
+	fmt.Printf("Uncovered spend:   %s/hour on-demand\n", money.Format(coverage.UncoveredOnDemand, currency))
+	fmt.Printf("Unused commitment: %s/hour\n", money.Format(coverage.UnusedCommitment, currency))
+	fmt.Printf("Utilization:       %.1f%%\n", coverage.Utilization)
+	fmt.Printf("Coverage:          %.1f%%\n", coverage.Coverage)
+	fmt.Printf("Net savings:       %s/hour\n", money.Format(coverage.NetSavings, currency))
+}
+
+// outputBudgetViolationsTable lists the budget ceilings that were exceeded
+func outputBudgetViolationsTable(violations []models.BudgetViolation, currency string) {
+	if len(violations) == 0 {
+		return
+	}
+
+	fmt.Printf("\n🚨 Budget Violations (%d)\n", len(violations))
+	fmt.Println("-----------------------")
+	for _, violation := range violations {
+		fmt.Printf("• %s\n", formatBudgetViolation(violation, currency))
+	}
+}
+
+// formatBudgetViolation describes a violation with its threshold and overage
+func formatBudgetViolation(violation models.BudgetViolation, currency string) string {
+	subject := "Total monthly cost"
+	switch violation.Scope {
+	case models.BudgetScopeResourceType:
+		subject = fmt.Sprintf("%s monthly cost", violation.Target)
+	case models.BudgetScopeResource:
+		subject = fmt.Sprintf("Monthly cost of %s", violation.Target)
+	}
+
+	return fmt.Sprintf("%s %s exceeds budget %s by %s", subject,
+		money.Format(violation.Actual, currency), money.Format(violation.Threshold, currency), money.Format(violation.Overage, currency))
+}
+
 // outputJSON formats results as JSON
 func outputJSON(result *models.EstimationResult) error {
 	encoder := json.NewEncoder(os.Stdout)
@@ -122,6 +206,8 @@ func outputCSV(result *models.EstimationResult) error {
 	writer := csv.NewWriter(os.Stdout)
 	defer writer.Flush()
 
+	decimals := money.Decimals(result.Currency)
+
 	// Write header
 	header := []string{
 		"Resource Name",
@@ -130,6 +216,7 @@ func outputCSV(result *models.EstimationResult) error {
 		"Hourly Cost",
 		"Daily Cost",
 		"Monthly Cost",
+		"Annual Cost",
 		"Currency",
 		"Generated At",
 	}
@@ -137,18 +224,14 @@ func outputCSV(result *models.EstimationResult) error {
 		return fmt.Errorf("failed to write CSV header: %w", err)
 	}
 
-	// Write resource rows
-	for _, cost := range result.ResourceCosts {
-		row := []string{
-			cost.ResourceName,
-			cost.ResourceType,
-			cost.Region,
-			fmt.Sprintf("%.4f", cost.HourlyCost),
-			fmt.Sprintf("%.4f", cost.DailyCost),
-			fmt.Sprintf("%.4f", cost.MonthlyCost),
-			cost.Currency,
-			cost.Timestamp.Format(time.RFC3339),
+	// Write resource rows, rounded so that each column adds up to its total
+	columns := output.RoundColumns(result.ResourceCosts, decimals)
+	for i, cost := range result.ResourceCosts {
+		row := []string{cost.ResourceName, cost.ResourceType, cost.Region}
+		for _, column := range columns {
+			row = append(row, column[i].StringFixed(decimals))
 		}
+		row = append(row, cost.Currency, cost.Timestamp.Format(time.RFC3339))
 		if err := writer.Write(row); err != nil {
 			return fmt.Errorf("failed to write CSV row: %w", err)
 		}
@@ -159,9 +242,10 @@ func outputCSV(result *models.EstimationResult) error {
 		"TOTAL",
 		"",
 		"",
-		fmt.Sprintf("%.4f", result.TotalHourlyCost),
-		fmt.Sprintf("%.4f", result.TotalDailyCost),
-		fmt.Sprintf("%.4f", result.TotalMonthlyCost),
+		result.TotalHourlyCost.StringFixed(decimals),
+		result.TotalDailyCost.StringFixed(decimals),
+		result.TotalMonthlyCost.StringFixed(decimals),
+		result.TotalAnnualCost.StringFixed(decimals),
 		result.Currency,
 		result.GeneratedAt.Format(time.RFC3339),
 	}
@@ -169,33 +253,97 @@ func outputCSV(result *models.EstimationResult) error {
 		return fmt.Errorf("failed to write CSV summary: %w", err)
 	}
 
+	// Keep the CSV a single table; report the period, skipped resources, credits and budget violations on stderr
+	if result.Month != "" {
+		fmt.Fprintf(os.Stderr, "Monthly costs cover %s (%.0f hours)\n", result.Month, result.HoursPerMonth)
+	}
+	if timeFrame := result.EmphasizedTimeFrame(); timeFrame != models.TimeFrameMonthly {
+		fmt.Fprintf(os.Stderr, "Total %s cost: %s\n", timeFrame, money.Format(result.TotalCost(timeFrame), result.Currency))
+	}
+	if rate := result.ExchangeRate; rate != nil {
+		fmt.Fprintf(os.Stderr, "Costs converted to %s\n", formatExchangeRate(rate))
+	}
+	if len(result.Skipped) > 0 {
+		fmt.Fprintf(os.Stderr, "Skipped %d resources that cannot be estimated (use --output table or json for details)\n", len(result.Skipped))
+	}
+	if result.FreeTierCredit.Sign() > 0 {
+		fmt.Fprintf(os.Stderr, "Free Tier credit of %s/month applied\n", money.Format(result.FreeTierCredit, result.Currency))
+	}
+	if coverage := result.SavingsPlans; coverage != nil {
+		fmt.Fprintf(os.Stderr, "%s Savings Plan: %.1f%% utilization, %.1f%% coverage, %s/hour net savings\n",
+			coverage.Type, coverage.Utilization, coverage.Coverage, money.Format(coverage.NetSavings, result.Currency))
+	}
+	for _, violation := range result.BudgetViolations {
+		fmt.Fprintf(os.Stderr, "Budget violation: %s\n", formatBudgetViolation(violation, result.Currency))
+	}
+
 	return nil
 }
 
 // Helper functions for table formatting
 
+// formatCurrency returns the currency of a result, with the exchange rate it
+// was converted at if any
+func formatCurrency(result *models.EstimationResult) string {
+	if result.ExchangeRate == nil {
+		return result.Currency
+	}
+	return formatExchangeRate(result.ExchangeRate)
+}
+
+// formatExchangeRate describes an exchange rate, e.g. "EUR at 0.9200 per
+// USD, rates effective 2026-10-01; details and assumptions quote AWS list
+// prices in USD"
+func formatExchangeRate(rate *models.ExchangeRate) string {
+	return fmt.Sprintf("%s at %.4f per %s, rates effective %s; details and assumptions quote AWS list prices in %s",
+		rate.To, rate.Rate, rate.From, rate.EffectiveDate, rate.From)
+}
+
+// formatTimeFrame returns the label of a time frame, e.g. "Monthly"
+func formatTimeFrame(timeFrame string) string {
+	return strings.ToUpper(timeFrame[:1]) + timeFrame[1:]
+}
+
 func isImportantDetail(key string) bool {
 	importantKeys := map[string]bool{
-		"instanceType":     true,
-		"count":            true,
-		"operatingSystem":  true,
-		"storageClass":     true,
-		"sizeGB":           true,
-		"requestsPerMonth": true,
-		"tenancy":          true,
+		"instanceType":        true,
+		"count":               true,
+		"operatingSystem":     true,
+		"storageClass":        true,
+		"sizeGB":              true,
+		"requestsPerMonth":    true,
+		"tenancy":             true,
+		"purchaseOption":      true,
+		"reservedTerm":        true,
+		"upfrontFee":          true,
+		"recurringPrice":      true,
+		"spotPercentile":      true,
+		"availabilityZone":    true,
+		"schedule":            true,
+		"savingsPlanCoverage": true,
+		"freeTierCredit":      true,
 	}
 	return importantKeys[key]
 }
 
 func formatDetailKey(key string) string {
 	keyMap := map[string]string{
-		"instanceType":     "Instance Type",
-		"count":            "Count",
-		"operatingSystem":  "Operating System",
-		"storageClass":     "Storage Class",
-		"sizeGB":           "Size (GB)",
-		"requestsPerMonth": "Requests/Month",
-		"tenancy":          "Tenancy",
+		"instanceType":        "Instance Type",
+		"count":               "Count",
+		"operatingSystem":     "Operating System",
+		"storageClass":        "Storage Class",
+		"sizeGB":              "Size (GB)",
+		"requestsPerMonth":    "Requests/Month",
+		"tenancy":             "Tenancy",
+		"purchaseOption":      "Purchase Option",
+		"reservedTerm":        "Reserved Term",
+		"upfrontFee":          "Upfront Fee",
+		"recurringPrice":      "Recurring Price",
+		"spotPercentile":      "Spot Percentile",
+		"availabilityZone":    "Availability Zone",
+		"schedule":            "Schedule",
+		"savingsPlanCoverage": "Savings Plan Coverage",
+		"freeTierCredit":      "Free Tier Credit",
 	}
 
 	if formatted, exists := keyMap[key]; exists {
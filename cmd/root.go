@@ -6,15 +6,22 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"shylock/internal/aws"
+	"shylock/internal/cache"
 	"shylock/internal/config"
 	"shylock/internal/errors"
 	"shylock/internal/estimators"
+	"shylock/internal/importers"
+	"shylock/internal/importers/cloudformation"
+	"shylock/internal/importers/terraform"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
+	"shylock/internal/performance"
 	"shylock/internal/version"
 )
 
@@ -24,6 +31,22 @@ var (
 	region       string
 	verbose      bool
 	currency     string
+	month        string
+
+	// Estimate flags
+	priceListPath      string
+	maxConcurrency     int
+	batchSize          int
+	cacheTTL           time.Duration
+	timeout            time.Duration
+	noCache            bool
+	recordPricing      string
+	replayPricing      string
+	spotPriceHistory   string
+	exchangeRates      string
+	fromTerraform      string
+	fromCloudFormation string
+	maxMonthly         float64
 
 	// Root command
 	rootCmd = &cobra.Command{
@@ -53,7 +76,8 @@ cost breakdowns with assumptions and recommendations.`,
 		Short: "Estimate AWS costs from configuration file",
 		Long: `Estimate AWS costs based on resource configurations defined in a JSON file.
 The configuration file should contain resource specifications including instance types,
-storage classes, and other AWS service parameters.`,
+storage classes, and other AWS service parameters. Resources can also be imported
+from a Terraform plan instead of a configuration file.`,
 		Example: `  # Basic cost estimation
   shylock estimate examples/simple-ec2.json
 
@@ -67,8 +91,35 @@ storage classes, and other AWS service parameters.`,
   shylock estimate config.json --verbose
 
   # Override region for all resources
-  shylock estimate config.json --region eu-west-1`,
-		Args: cobra.ExactArgs(1),
+  shylock estimate config.json --region eu-west-1
+
+  # Cost a specific calendar month, e.g. to reconcile with an invoice
+  shylock estimate config.json --month 2026-02
+
+  # Report costs in euros, converted with a local exchange rates file
+  shylock estimate config.json --currency EUR --exchange-rates rates.json
+
+  # Price from downloaded AWS bulk Price List files (no AWS credentials needed)
+  shylock estimate config.json --price-list ./offers
+
+  # Keep cached pricing data in a specific directory
+  shylock estimate config.json --cache-dir ./.shylock-cache
+
+  # Estimate from a Terraform plan
+  terraform show -json plan.tfplan > plan.json
+  shylock estimate --from-terraform plan.json
+
+  # Estimate from a CloudFormation template or CDK app
+  cdk synth > template.yaml
+  shylock estimate --from-cloudformation template.yaml --region eu-west-1
+
+  # Record pricing responses, then replay them for a reproducible estimate
+  shylock estimate config.json --record-pricing pricing-fixture.json
+  shylock estimate config.json --replay-pricing pricing-fixture.json
+
+  # Tune large configurations
+  shylock estimate config.json --concurrency 32 --batch-size 50 --timeout 2m`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: runEstimate,
 	}
 
@@ -105,10 +156,17 @@ fields, and unsupported resource types without performing actual cost estimation
 
 func init() {
 	// Add persistent flags to root command
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json, csv)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json, csv; diff also supports markdown)")
 	rootCmd.PersistentFlags().StringVarP(&region, "region", "r", "", "Override AWS region for all resources")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output with detailed information")
-	rootCmd.PersistentFlags().StringVarP(&currency, "currency", "c", "USD", "Currency for cost display (USD, EUR, GBP)")
+	rootCmd.PersistentFlags().StringVarP(&currency, "currency", "c", "USD", "Currency for cost display (USD, EUR, GBP, JPY); other than USD requires --exchange-rates")
+	rootCmd.PersistentFlags().StringVar(&month, "month", "", "Calendar month monthly costs cover, e.g. 2026-02 (default: a 730-hour month)")
+
+	// Add estimate flags
+	addPricingFlags(estimateCmd)
+	estimateCmd.Flags().StringVar(&fromTerraform, "from-terraform", "", "Import resources from 'terraform show -json' output instead of a configuration file")
+	estimateCmd.Flags().Float64Var(&maxMonthly, "max-monthly", 0, "Fail with exit code 8 if the total monthly cost exceeds this amount")
+	estimateCmd.Flags().StringVar(&fromCloudFormation, "from-cloudformation", "", "Import resources from a CloudFormation YAML/JSON template instead of a configuration file")
 
 	// Add subcommands
 	rootCmd.AddCommand(estimateCmd)
@@ -120,6 +178,22 @@ func init() {
 	rootCmd.SetHelpTemplate(getHelpTemplate())
 }
 
+// addPricingFlags adds the pricing source, cache and performance flags to a
+// command that estimates costs
+func addPricingFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&priceListPath, "price-list", "", "Offer file or directory of AWS bulk Price List files to use instead of the Pricing API")
+	cmd.Flags().StringVar(&cacheDir, "cache-dir", "", "Pricing cache directory (defaults to the user cache directory)")
+	cmd.Flags().IntVar(&maxConcurrency, "concurrency", performance.DefaultOptimizedConfig().MaxConcurrency, "Maximum number of resources estimated in parallel")
+	cmd.Flags().IntVar(&batchSize, "batch-size", performance.DefaultOptimizedConfig().BatchSize, "Number of resources processed per batch")
+	cmd.Flags().DurationVar(&cacheTTL, "cache-ttl", 24*time.Hour, "Lifetime of cached pricing data")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Maximum time for the whole estimation, e.g. 2m (0 disables the limit)")
+	cmd.Flags().BoolVar(&noCache, "no-cache", false, "Disable the in-memory and on-disk pricing caches")
+	cmd.Flags().StringVar(&recordPricing, "record-pricing", "", "Record every pricing query and response to a fixture file")
+	cmd.Flags().StringVar(&replayPricing, "replay-pricing", "", "Serve pricing from a recorded fixture file instead of AWS")
+	cmd.Flags().StringVar(&spotPriceHistory, "spot-price-history", "", "Spot price history file from 'aws ec2 describe-spot-price-history' for EC2 spot pricing")
+	cmd.Flags().StringVar(&exchangeRates, "exchange-rates", "", "Exchange rates file for converting costs from USD into --currency")
+}
+
 // Execute runs the root command
 func Execute() error {
 	return rootCmd.Execute()
@@ -132,77 +206,198 @@ func getVersionInfo() string {
 
 // runEstimate handles the estimate command
 func runEstimate(cmd *cobra.Command, args []string) error {
-	configFile := args[0]
+	cfg, skipped, err := loadEstimateConfig(args)
+	if err != nil {
+		return err
+	}
+
+	// Apply CLI overrides
+	if err := applyCliOverrides(cfg); err != nil {
+		return err
+	}
+	if err := applyBudgetOverride(cfg); err != nil {
+		return err
+	}
 
 	if verbose {
-		fmt.Printf("🔍 Loading configuration from: %s\n", configFile)
+		fmt.Printf("✅ Configuration loaded successfully (%d resources)\n", len(cfg.Resources))
+		if len(skipped) > 0 {
+			fmt.Printf("⏭️  Skipped %d resources that cannot be estimated\n", len(skipped))
+		}
 	}
 
-	// Validate file exists and has correct extension
-	if err := validateConfigFile(configFile); err != nil {
+	ctx := context.Background()
+	session, err := newEstimationSession(ctx)
+	if err != nil {
 		return err
 	}
 
-	// Parse configuration
-	parser := config.NewParser()
-	cfg, err := parser.ParseConfig(configFile)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	result, err := session.estimate(ctx, cfg)
 	if err != nil {
-		return errors.WrapError(err, errors.ConfigErrorType, "failed to parse configuration file").
-			WithContext("configFile", configFile).
-			WithSuggestion("Check the JSON syntax and required fields").
-			WithSuggestion("Use 'shylock validate' to check for configuration errors")
+		return err
 	}
 
-	// Apply CLI overrides
-	if err := applyCliOverrides(cfg); err != nil {
+	result.Skipped = skipped
+	result.BudgetViolations = cfg.Options.Budget.Check(result)
+
+	if err := session.finish(); err != nil {
 		return err
 	}
 
-	if verbose {
-		fmt.Printf("✅ Configuration loaded successfully (%d resources)\n", len(cfg.Resources))
+	// Output results
+	if err := outputResults(result, outputFormat); err != nil {
+		return err
 	}
 
-	// Create AWS client
-	ctx := context.Background()
-	awsClient, err := aws.NewClient(ctx, nil)
+	return budgetError(result.BudgetViolations)
+}
+
+// applyBudgetOverride applies --max-monthly as the total monthly budget ceiling
+func applyBudgetOverride(cfg *models.EstimationConfig) error {
+	if maxMonthly < 0 {
+		return errors.ValidationError("maximum monthly cost cannot be negative").
+			WithContext("maxMonthly", maxMonthly)
+	}
+	if maxMonthly == 0 {
+		return nil
+	}
+
+	if cfg.Options.Budget == nil {
+		cfg.Options.Budget = &models.Budget{}
+	}
+	cfg.Options.Budget.MaxMonthly = maxMonthly
+	return nil
+}
+
+// budgetError returns a budget error if any budget ceiling was exceeded, so
+// that CI pipelines can gate on the exit code
+func budgetError(violations []models.BudgetViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return errors.BudgetErrorf("estimated costs exceed %d budget limit(s)", len(violations)).
+		WithContext("violations", len(violations)).
+		WithSuggestion("Review the budget violations listed in the output").
+		WithSuggestion("Reduce resource sizes or counts, or raise the ceilings in options.budget")
+}
+
+// estimationSession is the pricing client stack and estimator factory shared
+// by every estimate a command makes, so that repeated pricing queries are
+// served from the caches
+type estimationSession struct {
+	factory    *performance.OptimizedFactory
+	perfConfig *performance.OptimizedFactoryConfig
+	recorder   *aws.RecordingClient
+	diskCache  *cache.DiskCache
+}
+
+// newEstimationSession creates the pricing client and optimized factory from the estimate flags
+func newEstimationSession(ctx context.Context) (*estimationSession, error) {
+	// Validate performance flags
+	perfConfig, err := newOptimizedConfig()
 	if err != nil {
-		return errors.WrapError(err, errors.AuthErrorType, "failed to create AWS client").
-			WithSuggestion("Ensure AWS credentials are configured").
-			WithSuggestion("Check AWS CLI configuration with 'aws configure list'")
+		return nil, err
 	}
 
-	if verbose {
-		fmt.Println("🔗 Connected to AWS Pricing API")
+	// Create pricing client
+	awsClient, err := newPricingClient(ctx)
+	if err != nil {
+		return nil, err
 	}
+	awsClient, diskCache := withDiskCache(awsClient)
 
-	// Create estimator factory
-	factory := estimators.NewFactory(awsClient)
+	// Record above the disk cache so cached responses are captured too
+	var recorder *aws.RecordingClient
+	if recordPricing != "" {
+		recorder = aws.NewRecordingClient(awsClient)
+		awsClient = recorder
+	}
 
+	factory := performance.NewOptimizedFactory(awsClient, perfConfig)
+	if spotPriceHistory != "" {
+		spotPrices, err := aws.NewFileSpotPriceSource(spotPriceHistory)
+		if err != nil {
+			return nil, err
+		}
+		factory.SetSpotPriceSource(spotPrices)
+	}
+	if exchangeRates != "" {
+		rates, err := money.LoadExchangeRates(exchangeRates)
+		if err != nil {
+			return nil, err
+		}
+		if verbose {
+			fmt.Printf("💱 Loaded exchange rates effective %s from: %s\n", rates.EffectiveDate, exchangeRates)
+		}
+		factory.SetExchangeRates(rates)
+	}
+
+	return &estimationSession{
+		factory:    factory,
+		perfConfig: perfConfig,
+		recorder:   recorder,
+		diskCache:  diskCache,
+	}, nil
+}
+
+// estimate validates a configuration and estimates its costs
+func (s *estimationSession) estimate(ctx context.Context, cfg *models.EstimationConfig) (*models.EstimationResult, error) {
 	// Validate configuration
-	if err := factory.ValidateConfig(cfg); err != nil {
-		return errors.WrapError(err, errors.ValidationErrorType, "configuration validation failed").
+	if err := s.factory.ValidateConfig(cfg); err != nil {
+		return nil, errors.WrapError(err, errors.ValidationErrorType, "configuration validation failed").
 			WithSuggestion("Use 'shylock validate' to check for specific validation errors")
 	}
 
 	if verbose {
 		fmt.Println("✅ Configuration validation passed")
-		fmt.Println("💰 Estimating costs...")
+		fmt.Printf("💰 Estimating costs (concurrency %d, batch size %d)...\n", s.perfConfig.MaxConcurrency, s.perfConfig.BatchSize)
 	}
 
-	// Estimate costs
-	result, err := factory.EstimateFromConfig(ctx, cfg)
+	result, err := s.factory.EstimateFromConfigOptimized(ctx, cfg)
+	if ctx.Err() == context.DeadlineExceeded {
+		// Resources still in flight fail individually, so never report a partial total
+		return nil, errors.NetworkError("cost estimation timed out").
+			WithContext("timeout", timeout.String()).
+			WithSuggestion("Increase the limit with --timeout").
+			WithSuggestion("Raise --concurrency to estimate more resources in parallel")
+	}
 	if err != nil {
-		return errors.WrapError(err, "", "cost estimation failed").
+		return nil, errors.WrapError(err, "", "cost estimation failed").
 			WithSuggestion("Check AWS credentials and network connectivity").
 			WithSuggestion("Verify that all resource types are supported in the specified regions")
 	}
 
 	if verbose {
-		fmt.Printf("✅ Cost estimation completed (%d resources processed)\n\n", len(result.ResourceCosts))
+		fmt.Printf("✅ Cost estimation completed (%d resources processed)\n", len(result.ResourceCosts))
 	}
 
-	// Output results
-	return outputResults(result, outputFormat)
+	return result, nil
+}
+
+// finish saves recorded pricing queries and prints cache statistics
+func (s *estimationSession) finish() error {
+	if s.recorder != nil {
+		if err := s.recorder.Save(recordPricing); err != nil {
+			return err
+		}
+		if verbose {
+			fmt.Printf("📼 Recorded %d pricing queries to: %s\n", len(s.recorder.Fixture().Interactions), recordPricing)
+		}
+	}
+
+	if verbose {
+		printCacheStats(s.factory.GetCacheStats(), s.diskCache)
+		fmt.Println()
+	}
+
+	return nil
 }
 
 // runList handles the list command
@@ -231,6 +426,35 @@ func runList(cmd *cobra.Command, args []string) error {
 						fmt.Printf("   • %s: %s\n", albType, desc)
 					}
 				}
+			case "APIGateway":
+				if types, ok := info["supportedAPITypes"].([]string); ok {
+					fmt.Printf("   API Types: %s\n", strings.Join(types, ", "))
+				}
+				if sizes, ok := info["supportedCacheSizes"].([]string); ok {
+					fmt.Printf("   REST API Cache Sizes (GB): %s\n", strings.Join(sizes, ", "))
+				}
+			case "CloudFront":
+				if classes, ok := info["supportedPriceClasses"].([]string); ok {
+					fmt.Printf("   Price Classes: %s\n", strings.Join(classes, ", "))
+				}
+				if geographies, ok := info["supportedGeographies"].([]string); ok {
+					fmt.Printf("   Traffic Split Geographies: %s\n", strings.Join(geographies, ", "))
+				}
+			case "DataTransfer":
+				if types, ok := info["supportedTransferTypes"].([]string); ok {
+					fmt.Printf("   Transfer Types: %s\n", strings.Join(types, ", "))
+				}
+			case "DynamoDB":
+				if modes, ok := info["supportedCapacityModes"].([]string); ok {
+					fmt.Printf("   Capacity Modes: %s\n", strings.Join(modes, ", "))
+				}
+				if classes, ok := info["supportedTableClasses"].([]string); ok {
+					fmt.Printf("   Table Classes: %s\n", strings.Join(classes, ", "))
+				}
+			case "EBS":
+				if types, ok := info["supportedVolumeTypes"].([]string); ok {
+					fmt.Printf("   Volume Types: %s\n", strings.Join(types, ", "))
+				}
 			case "EC2":
 				if families, ok := info["supportedInstanceFamilies"].([]string); ok {
 					fmt.Printf("   Instance Families: %s\n", strings.Join(families, ", "))
@@ -241,6 +465,30 @@ func runList(cmd *cobra.Command, args []string) error {
 						fmt.Printf("   ... and %d more\n", len(types)-5)
 					}
 				}
+			case "EKS":
+				if types, ok := info["supportedSupportTypes"].([]string); ok {
+					fmt.Printf("   Support Types: %s\n", strings.Join(types, ", "))
+				}
+			case "ElastiCache":
+				if engines, ok := info["supportedEngines"].([]string); ok {
+					fmt.Printf("   Cache Engines: %s\n", strings.Join(engines, ", "))
+				}
+				if types, ok := info["supportedNodeTypes"].([]string); ok && len(types) > 0 {
+					fmt.Printf("   Node Types: %s\n", strings.Join(types[:min(5, len(types))], ", "))
+					if len(types) > 5 {
+						fmt.Printf("   ... and %d more\n", len(types)-5)
+					}
+				}
+			case "Fargate":
+				if vcpus, ok := info["supportedVCPUs"].([]string); ok {
+					fmt.Printf("   vCPU: %s\n", strings.Join(vcpus, ", "))
+				}
+				if systems, ok := info["supportedOperatingSystems"].([]string); ok {
+					fmt.Printf("   Operating Systems: %s\n", strings.Join(systems, ", "))
+				}
+				if architectures, ok := info["supportedArchitectures"].([]string); ok {
+					fmt.Printf("   Architectures: %s\n", strings.Join(architectures, ", "))
+				}
 			case "Lambda":
 				if architectures, ok := info["supportedArchitectures"].([]string); ok {
 					fmt.Printf("   Architectures: %s\n", strings.Join(architectures, ", "))
@@ -355,6 +603,233 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 // Helper functions
 
+// importSource is an infrastructure-as-code file selected with a --from-* flag
+type importSource struct {
+	flag        string
+	path        string
+	description string
+	importer    importers.Importer
+}
+
+// importSources returns the import sources selected on the command line
+func importSources() []importSource {
+	var sources []importSource
+	if fromTerraform != "" {
+		sources = append(sources, importSource{
+			flag:        "--from-terraform",
+			path:        fromTerraform,
+			description: "Terraform plan",
+			importer:    terraform.NewImporter(region),
+		})
+	}
+	if fromCloudFormation != "" {
+		sources = append(sources, importSource{
+			flag:        "--from-cloudformation",
+			path:        fromCloudFormation,
+			description: "CloudFormation template",
+			importer:    cloudformation.NewImporter(region),
+		})
+	}
+	return sources
+}
+
+// loadEstimateConfig loads the estimation configuration from the config file
+// argument or from an infrastructure-as-code file given with a --from-* flag.
+// It also returns the imported resources that were skipped because they
+// cannot be estimated.
+func loadEstimateConfig(args []string) (*models.EstimationConfig, []models.SkippedResource, error) {
+	sources := importSources()
+
+	if len(sources) > 1 {
+		return nil, nil, errors.ValidationError("only one import source can be used at a time").
+			WithContext("flags", sources[0].flag+", "+sources[1].flag).
+			WithSuggestion("Estimate each Terraform plan or CloudFormation template separately")
+	}
+
+	if len(sources) == 1 {
+		source := sources[0]
+		if len(args) > 0 {
+			return nil, nil, errors.ValidationErrorf("a configuration file cannot be combined with %s", source.flag).
+				WithContext("configFile", args[0]).
+				WithSuggestion(fmt.Sprintf("Pass either a configuration file or %s, not both", source.flag))
+		}
+
+		if verbose {
+			fmt.Printf("🔍 Importing %s from: %s\n", source.description, source.path)
+		}
+
+		result, err := source.importer.ImportFile(source.path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Config, result.Skipped, nil
+	}
+
+	if len(args) == 0 {
+		return nil, nil, errors.ValidationError("no configuration file specified").
+			WithSuggestion("Pass a configuration file: shylock estimate config.json").
+			WithSuggestion("Or import a Terraform plan with --from-terraform plan.json").
+			WithSuggestion("Or import a CloudFormation template with --from-cloudformation template.yaml")
+	}
+
+	cfg, err := loadConfigFile(args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, nil, nil
+}
+
+// loadConfigFile parses a JSON configuration file
+func loadConfigFile(configFile string) (*models.EstimationConfig, error) {
+	if verbose {
+		fmt.Printf("🔍 Loading configuration from: %s\n", configFile)
+	}
+
+	// Validate file exists and has correct extension
+	if err := validateConfigFile(configFile); err != nil {
+		return nil, err
+	}
+
+	// Parse configuration
+	parser := config.NewParser()
+	cfg, err := parser.ParseConfig(configFile)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.ConfigErrorType, "failed to parse configuration file").
+			WithContext("configFile", configFile).
+			WithSuggestion("Check the JSON syntax and required fields").
+			WithSuggestion("Use 'shylock validate' to check for configuration errors")
+	}
+
+	return cfg, nil
+}
+
+// newPricingClient creates the pricing client for estimation: a fixture replay
+// client when --replay-pricing is set, a file-backed client when --price-list
+// is set, otherwise the live AWS Pricing API client
+func newPricingClient(ctx context.Context) (interfaces.AWSPricingClient, error) {
+	if replayPricing != "" {
+		if priceListPath != "" || recordPricing != "" {
+			return nil, errors.ValidationError("--replay-pricing cannot be combined with --price-list or --record-pricing").
+				WithSuggestion("Replay a fixture on its own, or record a new one from the desired pricing source")
+		}
+
+		client, err := aws.NewReplayClient(replayPricing)
+		if err != nil {
+			return nil, err
+		}
+
+		if verbose {
+			fmt.Printf("📼 Replaying pricing fixture: %s\n", replayPricing)
+		}
+		return client, nil
+	}
+
+	if priceListPath != "" {
+		client, err := aws.NewOfflineClient(&aws.OfflineClientConfig{PriceListPath: priceListPath})
+		if err != nil {
+			return nil, errors.WrapError(err, "", "failed to load offline price list").
+				WithContext("priceListPath", priceListPath).
+				WithSuggestion("Download offer files from https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/index.json")
+		}
+
+		if verbose {
+			fmt.Printf("📂 Using offline price list: %s\n", priceListPath)
+		}
+		return client, nil
+	}
+
+	client, err := aws.NewClient(ctx, nil)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.AuthErrorType, "failed to create AWS client").
+			WithSuggestion("Ensure AWS credentials are configured").
+			WithSuggestion("Check AWS CLI configuration with 'aws configure list'").
+			WithSuggestion("Use --price-list to estimate from downloaded AWS bulk Price List files")
+	}
+
+	if verbose {
+		fmt.Println("🔗 Connected to AWS Pricing API")
+	}
+	return client, nil
+}
+
+// withDiskCache wraps the pricing client with the on-disk pricing cache and
+// returns the cache it used, if any. Offline price lists and replayed fixtures
+// are read locally and are never cached, and a cache that cannot be opened
+// only disables caching rather than failing the estimate.
+func withDiskCache(client interfaces.AWSPricingClient) (interfaces.AWSPricingClient, *cache.DiskCache) {
+	if noCache || priceListPath != "" || replayPricing != "" {
+		return client, nil
+	}
+
+	diskCache, err := openDiskCache(cacheTTL)
+	if err != nil {
+		if verbose {
+			fmt.Printf("⚠️  Pricing cache disabled: %v\n", err)
+		}
+		return client, nil
+	}
+
+	if verbose {
+		fmt.Printf("💾 Using pricing cache: %s\n", diskCache.Dir())
+	}
+	return cache.NewCachedAWSClient(client, diskCache), diskCache
+}
+
+// newOptimizedConfig builds the optimized factory configuration from the estimate flags
+func newOptimizedConfig() (*performance.OptimizedFactoryConfig, error) {
+	if maxConcurrency < 1 {
+		return nil, errors.ValidationError("concurrency must be at least 1").
+			WithContext("concurrency", maxConcurrency).
+			WithSuggestion("Use --concurrency 1 to estimate resources sequentially")
+	}
+	if batchSize < 1 {
+		return nil, errors.ValidationError("batch size must be at least 1").
+			WithContext("batchSize", batchSize)
+	}
+	if cacheTTL <= 0 {
+		return nil, errors.ValidationError("cache TTL must be positive").
+			WithContext("cacheTTL", cacheTTL.String()).
+			WithSuggestion("Use --no-cache to disable pricing caches")
+	}
+	if timeout < 0 {
+		return nil, errors.ValidationError("timeout cannot be negative").
+			WithContext("timeout", timeout.String()).
+			WithSuggestion("Use --timeout 0 to disable the limit")
+	}
+
+	config := performance.DefaultOptimizedConfig()
+	config.MaxConcurrency = maxConcurrency
+	config.BatchSize = batchSize
+	config.CacheTTL = cacheTTL
+	config.EnableCaching = !noCache
+	return config, nil
+}
+
+// printCacheStats prints pricing cache hit/miss statistics for verbose output
+func printCacheStats(memoryStats *cache.CacheStats, diskCache *cache.DiskCache) {
+	if memoryStats == nil && diskCache == nil {
+		fmt.Println("📈 Pricing cache disabled")
+		return
+	}
+
+	if memoryStats != nil {
+		fmt.Printf("📈 Memory cache: %s\n", formatHitRate(memoryStats.TotalHits, memoryStats.TotalMisses))
+	}
+	if diskCache != nil {
+		stats := diskCache.Stats()
+		fmt.Printf("📈 Disk cache:   %s\n", formatHitRate(stats.TotalHits, stats.TotalMisses))
+	}
+}
+
+// formatHitRate formats hit and miss counts with the resulting hit rate
+func formatHitRate(hits, misses int64) string {
+	lookups := hits + misses
+	if lookups == 0 {
+		return "no lookups"
+	}
+	return fmt.Sprintf("%d hits, %d misses (%.1f%% hit rate)", hits, misses, float64(hits)/float64(lookups)*100)
+}
+
 func validateConfigFile(configFile string) error {
 	// Check if file exists
 	if _, err := os.Stat(configFile); os.IsNotExist(err) {
@@ -378,6 +853,12 @@ func validateConfigFile(configFile string) error {
 }
 
 func applyCliOverrides(cfg *models.EstimationConfig) error {
+	applyConfigOverrides(cfg)
+	return validateOutputFormat([]string{"table", "json", "csv"})
+}
+
+// applyConfigOverrides applies the --region, --currency and --month overrides to a configuration
+func applyConfigOverrides(cfg *models.EstimationConfig) {
 	// Override region if specified
 	if region != "" {
 		if verbose {
@@ -396,8 +877,17 @@ func applyCliOverrides(cfg *models.EstimationConfig) error {
 		cfg.Options.Currency = currency
 	}
 
-	// Validate output format
-	validFormats := []string{"table", "json", "csv"}
+	// Override the calendar month if specified
+	if month != "" {
+		if verbose {
+			fmt.Printf("📅 Costing monthly usage for: %s\n", month)
+		}
+		cfg.Options.Month = month
+	}
+}
+
+// validateOutputFormat checks the --output flag against the formats a command supports
+func validateOutputFormat(validFormats []string) error {
 	formatValid := false
 	for _, format := range validFormats {
 		if outputFormat == format {
@@ -409,7 +899,7 @@ func applyCliOverrides(cfg *models.EstimationConfig) error {
 		return errors.ValidationError("invalid output format").
 			WithContext("outputFormat", outputFormat).
 			WithContext("validFormats", strings.Join(validFormats, ", ")).
-			WithSuggestion("Use one of: table, json, csv")
+			WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(validFormats, ", ")))
 	}
 
 	return nil
@@ -2,14 +2,17 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"shylock/internal/errors"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 func TestValidateConfigFile(t *testing.T) {
@@ -175,27 +178,27 @@ func TestApplyCliOverrides(t *testing.T) {
 func TestOutputFormats(t *testing.T) {
 	// Create test result
 	result := &models.EstimationResult{
-		TotalHourlyCost:  1.5,
-		TotalDailyCost:   36.0,
-		TotalMonthlyCost: 1080.0,
+		TotalHourlyCost:  money.NewAmount(1.5),
+		TotalDailyCost:   money.NewAmount(36.0),
+		TotalMonthlyCost: money.NewAmount(1080.0),
 		Currency:         "USD",
 		ResourceCosts: []models.CostEstimate{
 			{
 				ResourceName: "test-instance",
 				ResourceType: "EC2",
 				Region:       "us-east-1",
-				HourlyCost:   1.0,
-				DailyCost:    24.0,
-				MonthlyCost:  720.0,
+				HourlyCost:   money.NewAmount(1.0),
+				DailyCost:    money.NewAmount(24.0),
+				MonthlyCost:  money.NewAmount(720.0),
 				Currency:     "USD",
 			},
 			{
 				ResourceName: "test-bucket",
 				ResourceType: "S3",
 				Region:       "us-east-1",
-				HourlyCost:   0.5,
-				DailyCost:    12.0,
-				MonthlyCost:  360.0,
+				HourlyCost:   money.NewAmount(0.5),
+				DailyCost:    money.NewAmount(12.0),
+				MonthlyCost:  money.NewAmount(360.0),
 				Currency:     "USD",
 			},
 		},
@@ -280,6 +283,241 @@ func TestOutputFormats(t *testing.T) {
 	}
 }
 
+func TestOutputTimeFrame(t *testing.T) {
+	result := &models.EstimationResult{
+		TotalHourlyCost:  money.NewAmount(1.0),
+		TotalDailyCost:   money.NewAmount(24.0),
+		TotalMonthlyCost: money.NewAmount(672.0),
+		TotalAnnualCost:  money.NewAmount(8760.0),
+		Currency:         "USD",
+		TimeFrame:        models.TimeFrameAnnual,
+		Month:            "2026-02",
+		HoursPerMonth:    672,
+		ResourceCosts: []models.CostEstimate{
+			{ResourceName: "web", ResourceType: "EC2", Region: "us-east-1", HourlyCost: money.NewAmount(1.0), DailyCost: money.NewAmount(24.0), MonthlyCost: money.NewAmount(672.0), AnnualCost: money.NewAmount(8760.0), Currency: "USD"},
+		},
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputResults(result, "table")
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	buf := make([]byte, 1024*10)
+	n, _ := r.Read(buf)
+	output := string(buf[:n])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, text := range []string{
+		"Monthly Cost: $672.0000 (2026-02, 672 hours)\n",
+		"Annual Cost:  $8760.0000  ◀\n",
+		"   $8760.0000",
+	} {
+		if !strings.Contains(output, text) {
+			t.Errorf("expected output to contain %q. Output: %s", text, output)
+		}
+	}
+}
+
+func TestOutputCurrency(t *testing.T) {
+	result := &models.EstimationResult{
+		TotalHourlyCost:  money.NewAmount(150.0),
+		TotalDailyCost:   money.NewAmount(3600.0),
+		TotalMonthlyCost: money.NewAmount(109500.0),
+		TotalAnnualCost:  money.NewAmount(1314000.0),
+		Currency:         "JPY",
+		ExchangeRate:     &models.ExchangeRate{From: "USD", To: "JPY", Rate: 150, EffectiveDate: "2026-10-01"},
+		ResourceCosts: []models.CostEstimate{
+			{ResourceName: "web", ResourceType: "EC2", Region: "us-east-1", HourlyCost: money.NewAmount(150.0), DailyCost: money.NewAmount(3600.0), MonthlyCost: money.NewAmount(109500.0), AnnualCost: money.NewAmount(1314000.0), Currency: "JPY"},
+		},
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputResults(result, "table")
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	buf := make([]byte, 1024*10)
+	n, _ := r.Read(buf)
+	output := string(buf[:n])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, text := range []string{
+		"Currency: JPY at 150.0000 per USD, rates effective 2026-10-01; details and assumptions quote AWS list prices in USD\n",
+		"Monthly Cost: ¥109500.00  ◀\n",
+		" ¥1314000.00",
+	} {
+		if !strings.Contains(output, text) {
+			t.Errorf("expected output to contain %q. Output: %s", text, output)
+		}
+	}
+	if strings.Contains(output, "$") {
+		t.Errorf("expected no dollar amounts. Output: %s", output)
+	}
+}
+
+func TestOutputSkippedResources(t *testing.T) {
+	result := &models.EstimationResult{
+		Currency: "USD",
+		ResourceCosts: []models.CostEstimate{
+			{ResourceName: "aws_instance.web", ResourceType: "EC2", Region: "us-east-1", Currency: "USD"},
+		},
+		Skipped: []models.SkippedResource{
+			{Name: "aws_sqs_queue.jobs", Type: "aws_sqs_queue", Reason: "resource type is not supported"},
+		},
+	}
+
+	for _, format := range []string{"table", "json"} {
+		t.Run(format, func(t *testing.T) {
+			oldStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			err := outputResults(result, format)
+
+			w.Close()
+			os.Stdout = oldStdout
+
+			buf := make([]byte, 1024*10)
+			n, _ := r.Read(buf)
+			output := string(buf[:n])
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(output, "aws_sqs_queue.jobs") || !strings.Contains(output, "resource type is not supported") {
+				t.Errorf("expected skipped resource in output. Output: %s", output)
+			}
+		})
+	}
+}
+
+func TestOutputBudgetViolations(t *testing.T) {
+	result := &models.EstimationResult{
+		Currency:         "USD",
+		TotalMonthlyCost: money.NewAmount(600),
+		ResourceCosts: []models.CostEstimate{
+			{ResourceName: "web", ResourceType: "EC2", Region: "us-east-1", MonthlyCost: money.NewAmount(600), Currency: "USD"},
+		},
+		BudgetViolations: []models.BudgetViolation{
+			{Scope: models.BudgetScopeTotal, Threshold: money.NewAmount(500), Actual: money.NewAmount(600), Overage: money.NewAmount(100)},
+			{Scope: models.BudgetScopeResource, Target: "web", Threshold: money.NewAmount(450), Actual: money.NewAmount(600), Overage: money.NewAmount(150)},
+		},
+	}
+
+	tests := []struct {
+		format   string
+		expected []string
+	}{
+		{
+			format: "table",
+			expected: []string{
+				"Budget Violations (2)",
+				"Total monthly cost $600.0000 exceeds budget $500.0000 by $100.0000",
+				"Monthly cost of web $600.0000 exceeds budget $450.0000 by $150.0000",
+			},
+		},
+		{
+			format:   "json",
+			expected: []string{`"budgetViolations"`, `"overage": 150`, `"target": "web"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			oldStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			err := outputResults(result, tt.format)
+
+			w.Close()
+			os.Stdout = oldStdout
+
+			buf := make([]byte, 1024*10)
+			n, _ := r.Read(buf)
+			output := string(buf[:n])
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, text := range tt.expected {
+				if !strings.Contains(output, text) {
+					t.Errorf("expected output to contain %q. Output: %s", text, output)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatBudgetViolation(t *testing.T) {
+	violation := models.BudgetViolation{Scope: models.BudgetScopeResourceType, Target: "RDS", Threshold: money.NewAmount(100), Actual: money.NewAmount(125.5), Overage: money.NewAmount(25.5)}
+	expected := "RDS monthly cost $125.5000 exceeds budget $100.0000 by $25.5000"
+	if result := formatBudgetViolation(violation, "USD"); result != expected {
+		t.Errorf("expected '%s', got '%s'", expected, result)
+	}
+
+	expected = "RDS monthly cost €125.5000 exceeds budget €100.0000 by €25.5000"
+	if result := formatBudgetViolation(violation, "EUR"); result != expected {
+		t.Errorf("expected '%s', got '%s'", expected, result)
+	}
+}
+
+func TestApplyBudgetOverride(t *testing.T) {
+	defer func() { maxMonthly = 0 }()
+
+	t.Run("no override", func(t *testing.T) {
+		maxMonthly = 0
+		cfg := &models.EstimationConfig{}
+		if err := applyBudgetOverride(cfg); err != nil || cfg.Options.Budget != nil {
+			t.Errorf("expected no budget, got %+v (err %v)", cfg.Options.Budget, err)
+		}
+	})
+
+	t.Run("keeps configured ceilings", func(t *testing.T) {
+		maxMonthly = 250
+		cfg := &models.EstimationConfig{Options: models.ConfigOptions{
+			Budget: &models.Budget{MaxMonthly: 500, ResourceTypes: map[string]float64{"EC2": 100}},
+		}}
+		if err := applyBudgetOverride(cfg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Options.Budget.MaxMonthly != 250 || cfg.Options.Budget.ResourceTypes["EC2"] != 100 {
+			t.Errorf("expected total ceiling override only, got %+v", cfg.Options.Budget)
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		maxMonthly = -1
+		if err := applyBudgetOverride(&models.EstimationConfig{}); !errors.IsErrorType(err, errors.ValidationErrorType) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestBudgetError(t *testing.T) {
+	if err := budgetError(nil); err != nil {
+		t.Errorf("expected no error without violations, got %v", err)
+	}
+
+	err := budgetError([]models.BudgetViolation{{Scope: models.BudgetScopeTotal, Threshold: money.NewAmount(1), Actual: money.NewAmount(2), Overage: money.NewAmount(1)}})
+	if !errors.IsErrorType(err, errors.BudgetErrorType) || errors.GetExitCode(err) != 8 {
+		t.Errorf("expected budget error with exit code 8, got %v", err)
+	}
+}
+
 func TestFormatDetailKey(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -289,6 +527,7 @@ func TestFormatDetailKey(t *testing.T) {
 		{"operatingSystem", "Operating System"},
 		{"storageClass", "Storage Class"},
 		{"sizeGB", "Size (GB)"},
+		{"reservedTerm", "Reserved Term"},
 		{"unknownKey", "Unknown Key"},
 		{"camelCaseKey", "Camel Case Key"},
 	}
@@ -311,6 +550,7 @@ func TestIsImportantDetail(t *testing.T) {
 		{"instanceType", true},
 		{"count", true},
 		{"storageClass", true},
+		{"upfrontFee", true},
 		{"sku", false},
 		{"productFamily", false},
 		{"unknownKey", false},
@@ -328,7 +568,7 @@ func TestIsImportantDetail(t *testing.T) {
 
 func TestCommandStructure(t *testing.T) {
 	// Test that all expected commands are available
-	expectedCommands := []string{"estimate", "list", "validate", "version"}
+	expectedCommands := []string{"estimate", "list", "validate", "version", "cache"}
 
 	for _, cmdName := range expectedCommands {
 		t.Run("command_"+cmdName, func(t *testing.T) {
@@ -345,7 +585,7 @@ func TestCommandStructure(t *testing.T) {
 
 func TestFlagDefinitions(t *testing.T) {
 	// Test that all expected flags are defined
-	expectedFlags := []string{"output", "region", "verbose", "currency"}
+	expectedFlags := []string{"output", "region", "verbose", "currency", "month"}
 
 	for _, flagName := range expectedFlags {
 		t.Run("flag_"+flagName, func(t *testing.T) {
@@ -357,6 +597,305 @@ func TestFlagDefinitions(t *testing.T) {
 	}
 }
 
+func TestEstimateFlagDefinitions(t *testing.T) {
+	// Test that all expected estimate flags are defined
+	expectedFlags := []string{"price-list", "cache-dir", "concurrency", "batch-size", "cache-ttl", "timeout", "no-cache", "record-pricing", "replay-pricing", "spot-price-history", "exchange-rates", "from-terraform", "from-cloudformation", "max-monthly"}
+
+	for _, flagName := range expectedFlags {
+		t.Run("flag_"+flagName, func(t *testing.T) {
+			flag := estimateCmd.Flags().Lookup(flagName)
+			if flag == nil {
+				t.Errorf("flag '%s' not found", flagName)
+			}
+		})
+	}
+}
+
+func TestNewOptimizedConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		batchSize   int
+		cacheTTL    time.Duration
+		timeout     time.Duration
+		noCache     bool
+		expectError bool
+	}{
+		{
+			name:        "valid flags",
+			concurrency: 8,
+			batchSize:   20,
+			cacheTTL:    time.Hour,
+			timeout:     time.Minute,
+		},
+		{
+			name:        "no cache",
+			concurrency: 1,
+			batchSize:   1,
+			cacheTTL:    time.Hour,
+			noCache:     true,
+		},
+		{
+			name:        "zero concurrency",
+			concurrency: 0,
+			batchSize:   10,
+			cacheTTL:    time.Hour,
+			expectError: true,
+		},
+		{
+			name:        "zero batch size",
+			concurrency: 4,
+			batchSize:   0,
+			cacheTTL:    time.Hour,
+			expectError: true,
+		},
+		{
+			name:        "zero cache TTL",
+			concurrency: 4,
+			batchSize:   10,
+			expectError: true,
+		},
+		{
+			name:        "negative timeout",
+			concurrency: 4,
+			batchSize:   10,
+			cacheTTL:    time.Hour,
+			timeout:     -time.Second,
+			expectError: true,
+		},
+	}
+
+	oldConcurrency, oldBatchSize, oldCacheTTL, oldTimeout, oldNoCache := maxConcurrency, batchSize, cacheTTL, timeout, noCache
+	defer func() {
+		maxConcurrency, batchSize, cacheTTL, timeout, noCache = oldConcurrency, oldBatchSize, oldCacheTTL, oldTimeout, oldNoCache
+	}()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxConcurrency, batchSize, cacheTTL, timeout, noCache = tt.concurrency, tt.batchSize, tt.cacheTTL, tt.timeout, tt.noCache
+
+			config, err := newOptimizedConfig()
+
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if config.MaxConcurrency != tt.concurrency || config.BatchSize != tt.batchSize || config.CacheTTL != tt.cacheTTL {
+				t.Errorf("flags not applied: %+v", config)
+			}
+			if config.EnableCaching == tt.noCache {
+				t.Errorf("expected EnableCaching %v, got %v", !tt.noCache, config.EnableCaching)
+			}
+		})
+	}
+}
+
+func TestFormatHitRate(t *testing.T) {
+	tests := []struct {
+		hits     int64
+		misses   int64
+		expected string
+	}{
+		{0, 0, "no lookups"},
+		{3, 1, "3 hits, 1 misses (75.0% hit rate)"},
+		{0, 5, "0 hits, 5 misses (0.0% hit rate)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := formatHitRate(tt.hits, tt.misses); result != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLoadEstimateConfig(t *testing.T) {
+	defer func() {
+		fromTerraform = ""
+		fromCloudFormation = ""
+		region = ""
+	}()
+	region = ""
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	config := `{"version": "1.0", "resources": [{"type": "S3", "name": "bucket", "region": "us-east-1", "properties": {"storageClass": "STANDARD"}}]}`
+	if err := os.WriteFile(configFile, []byte(config), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	planFile := filepath.Join(dir, "plan.json")
+	plan := `{"planned_values": {"root_module": {"resources": [
+  {"address": "aws_instance.web", "mode": "managed", "type": "aws_instance", "values": {"instance_type": "t3.micro"}},
+  {"address": "aws_sqs_queue.jobs", "mode": "managed", "type": "aws_sqs_queue", "values": {}}
+]}}}`
+	if err := os.WriteFile(planFile, []byte(plan), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	templateFile := filepath.Join(dir, "template.yaml")
+	template := `Parameters:
+  Size: {Type: String, Default: t3.small}
+Resources:
+  Web:
+    Type: AWS::EC2::Instance
+    Properties:
+      InstanceType: !Ref Size
+`
+	if err := os.WriteFile(templateFile, []byte(template), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	t.Run("config file", func(t *testing.T) {
+		fromTerraform = ""
+		cfg, skipped, err := loadEstimateConfig([]string{configFile})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Resources) != 1 || len(skipped) != 0 {
+			t.Errorf("expected 1 resource and no skipped, got %d and %d", len(cfg.Resources), len(skipped))
+		}
+	})
+
+	t.Run("terraform plan", func(t *testing.T) {
+		fromTerraform = planFile
+		cfg, skipped, err := loadEstimateConfig(nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Resources) != 1 || cfg.Resources[0].Name != "aws_instance.web" {
+			t.Errorf("expected imported EC2 resource, got %+v", cfg.Resources)
+		}
+		if len(skipped) != 1 || skipped[0].Name != "aws_sqs_queue.jobs" {
+			t.Errorf("expected skipped SQS queue, got %+v", skipped)
+		}
+	})
+
+	t.Run("config file and terraform plan", func(t *testing.T) {
+		fromTerraform = planFile
+		if _, _, err := loadEstimateConfig([]string{configFile}); !errors.IsErrorType(err, errors.ValidationErrorType) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("cloudformation template", func(t *testing.T) {
+		fromTerraform = ""
+		fromCloudFormation = templateFile
+		region = "eu-west-1"
+		defer func() {
+			fromCloudFormation = ""
+			region = ""
+		}()
+
+		cfg, _, err := loadEstimateConfig(nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Resources) != 1 || cfg.Resources[0].Properties["instanceType"] != "t3.small" || cfg.Resources[0].Region != "eu-west-1" {
+			t.Errorf("expected imported EC2 resource, got %+v", cfg.Resources)
+		}
+	})
+
+	t.Run("terraform plan and cloudformation template", func(t *testing.T) {
+		fromTerraform = planFile
+		fromCloudFormation = templateFile
+		defer func() { fromCloudFormation = "" }()
+
+		if _, _, err := loadEstimateConfig(nil); !errors.IsErrorType(err, errors.ValidationErrorType) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("no input", func(t *testing.T) {
+		fromTerraform = ""
+		if _, _, err := loadEstimateConfig(nil); !errors.IsErrorType(err, errors.ValidationErrorType) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestNewPricingClientReplay(t *testing.T) {
+	defer func() {
+		replayPricing = ""
+		recordPricing = ""
+		priceListPath = ""
+	}()
+
+	fixturePath := filepath.Join(t.TempDir(), "fixture.json")
+	fixture := `{"version": 1, "interactions": [{"serviceCode": "AmazonEC2", "filters": {"instanceType": "t3.micro"}, "products": [{"sku": "TEST123"}]}]}`
+	if err := os.WriteFile(fixturePath, []byte(fixture), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	t.Run("replay fixture", func(t *testing.T) {
+		replayPricing = fixturePath
+		client, err := newPricingClient(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		products, err := client.GetProducts(context.Background(), "AmazonEC2", map[string]string{"instanceType": "t3.micro"})
+		if err != nil || len(products) != 1 || products[0].SKU != "TEST123" {
+			t.Errorf("expected recorded product, got %v (%v)", products, err)
+		}
+		if _, err := client.GetProducts(context.Background(), "AmazonEC2", map[string]string{"instanceType": "t3.large"}); err == nil {
+			t.Error("expected error for unrecorded query")
+		}
+	})
+
+	t.Run("replay with record", func(t *testing.T) {
+		replayPricing = fixturePath
+		recordPricing = filepath.Join(t.TempDir(), "out.json")
+		if _, err := newPricingClient(context.Background()); !errors.IsErrorType(err, errors.ValidationErrorType) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("replay skips disk cache", func(t *testing.T) {
+		replayPricing = fixturePath
+		recordPricing = ""
+		client := &MockAWSClient{}
+		if wrapped, diskCache := withDiskCache(client); wrapped != client || diskCache != nil {
+			t.Error("expected replayed pricing not to be cached")
+		}
+	})
+}
+
+func TestNewPricingClientOffline(t *testing.T) {
+	defer func() { priceListPath = "" }()
+
+	t.Run("missing price list", func(t *testing.T) {
+		priceListPath = filepath.Join(t.TempDir(), "missing")
+		if _, err := newPricingClient(context.Background()); !errors.IsErrorType(err, errors.FileErrorType) {
+			t.Errorf("expected file error, got %v", err)
+		}
+	})
+
+	t.Run("valid price list", func(t *testing.T) {
+		dir := t.TempDir()
+		offer := `{"offerCode": "AmazonEC2", "products": {}, "terms": {}}`
+		if err := os.WriteFile(filepath.Join(dir, "ec2.json"), []byte(offer), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		priceListPath = dir
+		client, err := newPricingClient(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client == nil {
+			t.Error("expected client but got nil")
+		}
+	})
+}
+
 // Test helper to capture command output
 func executeCommand(args ...string) (string, error) {
 	buf := new(bytes.Buffer)
@@ -393,9 +932,9 @@ func TestListCommand(t *testing.T) {
 // Benchmark tests
 func BenchmarkOutputTable(b *testing.B) {
 	result := &models.EstimationResult{
-		TotalHourlyCost:  10.0,
-		TotalDailyCost:   240.0,
-		TotalMonthlyCost: 7200.0,
+		TotalHourlyCost:  money.NewAmount(10.0),
+		TotalDailyCost:   money.NewAmount(240.0),
+		TotalMonthlyCost: money.NewAmount(7200.0),
 		Currency:         "USD",
 		ResourceCosts:    make([]models.CostEstimate, 10),
 	}
@@ -406,9 +945,9 @@ func BenchmarkOutputTable(b *testing.B) {
 			ResourceName: fmt.Sprintf("resource-%d", i),
 			ResourceType: "EC2",
 			Region:       "us-east-1",
-			HourlyCost:   1.0,
-			DailyCost:    24.0,
-			MonthlyCost:  720.0,
+			HourlyCost:   money.NewAmount(1.0),
+			DailyCost:    money.NewAmount(24.0),
+			MonthlyCost:  money.NewAmount(720.0),
 			Currency:     "USD",
 		}
 	}
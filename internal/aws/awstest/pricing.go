@@ -0,0 +1,55 @@
+// Package awstest provides AWS pricing products for tests. It is a test
+// helper and must only be imported from _test.go files.
+package awstest
+
+import (
+	"fmt"
+
+	"shylock/internal/interfaces"
+)
+
+// Product builds an on-demand pricing product with one price dimension per
+// tier of begin range, end range and price in USD
+func Product(sku, usageType string, tiers ...[3]string) interfaces.PricingProduct {
+	dimensions := make(map[string]interface{})
+	for i, tier := range tiers {
+		dimensions[fmt.Sprintf("%s.%d", sku, i)] = map[string]interface{}{
+			"beginRange":   tier[0],
+			"endRange":     tier[1],
+			"pricePerUnit": map[string]interface{}{"USD": tier[2]},
+		}
+	}
+	return interfaces.PricingProduct{
+		SKU:        sku,
+		Attributes: map[string]string{"usageType": usageType},
+		Terms: map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				sku + ".JRTCKXETXF": map[string]interface{}{"priceDimensions": dimensions},
+			},
+		},
+	}
+}
+
+// FlatProduct builds an on-demand pricing product with a single price in USD
+// for all usage
+func FlatProduct(sku, usageType, price string) interfaces.PricingProduct {
+	return Product(sku, usageType, [3]string{"0", "Inf", price})
+}
+
+// WithAttribute adds an attribute to a product, e.g. the volume type of an
+// EBS volume product
+func WithAttribute(product interfaces.PricingProduct, key, value string) interfaces.PricingProduct {
+	product.Attributes[key] = value
+	return product
+}
+
+// WithUnit sets the unit of every price dimension of a product
+func WithUnit(product interfaces.PricingProduct, unit string) interfaces.PricingProduct {
+	for _, term := range product.Terms["OnDemand"].(map[string]interface{}) {
+		dimensions := term.(map[string]interface{})["priceDimensions"].(map[string]interface{})
+		for _, dimension := range dimensions {
+			dimension.(map[string]interface{})["unit"] = unit
+		}
+	}
+	return product
+}
@@ -249,21 +249,7 @@ func (c *Client) GetRegions(ctx context.Context, serviceCode string) ([]string,
 	}
 
 	// Extract unique regions from product attributes
-	regionSet := make(map[string]bool)
-	for _, product := range products {
-		if location, exists := product.Attributes["location"]; exists && location != "" {
-			regionSet[location] = true
-		}
-		if region, exists := product.Attributes["regionCode"]; exists && region != "" {
-			regionSet[region] = true
-		}
-	}
-
-	// Convert set to slice
-	var regions []string
-	for region := range regionSet {
-		regions = append(regions, region)
-	}
+	regions := regionsFromProducts(products)
 
 	if len(regions) == 0 {
 		return nil, errors.APIError("no regions found for service").
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+)
+
+// PricingFixtureVersion is the pricing fixture file format version
+const PricingFixtureVersion = 1
+
+// PricingFixture is a recorded set of GetProducts interactions that can be
+// replayed for deterministic estimates
+type PricingFixture struct {
+	Version      int                  `json:"version"`
+	Interactions []PricingInteraction `json:"interactions"`
+}
+
+// PricingInteraction is a single recorded GetProducts request and response
+type PricingInteraction struct {
+	ServiceCode string                      `json:"serviceCode"`
+	Filters     map[string]string           `json:"filters"`
+	Products    []interfaces.PricingProduct `json:"products"`
+}
+
+// RecordingClient wraps a pricing client and captures every successful
+// GetProducts request/response pair so it can be saved as a fixture
+type RecordingClient struct {
+	client       interfaces.AWSPricingClient
+	interactions map[string]PricingInteraction // Query key -> interaction
+	mutex        sync.Mutex
+}
+
+// NewRecordingClient creates a pricing client that records GetProducts interactions
+func NewRecordingClient(client interfaces.AWSPricingClient) *RecordingClient {
+	return &RecordingClient{
+		client:       client,
+		interactions: make(map[string]PricingInteraction),
+	}
+}
+
+// GetProducts delegates to the wrapped client and records successful responses
+func (c *RecordingClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	products, err := c.client.GetProducts(ctx, serviceCode, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy filters so later changes by the caller don't alter the recording
+	recordedFilters := make(map[string]string, len(filters))
+	for key, value := range filters {
+		recordedFilters[key] = value
+	}
+
+	c.mutex.Lock()
+	c.interactions[fixtureKey(serviceCode, filters)] = PricingInteraction{
+		ServiceCode: serviceCode,
+		Filters:     recordedFilters,
+		Products:    products,
+	}
+	c.mutex.Unlock()
+
+	return products, nil
+}
+
+// DescribeServices delegates to the wrapped client (not recorded)
+func (c *RecordingClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return c.client.DescribeServices(ctx)
+}
+
+// GetRegions delegates to the wrapped client (not recorded)
+func (c *RecordingClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return c.client.GetRegions(ctx, serviceCode)
+}
+
+// Fixture returns the recorded interactions, sorted by query so that
+// fixture files diff cleanly in code review
+func (c *RecordingClient) Fixture() *PricingFixture {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	keys := make([]string, 0, len(c.interactions))
+	for key := range c.interactions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fixture := &PricingFixture{
+		Version:      PricingFixtureVersion,
+		Interactions: make([]PricingInteraction, 0, len(keys)),
+	}
+	for _, key := range keys {
+		fixture.Interactions = append(fixture.Interactions, c.interactions[key])
+	}
+
+	return fixture
+}
+
+// Save writes the recorded interactions to a fixture file
+func (c *RecordingClient) Save(path string) error {
+	data, err := json.MarshalIndent(c.Fixture(), "", "  ")
+	if err != nil {
+		return errors.FileErrorWithCause("failed to encode pricing fixture", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return errors.FileErrorWithCause("failed to write pricing fixture", err).
+			WithContext("fixturePath", path).
+			WithSuggestion("Check that the directory exists and is writable")
+	}
+
+	return nil
+}
+
+// ReplayClient implements the AWSPricingClient interface by serving
+// recorded fixture interactions. Queries that were not recorded fail
+// instead of falling back to AWS.
+type ReplayClient struct {
+	path         string
+	interactions map[string]PricingInteraction // Query key -> interaction
+}
+
+// NewReplayClient creates a pricing client that replays a recorded fixture file
+func NewReplayClient(path string) (interfaces.AWSPricingClient, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read pricing fixture", err).
+			WithContext("fixturePath", path).
+			WithSuggestion("Record a fixture first with --record-pricing")
+	}
+
+	var fixture PricingFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, errors.FileErrorWithCause("failed to parse pricing fixture", err).
+			WithContext("fixturePath", path)
+	}
+
+	if fixture.Version != PricingFixtureVersion {
+		return nil, errors.FileErrorf("unsupported pricing fixture version %d", fixture.Version).
+			WithContext("fixturePath", path).
+			WithContext("supportedVersion", PricingFixtureVersion).
+			WithSuggestion("Re-record the fixture with --record-pricing")
+	}
+
+	client := &ReplayClient{
+		path:         path,
+		interactions: make(map[string]PricingInteraction, len(fixture.Interactions)),
+	}
+	for _, interaction := range fixture.Interactions {
+		client.interactions[fixtureKey(interaction.ServiceCode, interaction.Filters)] = interaction
+	}
+
+	return client, nil
+}
+
+// GetProducts returns the recorded response for the query
+func (c *ReplayClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	interaction, exists := c.interactions[fixtureKey(serviceCode, filters)]
+	if !exists {
+		return nil, errors.APIError("pricing query not found in replay fixture").
+			WithContext("fixturePath", c.path).
+			WithContext("serviceCode", serviceCode).
+			WithContext("filters", formatFilters(filters)).
+			WithSuggestion("Re-record the fixture with --record-pricing after changing the configuration")
+	}
+
+	return interaction.Products, nil
+}
+
+// DescribeServices lists the services that have recorded interactions
+func (c *ReplayClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	attributes := make(map[string]map[string]string)
+	for _, interaction := range c.interactions {
+		if attributes[interaction.ServiceCode] == nil {
+			attributes[interaction.ServiceCode] = make(map[string]string)
+		}
+		for _, product := range interaction.Products {
+			for attr := range product.Attributes {
+				attributes[interaction.ServiceCode][attr] = ""
+			}
+		}
+	}
+
+	serviceCodes := make([]string, 0, len(attributes))
+	for serviceCode := range attributes {
+		serviceCodes = append(serviceCodes, serviceCode)
+	}
+	sort.Strings(serviceCodes)
+
+	services := make([]interfaces.ServiceInfo, 0, len(serviceCodes))
+	for _, serviceCode := range serviceCodes {
+		services = append(services, interfaces.ServiceInfo{
+			ServiceCode: serviceCode,
+			ServiceName: serviceCode,
+			Attributes:  attributes[serviceCode],
+		})
+	}
+
+	return services, nil
+}
+
+// GetRegions returns the regions found in a service's recorded products
+func (c *ReplayClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	var products []interfaces.PricingProduct
+	for _, interaction := range c.interactions {
+		if interaction.ServiceCode == serviceCode {
+			products = append(products, interaction.Products...)
+		}
+	}
+
+	regions := regionsFromProducts(products)
+	if len(regions) == 0 {
+		return nil, errors.APIError("no recorded regions for service").
+			WithContext("fixturePath", c.path).
+			WithContext("serviceCode", serviceCode)
+	}
+
+	return regions, nil
+}
+
+// fixtureKey creates a deterministic, human-readable key for a pricing query
+func fixtureKey(serviceCode string, filters map[string]string) string {
+	return serviceCode + "?" + formatFilters(filters)
+}
+
+// formatFilters formats filters as sorted key=value pairs
+func formatFilters(filters map[string]string) string {
+	pairs := make([]string, 0, len(filters))
+	for key, value := range filters {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
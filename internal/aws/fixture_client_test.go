@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/money"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	offline, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	recorder := NewRecordingClient(offline)
+	recorded, err := NewPricingService(recorder).GetEC2Pricing(ctx, "t3.micro", "us-east-1", "Linux")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fixturePath := filepath.Join(t.TempDir(), "pricing.json")
+	if err := recorder.Save(fixturePath); err != nil {
+		t.Fatalf("unexpected error saving fixture: %v", err)
+	}
+
+	replay, err := NewReplayClient(fixturePath)
+	if err != nil {
+		t.Fatalf("unexpected error loading fixture: %v", err)
+	}
+	service := NewPricingService(replay)
+
+	t.Run("recorded query", func(t *testing.T) {
+		products, err := service.GetEC2Pricing(ctx, "t3.micro", "us-east-1", "Linux")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(products) != len(recorded) || products[0].SKU != recorded[0].SKU {
+			t.Fatalf("expected replayed products to match recording, got %+v", products)
+		}
+		price, err := service.ExtractHourlyPrice(products[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !price.Equal(money.NewAmount(0.0104)) {
+			t.Errorf("expected price 0.0104, got %s", price)
+		}
+	})
+
+	t.Run("unrecorded query", func(t *testing.T) {
+		_, err := service.GetEC2Pricing(ctx, "t3.micro", "us-west-2", "Linux")
+		if !errors.IsErrorType(err, errors.APIErrorType) {
+			t.Errorf("expected API error for unrecorded query, got %v", err)
+		}
+	})
+
+	t.Run("services and regions", func(t *testing.T) {
+		services, err := replay.DescribeServices(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(services) != 1 || services[0].ServiceCode != "AmazonEC2" {
+			t.Errorf("expected AmazonEC2 service, got %+v", services)
+		}
+
+		regions, err := replay.GetRegions(ctx, "AmazonEC2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(regions) != 2 || regions[1] != "us-east-1" {
+			t.Errorf("expected location and region code of the recorded product, got %v", regions)
+		}
+
+		if _, err := replay.GetRegions(ctx, "AmazonS3"); err == nil {
+			t.Error("expected error for service without recordings")
+		}
+	})
+}
+
+func TestRecordingClientErrorsNotRecorded(t *testing.T) {
+	recorder := NewRecordingClient(&MockAWSClient{shouldFailGet: true})
+
+	if _, err := recorder.GetProducts(context.Background(), "AmazonEC2", nil); err == nil {
+		t.Fatal("expected error from wrapped client")
+	}
+	if fixture := recorder.Fixture(); len(fixture.Interactions) != 0 {
+		t.Errorf("expected failed queries not to be recorded, got %d", len(fixture.Interactions))
+	}
+}
+
+func TestRecordingClientConcurrent(t *testing.T) {
+	recorder := NewRecordingClient(&MockAWSClient{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			filters := map[string]string{"instanceType": fmt.Sprintf("t3.%d", i%5)}
+			recorder.GetProducts(context.Background(), "AmazonEC2", filters)
+		}(i)
+	}
+	wg.Wait()
+
+	fixture := recorder.Fixture()
+	if fixture.Version != PricingFixtureVersion {
+		t.Errorf("expected version %d, got %d", PricingFixtureVersion, fixture.Version)
+	}
+	if len(fixture.Interactions) != 5 {
+		t.Fatalf("expected duplicate queries to be recorded once, got %d", len(fixture.Interactions))
+	}
+	for i, interaction := range fixture.Interactions {
+		if expected := fmt.Sprintf("t3.%d", i); interaction.Filters["instanceType"] != expected {
+			t.Errorf("expected interactions sorted by query, got %s at %d", interaction.Filters["instanceType"], i)
+		}
+	}
+}
+
+func TestNewReplayClientErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "invalid JSON",
+			content: `{"version": 1, "interactions": [`,
+		},
+		{
+			name:    "unsupported version",
+			content: `{"version": 99, "interactions": []}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "fixture.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to create test file: %v", err)
+			}
+
+			if _, err := NewReplayClient(path); !errors.IsErrorType(err, errors.FileErrorType) {
+				t.Errorf("expected file error, got %v", err)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := NewReplayClient(filepath.Join(dir, "missing.json")); !errors.IsErrorType(err, errors.FileErrorType) {
+			t.Errorf("expected file error, got %v", err)
+		}
+	})
+}
+
+func TestFixtureKey(t *testing.T) {
+	a := fixtureKey("AmazonEC2", map[string]string{"location": "US East (N. Virginia)", "instanceType": "t3.micro"})
+	b := fixtureKey("AmazonEC2", map[string]string{"instanceType": "t3.micro", "location": "US East (N. Virginia)"})
+
+	if a != b {
+		t.Errorf("expected filter order not to matter, got %q and %q", a, b)
+	}
+	if expected := "AmazonEC2?instanceType=t3.micro&location=US East (N. Virginia)"; a != expected {
+		t.Errorf("expected %q, got %q", expected, a)
+	}
+	if fixtureKey("AmazonS3", nil) == fixtureKey("AmazonEC2", nil) {
+		t.Error("expected service code to be part of the key")
+	}
+}
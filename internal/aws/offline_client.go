@@ -0,0 +1,607 @@
+package aws
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+)
+
+// OfflineClient implements the AWSPricingClient interface using locally
+// downloaded AWS bulk Price List offer files (JSON or CSV) instead of the
+// live Pricing API. It needs no AWS credentials or network access.
+type OfflineClient struct {
+	services map[string]*offerService // Service code -> offer files; fixed after construction
+}
+
+// offerService holds the offer files of one service and their products once
+// loaded. Each service has its own lock, so parsing a large offer file only
+// blocks callers that need the same service.
+type offerService struct {
+	paths    []string
+	products []interfaces.PricingProduct
+	loaded   bool
+	mutex    sync.Mutex
+}
+
+// OfflineClientConfig holds configuration for the offline pricing client
+type OfflineClientConfig struct {
+	// PriceListPath is an offer file or a directory searched recursively for
+	// offer files (*.json, *.csv)
+	PriceListPath string
+}
+
+// offerFile mirrors the top-level structure of a bulk Price List JSON offer file
+type offerFile struct {
+	OfferCode string                                       `json:"offerCode"`
+	Products  map[string]offerProduct                      `json:"products"`
+	Terms     map[string]map[string]map[string]interface{} `json:"terms"`
+}
+
+// offerProduct mirrors a product entry in a bulk Price List JSON offer file
+type offerProduct struct {
+	SKU           string            `json:"sku"`
+	ProductFamily string            `json:"productFamily"`
+	Attributes    map[string]string `json:"attributes"`
+}
+
+// csvMetadataLines is the number of metadata lines preceding the header row in CSV offer files
+const csvMetadataLines = 5
+
+// csvAttributeNames maps CSV offer file column names that don't follow the
+// usual "Title Case" to camelCase convention onto their Pricing API attribute names
+var csvAttributeNames = map[string]string{
+	"serviceCode":       "servicecode",
+	"Pre Installed S/W": "preInstalledSw",
+	"vCPU":              "vcpu",
+	"usageType":         "usagetype",
+}
+
+// NewOfflineClient creates a pricing client backed by local bulk Price List offer files
+func NewOfflineClient(clientConfig *OfflineClientConfig) (interfaces.AWSPricingClient, error) {
+	if clientConfig == nil || clientConfig.PriceListPath == "" {
+		return nil, errors.ConfigError("price list path cannot be empty").
+			WithSuggestion("Provide a bulk Price List offer file or a directory containing offer files")
+	}
+
+	info, err := os.Stat(clientConfig.PriceListPath)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("price list path is not accessible", err).
+			WithContext("priceListPath", clientConfig.PriceListPath).
+			WithSuggestion("Check that the path exists and is readable")
+	}
+
+	client := &OfflineClient{
+		services: make(map[string]*offerService),
+	}
+
+	var paths []string
+	if info.IsDir() {
+		err = filepath.WalkDir(clientConfig.PriceListPath, func(path string, entry os.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if !entry.IsDir() && isOfferFile(path) {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.FileErrorWithCause("failed to scan price list directory", err).
+				WithContext("priceListPath", clientConfig.PriceListPath)
+		}
+	} else {
+		paths = append(paths, clientConfig.PriceListPath)
+	}
+
+	// Index offer files by service code without loading their contents
+	for _, path := range paths {
+		offerCode, err := readOfferCode(path)
+		if err != nil {
+			return nil, err
+		}
+		service, exists := client.services[offerCode]
+		if !exists {
+			service = &offerService{}
+			client.services[offerCode] = service
+		}
+		service.paths = append(service.paths, path)
+	}
+
+	if len(client.services) == 0 {
+		return nil, errors.FileError("no offer files found").
+			WithContext("priceListPath", clientConfig.PriceListPath).
+			WithSuggestion("Download offer files from https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/index.json").
+			WithSuggestion("Offer files must have a .json or .csv extension")
+	}
+
+	return client, nil
+}
+
+// GetProducts returns the products of a service's offer files that match all filters
+func (c *OfflineClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if serviceCode == "" {
+		return nil, errors.ValidationError("service code cannot be empty").
+			WithSuggestion("Provide a valid AWS service code (e.g., 'AmazonEC2', 'AmazonS3')")
+	}
+
+	products, err := c.loadService(ctx, serviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []interfaces.PricingProduct
+	for _, product := range products {
+		if matchesFilters(product, filters) {
+			matched = append(matched, product)
+		}
+	}
+
+	return matched, nil
+}
+
+// DescribeServices lists the services that have local offer files
+func (c *OfflineClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	var services []interfaces.ServiceInfo
+
+	for _, serviceCode := range c.serviceCodes() {
+		products, err := c.loadService(ctx, serviceCode)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceInfo := interfaces.ServiceInfo{
+			ServiceCode: serviceCode,
+			ServiceName: serviceCode,
+			Attributes:  make(map[string]string),
+		}
+		for _, product := range products {
+			for attr := range product.Attributes {
+				serviceInfo.Attributes[attr] = ""
+			}
+		}
+
+		services = append(services, serviceInfo)
+	}
+
+	return services, nil
+}
+
+// GetRegions returns the regions that have pricing in a service's offer files
+func (c *OfflineClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	if serviceCode == "" {
+		return nil, errors.ValidationError("service code cannot be empty").
+			WithSuggestion("Provide a valid AWS service code")
+	}
+
+	products, err := c.loadService(ctx, serviceCode)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to get regions for service").
+			WithContext("serviceCode", serviceCode)
+	}
+
+	regions := regionsFromProducts(products)
+	if len(regions) == 0 {
+		return nil, errors.APIError("no regions found for service").
+			WithContext("serviceCode", serviceCode).
+			WithSuggestion("Check that the offer file contains regional products")
+	}
+
+	return regions, nil
+}
+
+// serviceCodes returns the indexed service codes in sorted order
+func (c *OfflineClient) serviceCodes() []string {
+	codes := make([]string, 0, len(c.services))
+	for code := range c.services {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// loadService parses a service's offer files on first use and returns its
+// products. A load that fails, e.g. because its context was cancelled, is
+// retried by the next caller.
+func (c *OfflineClient) loadService(ctx context.Context, serviceCode string) ([]interfaces.PricingProduct, error) {
+	service, exists := c.services[serviceCode]
+	if !exists {
+		return nil, errors.APIError("no offer file found for service").
+			WithContext("serviceCode", serviceCode).
+			WithSuggestion("Download the offer file from https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/" + serviceCode + "/current/index.json").
+			WithSuggestion("Place the offer file in the price list directory")
+	}
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	if service.loaded {
+		return service.products, nil
+	}
+
+	var products []interfaces.PricingProduct
+	for _, path := range service.paths {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.APIErrorWithCause("loading offer files was cancelled", err).
+				WithContext("serviceCode", serviceCode)
+		}
+
+		var fileProducts []interfaces.PricingProduct
+		var err error
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			fileProducts, err = parseCSVOfferFile(path, serviceCode)
+		} else {
+			fileProducts, err = parseJSONOfferFile(path, serviceCode)
+		}
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, fileProducts...)
+	}
+
+	service.products, service.loaded = products, true
+	return products, nil
+}
+
+// matchesFilters applies TERM_MATCH semantics: every filter field must be
+// present on the product with exactly the filter value. Field names are
+// matched case-insensitively, as the Pricing API does.
+func matchesFilters(product interfaces.PricingProduct, filters map[string]string) bool {
+	for field, value := range filters {
+		actual, exists := productField(product, field)
+		if !exists || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// productField looks up a filterable field on a product
+func productField(product interfaces.PricingProduct, field string) (string, bool) {
+	switch strings.ToLower(field) {
+	case "productfamily":
+		return product.ProductFamily, product.ProductFamily != ""
+	case "sku":
+		return product.SKU, product.SKU != ""
+	case "servicecode":
+		if value, exists := product.Attributes["servicecode"]; exists {
+			return value, true
+		}
+		return product.ServiceCode, product.ServiceCode != ""
+	}
+
+	if value, exists := product.Attributes[field]; exists {
+		return value, true
+	}
+	for key, value := range product.Attributes {
+		if strings.EqualFold(key, field) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// regionsFromProducts extracts the unique locations and region codes of products
+func regionsFromProducts(products []interfaces.PricingProduct) []string {
+	regionSet := make(map[string]bool)
+	for _, product := range products {
+		if location, exists := product.Attributes["location"]; exists && location != "" {
+			regionSet[location] = true
+		}
+		if region, exists := product.Attributes["regionCode"]; exists && region != "" {
+			regionSet[region] = true
+		}
+	}
+
+	var regions []string
+	for region := range regionSet {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// isOfferFile reports whether a path looks like a bulk Price List offer file
+func isOfferFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".json" || ext == ".csv"
+}
+
+// readOfferCode reads the offer (service) code from an offer file header.
+// Files without one fall back to their base name, or to their parent
+// directory for the "current/index.json" layout of the bulk API.
+func readOfferCode(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.FileErrorWithCause("failed to open offer file", err).
+			WithContext("offerFile", path)
+	}
+	defer file.Close()
+
+	var offerCode string
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		offerCode = readCSVOfferCode(file)
+	} else {
+		offerCode = readJSONOfferCode(file)
+	}
+
+	if offerCode == "" {
+		base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if base == "index" {
+			base = filepath.Base(filepath.Dir(path))
+			if base == "current" {
+				base = filepath.Base(filepath.Dir(filepath.Dir(path)))
+			}
+		}
+		offerCode = base
+	}
+
+	return offerCode, nil
+}
+
+// readJSONOfferCode streams top-level keys until "offerCode" is found,
+// stopping before the (potentially very large) products and terms sections
+func readJSONOfferCode(reader io.Reader) string {
+	decoder := json.NewDecoder(reader)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return ""
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		key, _ := token.(string)
+		if key == "products" || key == "terms" {
+			return ""
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return ""
+		}
+		if key == "offerCode" {
+			var offerCode string
+			if json.Unmarshal(value, &offerCode) == nil {
+				return offerCode
+			}
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// readCSVOfferCode reads the "OfferCode" line from a CSV offer file's metadata
+func readCSVOfferCode(reader io.Reader) string {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	for i := 0; i < csvMetadataLines; i++ {
+		record, err := csvReader.Read()
+		if err != nil {
+			return ""
+		}
+		if len(record) >= 2 && record[0] == "OfferCode" {
+			return record[1]
+		}
+	}
+	return ""
+}
+
+// parseJSONOfferFile loads all products and their terms from a JSON offer file
+func parseJSONOfferFile(path, serviceCode string) ([]interfaces.PricingProduct, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to open offer file", err).
+			WithContext("offerFile", path)
+	}
+	defer file.Close()
+
+	var offer offerFile
+	if err := json.NewDecoder(file).Decode(&offer); err != nil {
+		return nil, errors.FileErrorWithCause("failed to parse JSON offer file", err).
+			WithContext("offerFile", path).
+			WithSuggestion("Ensure the file is an unmodified AWS bulk Price List JSON offer file")
+	}
+
+	products := make([]interfaces.PricingProduct, 0, len(offer.Products))
+	for sku, entry := range offer.Products {
+		product := interfaces.PricingProduct{
+			SKU:           entry.SKU,
+			ProductFamily: entry.ProductFamily,
+			ServiceCode:   serviceCode,
+			Attributes:    entry.Attributes,
+			Terms:         make(map[string]interface{}),
+		}
+		if product.SKU == "" {
+			product.SKU = sku
+		}
+		if product.Attributes == nil {
+			product.Attributes = make(map[string]string)
+		}
+
+		for termType, termsBySKU := range offer.Terms {
+			if skuTerms, exists := termsBySKU[product.SKU]; exists {
+				product.Terms[termType] = skuTerms
+			}
+		}
+
+		products = append(products, product)
+	}
+
+	// Map iteration order is random; keep results deterministic
+	sort.Slice(products, func(i, j int) bool {
+		return products[i].SKU < products[j].SKU
+	})
+
+	return products, nil
+}
+
+// parseCSVOfferFile loads all products and their terms from a CSV offer file.
+// Each CSV row is one price dimension of one term of one product.
+func parseCSVOfferFile(path, serviceCode string) ([]interfaces.PricingProduct, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to open offer file", err).
+			WithContext("offerFile", path)
+	}
+	defer file.Close()
+
+	csvReader := csv.NewReader(file)
+	csvReader.FieldsPerRecord = -1
+
+	for i := 0; i < csvMetadataLines; i++ {
+		if _, err := csvReader.Read(); err != nil {
+			return nil, errors.FileErrorWithCause("failed to read CSV offer file metadata", err).
+				WithContext("offerFile", path)
+		}
+	}
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read CSV offer file header", err).
+			WithContext("offerFile", path)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, required := range []string{"SKU", "TermType", "OfferTermCode", "RateCode", "PricePerUnit", "Currency"} {
+		if _, exists := columns[required]; !exists {
+			return nil, errors.FileErrorf("CSV offer file is missing column '%s'", required).
+				WithContext("offerFile", path).
+				WithSuggestion("Ensure the file is an unmodified AWS bulk Price List CSV offer file")
+		}
+	}
+
+	termColumns := map[string]bool{
+		"SKU": true, "OfferTermCode": true, "RateCode": true, "TermType": true,
+		"PriceDescription": true, "EffectiveDate": true, "StartingRange": true,
+		"EndingRange": true, "Unit": true, "PricePerUnit": true, "Currency": true,
+		"RelatedTo": true, "LeaseContractLength": true, "PurchaseOption": true,
+		"OfferingClass": true, "Product Family": true,
+	}
+
+	productsBySKU := make(map[string]*interfaces.PricingProduct)
+	var order []string
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.FileErrorWithCause("failed to read CSV offer file row", err).
+				WithContext("offerFile", path)
+		}
+
+		field := func(name string) string {
+			if index, exists := columns[name]; exists && index < len(record) {
+				return record[index]
+			}
+			return ""
+		}
+
+		sku := field("SKU")
+		product, exists := productsBySKU[sku]
+		if !exists {
+			product = &interfaces.PricingProduct{
+				SKU:           sku,
+				ProductFamily: field("Product Family"),
+				ServiceCode:   serviceCode,
+				Attributes:    make(map[string]string),
+				Terms:         make(map[string]interface{}),
+			}
+			for i, name := range header {
+				if termColumns[name] || i >= len(record) || record[i] == "" {
+					continue
+				}
+				product.Attributes[csvAttributeName(name)] = record[i]
+			}
+			productsBySKU[sku] = product
+			order = append(order, sku)
+		}
+
+		// Rebuild the nested JSON term structure so ExtractHourlyPrice works unchanged
+		termType := field("TermType")
+		termsForType, _ := product.Terms[termType].(map[string]interface{})
+		if termsForType == nil {
+			termsForType = make(map[string]interface{})
+			product.Terms[termType] = termsForType
+		}
+
+		termKey := sku + "." + field("OfferTermCode")
+		term, _ := termsForType[termKey].(map[string]interface{})
+		if term == nil {
+			termAttributes := make(map[string]interface{})
+			for _, name := range []string{"LeaseContractLength", "PurchaseOption", "OfferingClass"} {
+				if value := field(name); value != "" {
+					termAttributes[name] = value
+				}
+			}
+			term = map[string]interface{}{
+				"offerTermCode":   field("OfferTermCode"),
+				"sku":             sku,
+				"effectiveDate":   field("EffectiveDate"),
+				"priceDimensions": make(map[string]interface{}),
+				"termAttributes":  termAttributes,
+			}
+			termsForType[termKey] = term
+		}
+
+		dimensions := term["priceDimensions"].(map[string]interface{})
+		dimensions[field("RateCode")] = map[string]interface{}{
+			"rateCode":     field("RateCode"),
+			"description":  field("PriceDescription"),
+			"beginRange":   field("StartingRange"),
+			"endRange":     field("EndingRange"),
+			"unit":         field("Unit"),
+			"pricePerUnit": map[string]interface{}{field("Currency"): field("PricePerUnit")},
+			"appliesTo":    []interface{}{},
+		}
+	}
+
+	products := make([]interfaces.PricingProduct, 0, len(order))
+	for _, sku := range order {
+		products = append(products, *productsBySKU[sku])
+	}
+
+	return products, nil
+}
+
+// csvAttributeName converts a CSV offer file column name (e.g. "Instance Type")
+// to the attribute name used by the Pricing API (e.g. "instanceType")
+func csvAttributeName(column string) string {
+	if name, exists := csvAttributeNames[column]; exists {
+		return name
+	}
+
+	words := strings.FieldsFunc(column, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return column
+	}
+
+	var result strings.Builder
+	for i, word := range words {
+		if i == 0 {
+			result.WriteString(strings.ToLower(word[:1]) + word[1:])
+			continue
+		}
+		result.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return result.String()
+}
@@ -0,0 +1,413 @@
+package aws
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/money"
+)
+
+const testJSONOffer = `{
+  "formatVersion": "v1.0",
+  "disclaimer": "test",
+  "offerCode": "AmazonEC2",
+  "version": "20250101000000",
+  "publicationDate": "2025-01-01T00:00:00Z",
+  "products": {
+    "SKU1": {
+      "sku": "SKU1",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "servicecode": "AmazonEC2",
+        "location": "US East (N. Virginia)",
+        "regionCode": "us-east-1",
+        "instanceType": "t3.micro",
+        "operatingSystem": "Linux",
+        "tenancy": "Shared",
+        "preInstalledSw": "NA"
+      }
+    },
+    "SKU2": {
+      "sku": "SKU2",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "servicecode": "AmazonEC2",
+        "location": "US West (Oregon)",
+        "regionCode": "us-west-2",
+        "instanceType": "t3.micro",
+        "operatingSystem": "Windows",
+        "tenancy": "Shared",
+        "preInstalledSw": "NA"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU1": {
+        "SKU1.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU1",
+          "effectiveDate": "2025-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU1.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU1.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.0104 per On Demand Linux t3.micro Instance Hour",
+              "beginRange": "0",
+              "endRange": "Inf",
+              "unit": "Hrs",
+              "pricePerUnit": {"USD": "0.0104000000"},
+              "appliesTo": []
+            }
+          },
+          "termAttributes": {}
+        }
+      },
+      "SKU2": {
+        "SKU2.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU2",
+          "effectiveDate": "2025-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU2.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU2.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.0196 per On Demand Windows t3.micro Instance Hour",
+              "beginRange": "0",
+              "endRange": "Inf",
+              "unit": "Hrs",
+              "pricePerUnit": {"USD": "0.0196000000"},
+              "appliesTo": []
+            }
+          },
+          "termAttributes": {}
+        }
+      }
+    }
+  }
+}`
+
+const testCSVOffer = `"FormatVersion","v1.0"
+"Disclaimer","test"
+"Publication Date","2025-01-01T00:00:00Z"
+"Version","20250101000000"
+"OfferCode","AmazonRDS"
+"SKU","OfferTermCode","RateCode","TermType","PriceDescription","EffectiveDate","StartingRange","EndingRange","Unit","PricePerUnit","Currency","RelatedTo","LeaseContractLength","PurchaseOption","OfferingClass","Product Family","serviceCode","Location","Instance Type","Database Engine","Deployment Option","usageType"
+"RDS1","JRTCKXETXF","RDS1.JRTCKXETXF.6YS6EN2CT7","OnDemand","$0.017 per RDS db.t3.micro instance hour","2025-01-01","0","Inf","Hrs","0.0170000000","USD","","","","","Database Instance","AmazonRDS","US East (N. Virginia)","db.t3.micro","MySQL","Single-AZ","InstanceUsage:db.t3.micro"
+"RDS1","HU7G6KETJZ","RDS1.HU7G6KETJZ.2TG2D8R56U","Reserved","Upfront Fee","2025-01-01","","","Quantity","51","USD","","1yr","Partial Upfront","standard","Database Instance","AmazonRDS","US East (N. Virginia)","db.t3.micro","MySQL","Single-AZ","InstanceUsage:db.t3.micro"
+"RDS1","HU7G6KETJZ","RDS1.HU7G6KETJZ.6YS6EN2CT7","Reserved","db.t3.micro reserved hourly","2025-01-01","0","Inf","Hrs","0.0060000000","USD","","1yr","Partial Upfront","standard","Database Instance","AmazonRDS","US East (N. Virginia)","db.t3.micro","MySQL","Single-AZ","InstanceUsage:db.t3.micro"
+`
+
+func writeTestOffers(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "ec2.json"), []byte(testJSONOffer), 0644); err != nil {
+		t.Fatalf("failed to write JSON offer: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "rds.csv"), []byte(testCSVOffer), 0644); err != nil {
+		t.Fatalf("failed to write CSV offer: %v", err)
+	}
+
+	return dir
+}
+
+func TestNewOfflineClient(t *testing.T) {
+	t.Run("valid directory", func(t *testing.T) {
+		client, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client == nil {
+			t.Fatal("expected client but got nil")
+		}
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		_, err := NewOfflineClient(nil)
+		if !errors.IsErrorType(err, errors.ConfigErrorType) {
+			t.Errorf("expected config error, got %v", err)
+		}
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: filepath.Join(t.TempDir(), "missing")})
+		if !errors.IsErrorType(err, errors.FileErrorType) {
+			t.Errorf("expected file error, got %v", err)
+		}
+	})
+
+	t.Run("empty directory", func(t *testing.T) {
+		_, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: t.TempDir()})
+		if !errors.IsErrorType(err, errors.FileErrorType) {
+			t.Errorf("expected file error, got %v", err)
+		}
+	})
+}
+
+func TestOfflineClientGetProducts(t *testing.T) {
+	client, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		serviceCode  string
+		filters      map[string]string
+		expectedSKUs []string
+		expectError  bool
+		errorType    errors.ErrorType
+	}{
+		{
+			name:        "EC2 filters used by PricingService",
+			serviceCode: "AmazonEC2",
+			filters: map[string]string{
+				"servicecode":     "AmazonEC2",
+				"instanceType":    "t3.micro",
+				"location":        "US East (N. Virginia)",
+				"operatingSystem": "Linux",
+				"tenancy":         "Shared",
+				"preInstalledSw":  "NA",
+			},
+			expectedSKUs: []string{"SKU1"},
+		},
+		{
+			name:         "no filters returns all products",
+			serviceCode:  "AmazonEC2",
+			filters:      map[string]string{},
+			expectedSKUs: []string{"SKU1", "SKU2"},
+		},
+		{
+			name:         "field names are case-insensitive",
+			serviceCode:  "AmazonEC2",
+			filters:      map[string]string{"INSTANCETYPE": "t3.micro", "productFamily": "Compute Instance"},
+			expectedSKUs: []string{"SKU1", "SKU2"},
+		},
+		{
+			name:         "values must match exactly",
+			serviceCode:  "AmazonEC2",
+			filters:      map[string]string{"operatingSystem": "linux"},
+			expectedSKUs: nil,
+		},
+		{
+			name:         "missing attribute does not match",
+			serviceCode:  "AmazonEC2",
+			filters:      map[string]string{"databaseEngine": "MySQL"},
+			expectedSKUs: nil,
+		},
+		{
+			name:        "CSV offer file",
+			serviceCode: "AmazonRDS",
+			filters: map[string]string{
+				"location":         "US East (N. Virginia)",
+				"instanceType":     "db.t3.micro",
+				"databaseEngine":   "MySQL",
+				"deploymentOption": "Single-AZ",
+			},
+			expectedSKUs: []string{"RDS1"},
+		},
+		{
+			name:        "unknown service",
+			serviceCode: "AmazonDynamoDB",
+			expectError: true,
+			errorType:   errors.APIErrorType,
+		},
+		{
+			name:        "empty service code",
+			serviceCode: "",
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products, err := client.GetProducts(ctx, tt.serviceCode, tt.filters)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if !errors.IsErrorType(err, tt.errorType) {
+					t.Errorf("expected error type %s, got %s", tt.errorType, errors.GetErrorType(err))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(products) != len(tt.expectedSKUs) {
+				t.Fatalf("expected %d products, got %d", len(tt.expectedSKUs), len(products))
+			}
+			for i, sku := range tt.expectedSKUs {
+				if products[i].SKU != sku {
+					t.Errorf("expected SKU %s at index %d, got %s", sku, i, products[i].SKU)
+				}
+			}
+		})
+	}
+}
+
+func TestOfflineClientPricingCompatibility(t *testing.T) {
+	client, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	service := NewPricingService(client)
+	ctx := context.Background()
+
+	t.Run("JSON offer", func(t *testing.T) {
+		products, err := service.GetEC2Pricing(ctx, "t3.micro", "us-east-1", "Linux")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		price, err := service.ExtractHourlyPrice(products[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !price.Equal(money.NewAmount(0.0104)) {
+			t.Errorf("expected price 0.0104, got %s", price)
+		}
+	})
+
+	t.Run("CSV offer", func(t *testing.T) {
+		products, err := service.GetRDSPricing(ctx, "db.t3.micro", "mysql", "us-east-1", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if products[0].Attributes["usagetype"] != "InstanceUsage:db.t3.micro" {
+			t.Errorf("expected usagetype attribute, got %v", products[0].Attributes)
+		}
+		price, err := service.ExtractHourlyPrice(products[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !price.Equal(money.NewAmount(0.017)) {
+			t.Errorf("expected price 0.017, got %s", price)
+		}
+		if _, exists := products[0].Terms["Reserved"]; !exists {
+			t.Error("expected reserved terms to be preserved")
+		}
+	})
+}
+
+func TestOfflineClientServicesAndRegions(t *testing.T) {
+	client, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	services, err := client.DescribeServices(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(services) != 2 || services[0].ServiceCode != "AmazonEC2" || services[1].ServiceCode != "AmazonRDS" {
+		t.Errorf("unexpected services: %+v", services)
+	}
+	if _, exists := services[0].Attributes["instanceType"]; !exists {
+		t.Error("expected instanceType attribute for AmazonEC2")
+	}
+
+	regions, err := client.GetRegions(ctx, "AmazonEC2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"US East (N. Virginia)", "US West (Oregon)", "us-east-1", "us-west-2"}
+	if len(regions) != len(expected) {
+		t.Fatalf("expected %d regions, got %v", len(expected), regions)
+	}
+	for i, region := range expected {
+		if regions[i] != region {
+			t.Errorf("expected region %s at index %d, got %s", region, i, regions[i])
+		}
+	}
+}
+
+func TestOfflineClientLoadService(t *testing.T) {
+	pricingClient, err := NewOfflineClient(&OfflineClientConfig{PriceListPath: writeTestOffers(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := pricingClient.(*OfflineClient)
+
+	t.Run("cancelled load is retried", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.GetProducts(ctx, "AmazonEC2", nil); !errors.IsErrorType(err, errors.APIErrorType) {
+			t.Fatalf("expected API error for a cancelled load, got %v", err)
+		}
+
+		products, err := client.GetProducts(context.Background(), "AmazonEC2", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(products) == 0 {
+			t.Error("expected products after retrying the load")
+		}
+	})
+
+	t.Run("services load independently", func(t *testing.T) {
+		// Hold the AmazonRDS lock as a parse in progress would
+		rds := client.services["AmazonRDS"]
+		rds.mutex.Lock()
+		defer rds.mutex.Unlock()
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := client.GetProducts(context.Background(), "AmazonEC2", nil)
+			done <- err
+		}()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("loading AmazonEC2 waited for AmazonRDS")
+		}
+	})
+}
+
+func TestReadOfferCodeFallback(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "AmazonS3", "current")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	path := filepath.Join(dir, "index.json")
+	if err := os.WriteFile(path, []byte(`{"products": {}, "terms": {}}`), 0644); err != nil {
+		t.Fatalf("failed to write offer: %v", err)
+	}
+
+	offerCode, err := readOfferCode(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if offerCode != "AmazonS3" {
+		t.Errorf("expected offer code 'AmazonS3', got '%s'", offerCode)
+	}
+}
+
+func TestCSVAttributeName(t *testing.T) {
+	tests := map[string]string{
+		"Instance Type":     "instanceType",
+		"Location":          "location",
+		"Operating System":  "operatingSystem",
+		"Pre Installed S/W": "preInstalledSw",
+		"serviceCode":       "servicecode",
+		"usageType":         "usagetype",
+		"Region Code":       "regionCode",
+	}
+
+	for column, expected := range tests {
+		if actual := csvAttributeName(column); actual != expected {
+			t.Errorf("csvAttributeName(%q) = %q, expected %q", column, actual, expected)
+		}
+	}
+}
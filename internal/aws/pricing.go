@@ -3,11 +3,11 @@ package aws
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
+	"shylock/internal/money"
 )
 
 // PricingService handles AWS pricing data retrieval and filtering
@@ -305,10 +305,569 @@ func (p *PricingService) GetS3Pricing(ctx context.Context, storageClass, region
 	return products, nil
 }
 
+// GetEBSPricing retrieves EBS pricing information for a volume type and region:
+// storage, and provisioned IOPS and throughput where the volume type has them
+func (p *PricingService) GetEBSPricing(ctx context.Context, volumeType, region string) ([]interfaces.PricingProduct, error) {
+	if volumeType == "" {
+		return nil, errors.ValidationError("volume type cannot be empty").
+			WithSuggestion("Provide a valid EBS volume type (e.g., 'gp3', 'io2')")
+	}
+
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	// EBS pricing is under the "AmazonEC2" service code
+	filters := map[string]string{
+		"servicecode":   "AmazonEC2",
+		"location":      location,
+		"volumeApiName": volumeType,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonEC2", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve EBS pricing").
+			WithContext("volumeType", volumeType).
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no EBS pricing data found").
+			WithContext("volumeType", volumeType).
+			WithContext("region", region).
+			WithSuggestion("Check that the volume type is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetEBSSnapshotPricing retrieves EBS snapshot storage pricing for a region
+func (p *PricingService) GetEBSSnapshotPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":   "AmazonEC2",
+		"location":      location,
+		"productFamily": "Storage Snapshot",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonEC2", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve EBS snapshot pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no EBS snapshot pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that EBS snapshots are available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetCloudWatchMetricPricing retrieves CloudWatch custom and detailed
+// monitoring metric pricing for a region
+func (p *PricingService) GetCloudWatchMetricPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":   "AmazonCloudWatch",
+		"location":      location,
+		"productFamily": "Metric",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonCloudWatch", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve CloudWatch metric pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no CloudWatch metric pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that CloudWatch is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetPublicIPv4Pricing retrieves public IPv4 address pricing for a region,
+// which covers Elastic IP addresses whether or not they are attached
+func (p *PricingService) GetPublicIPv4Pricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	// Public IPv4 address pricing is under the "AmazonVPC" service code
+	filters := map[string]string{
+		"servicecode": "AmazonVPC",
+		"location":    location,
+		"group":       "VPCPublicIPv4Address",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonVPC", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve public IPv4 address pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no public IPv4 address pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that the region supports public IPv4 addresses")
+	}
+
+	return products, nil
+}
+
+// GetNATGatewayPricing retrieves NAT gateway hourly and data processing pricing for a region
+func (p *PricingService) GetNATGatewayPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	// NAT gateway pricing is under the "AmazonEC2" service code
+	filters := map[string]string{
+		"servicecode":   "AmazonEC2",
+		"location":      location,
+		"productFamily": "NAT Gateway",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonEC2", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve NAT gateway pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no NAT gateway pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that NAT gateways are available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetDynamoDBPricing retrieves DynamoDB pricing information for a region:
+// request units, capacity unit hours, storage, backups and replication
+func (p *PricingService) GetDynamoDBPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode": "AmazonDynamoDB",
+		"location":    location,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonDynamoDB", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve DynamoDB pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no DynamoDB pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that DynamoDB is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetElastiCachePricing retrieves ElastiCache node pricing for a node type,
+// cache engine and region
+func (p *PricingService) GetElastiCachePricing(ctx context.Context, nodeType, engine, region string) ([]interfaces.PricingProduct, error) {
+	if nodeType == "" {
+		return nil, errors.ValidationError("node type cannot be empty").
+			WithSuggestion("Provide a valid ElastiCache node type (e.g., 'cache.t4g.micro', 'cache.r6g.large')")
+	}
+
+	if engine == "" {
+		return nil, errors.ValidationError("engine cannot be empty").
+			WithSuggestion("Provide a valid cache engine ('Redis', 'Valkey' or 'Memcached')")
+	}
+
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":   "AmazonElastiCache",
+		"location":      location,
+		"productFamily": "Cache Instance",
+		"instanceType":  nodeType,
+		"cacheEngine":   engine,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonElastiCache", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve ElastiCache pricing").
+			WithContext("nodeType", nodeType).
+			WithContext("engine", engine).
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no ElastiCache pricing data found").
+			WithContext("nodeType", nodeType).
+			WithContext("engine", engine).
+			WithContext("region", region).
+			WithSuggestion("Check that the node type is available in the specified region").
+			WithSuggestion("Verify the cache engine supports the node type")
+	}
+
+	return products, nil
+}
+
+// GetElastiCacheServerlessPricing retrieves ElastiCache Serverless data
+// storage and ElastiCache Processing Unit (ECPU) pricing for a cache engine and region
+func (p *PricingService) GetElastiCacheServerlessPricing(ctx context.Context, engine, region string) ([]interfaces.PricingProduct, error) {
+	if engine == "" {
+		return nil, errors.ValidationError("engine cannot be empty").
+			WithSuggestion("Provide a valid cache engine ('Redis', 'Valkey' or 'Memcached')")
+	}
+
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":   "AmazonElastiCache",
+		"location":      location,
+		"productFamily": "ElastiCache Serverless",
+		"cacheEngine":   engine,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonElastiCache", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve ElastiCache Serverless pricing").
+			WithContext("engine", engine).
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no ElastiCache Serverless pricing data found").
+			WithContext("engine", engine).
+			WithContext("region", region).
+			WithSuggestion("Check that ElastiCache Serverless is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetElastiCacheBackupPricing retrieves ElastiCache backup storage pricing for a region
+func (p *PricingService) GetElastiCacheBackupPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":   "AmazonElastiCache",
+		"location":      location,
+		"productFamily": "Storage Snapshot",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonElastiCache", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve ElastiCache backup pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no ElastiCache backup pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that ElastiCache is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetFargatePricing retrieves AWS Fargate pricing information for a region:
+// vCPU, memory and ephemeral storage hours of each operating system and
+// architecture, the Windows license fee and Fargate Spot
+func (p *PricingService) GetFargatePricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode": "AmazonECS",
+		"location":    location,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonECS", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve Fargate pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no Fargate pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that Fargate is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetEKSPricing retrieves Amazon EKS pricing information for a region:
+// cluster control plane hours and the extended support surcharge
+func (p *PricingService) GetEKSPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode": "AmazonEKS",
+		"location":    location,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonEKS", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve EKS pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no EKS pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that EKS is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetAPIGatewayPricing retrieves Amazon API Gateway pricing information for a
+// region: REST, HTTP and WebSocket API usage and REST API cache clusters
+func (p *PricingService) GetAPIGatewayPricing(ctx context.Context, region string) ([]interfaces.PricingProduct, error) {
+	if region == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert region to location format
+	location := p.regionToLocation(region)
+	if location == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", region).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode": "AmazonApiGateway",
+		"location":    location,
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonApiGateway", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve API Gateway pricing").
+			WithContext("region", region)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no API Gateway pricing data found").
+			WithContext("region", region).
+			WithSuggestion("Check that API Gateway is available in the specified region")
+	}
+
+	return products, nil
+}
+
+// GetCloudFrontPricing retrieves CloudFront pricing information: data transfer
+// out and requests of each edge location geography, origin shield requests,
+// invalidations and CloudFront Functions. CloudFront is a global service, so
+// products are not filtered by region.
+func (p *PricingService) GetCloudFrontPricing(ctx context.Context) ([]interfaces.PricingProduct, error) {
+	filters := map[string]string{
+		"servicecode": "AmazonCloudFront",
+	}
+
+	products, err := p.client.GetProducts(ctx, "AmazonCloudFront", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve CloudFront pricing")
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no CloudFront pricing data found").
+			WithSuggestion("Check that the pricing API is reachable and the AmazonCloudFront price list is available")
+	}
+
+	return products, nil
+}
+
+// Transfer types of data transfer products in the AWSDataTransfer price list
+const (
+	TransferTypeInternet    = "AWS Outbound"         // From a region to the internet
+	TransferTypeInterRegion = "InterRegion Outbound" // From a region to another region
+	TransferTypeIntraRegion = "IntraRegion"          // Between availability zones of a region
+	TransferTypeCloudFront  = "CloudFront Outbound"  // From a region to CloudFront edge locations
+)
+
+// GetDataTransferPricing retrieves data transfer pricing of a transfer type out
+// of a region. The destination region only applies to inter-region transfer.
+func (p *PricingService) GetDataTransferPricing(ctx context.Context, transferType, fromRegion, toRegion string) ([]interfaces.PricingProduct, error) {
+	if transferType == "" {
+		return nil, errors.ValidationError("transfer type cannot be empty").
+			WithSuggestion(fmt.Sprintf("Use one of: %s, %s, %s, %s",
+				TransferTypeInternet, TransferTypeInterRegion, TransferTypeIntraRegion, TransferTypeCloudFront))
+	}
+
+	if fromRegion == "" {
+		return nil, errors.ValidationError("region cannot be empty").
+			WithSuggestion("Provide a valid AWS region")
+	}
+
+	// Convert regions to location format
+	fromLocation := p.regionToLocation(fromRegion)
+	if fromLocation == "" {
+		return nil, errors.ValidationError("unsupported region").
+			WithContext("region", fromRegion).
+			WithSuggestion("Use a standard AWS region code")
+	}
+
+	filters := map[string]string{
+		"servicecode":  "AWSDataTransfer",
+		"fromLocation": fromLocation,
+		"transferType": transferType,
+	}
+
+	if toRegion != "" {
+		toLocation := p.regionToLocation(toRegion)
+		if toLocation == "" {
+			return nil, errors.ValidationError("unsupported destination region").
+				WithContext("region", toRegion).
+				WithSuggestion("Use a standard AWS region code")
+		}
+		filters["toLocation"] = toLocation
+	}
+
+	products, err := p.client.GetProducts(ctx, "AWSDataTransfer", filters)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve data transfer pricing").
+			WithContext("transferType", transferType).
+			WithContext("region", fromRegion)
+	}
+
+	if len(products) == 0 {
+		return nil, errors.APIError("no data transfer pricing data found").
+			WithContext("transferType", transferType).
+			WithContext("region", fromRegion).
+			WithContext("destinationRegion", toRegion).
+			WithSuggestion("Check that the regions are spelled correctly")
+	}
+
+	return products, nil
+}
+
 // ExtractHourlyPrice extracts the hourly price from pricing terms
-func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (float64, error) {
+func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (money.Amount, error) {
 	if product.Terms == nil {
-		return 0, errors.APIError("no pricing terms found in product").
+		return money.Amount{}, errors.APIError("no pricing terms found in product").
 			WithContext("sku", product.SKU).
 			WithSuggestion("The product may not have on-demand pricing available")
 	}
@@ -316,7 +875,7 @@ func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (
 	// Look for OnDemand terms
 	onDemandTerms, ok := product.Terms["OnDemand"]
 	if !ok {
-		return 0, errors.APIError("no on-demand pricing terms found").
+		return money.Amount{}, errors.APIError("no on-demand pricing terms found").
 			WithContext("sku", product.SKU).
 			WithSuggestion("The product may only have reserved or spot pricing")
 	}
@@ -324,7 +883,7 @@ func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (
 	// Navigate through the nested pricing structure
 	termsMap, ok := onDemandTerms.(map[string]interface{})
 	if !ok {
-		return 0, errors.APIError("invalid on-demand terms structure").
+		return money.Amount{}, errors.APIError("invalid on-demand terms structure").
 			WithContext("sku", product.SKU)
 	}
 
@@ -367,9 +926,9 @@ func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (
 			// Look for USD price
 			if usdPrice, ok := priceMap["USD"]; ok {
 				if priceStr, ok := usdPrice.(string); ok {
-					price, err := strconv.ParseFloat(priceStr, 64)
+					price, err := money.ParseAmount(priceStr)
 					if err != nil {
-						return 0, errors.APIErrorWithCause("failed to parse price", err).
+						return money.Amount{}, errors.APIErrorWithCause("failed to parse price", err).
 							WithContext("sku", product.SKU).
 							WithContext("priceString", priceStr)
 					}
@@ -379,11 +938,215 @@ func (p *PricingService) ExtractHourlyPrice(product interfaces.PricingProduct) (
 		}
 	}
 
-	return 0, errors.APIError("no USD pricing found in product").
+	return money.Amount{}, errors.APIError("no USD pricing found in product").
 		WithContext("sku", product.SKU).
 		WithSuggestion("The product may not have USD pricing available")
 }
 
+// Purchase options supported for instance-based resources
+const (
+	PurchaseOptionOnDemand = "OnDemand"
+	PurchaseOptionReserved = "Reserved"
+	PurchaseOptionSpot     = "Spot"
+)
+
+// hoursPerYear is the number of hours in a reserved term year
+const hoursPerYear = 8760
+
+// Valid reserved term values, as they appear in the pricing API term attributes
+var (
+	reservedTermLengths     = []string{"1yr", "3yr"}
+	reservedOfferingClasses = []string{"standard", "convertible"}
+	reservedPaymentOptions  = []string{"No Upfront", "Partial Upfront", "All Upfront"}
+)
+
+// ReservedTerm identifies a reserved instance offering
+type ReservedTerm struct {
+	Length        string // LeaseContractLength: "1yr" or "3yr"
+	OfferingClass string // "standard" or "convertible"
+	PaymentOption string // "No Upfront", "Partial Upfront" or "All Upfront"
+}
+
+// Hours returns the number of hours covered by the term
+func (t ReservedTerm) Hours() float64 {
+	if t.Length == "3yr" {
+		return 3 * hoursPerYear
+	}
+	return hoursPerYear
+}
+
+// String describes the term, e.g. "1yr standard, No Upfront"
+func (t ReservedTerm) String() string {
+	return fmt.Sprintf("%s %s, %s", t.Length, t.OfferingClass, t.PaymentOption)
+}
+
+// ReservedPrice holds the upfront and recurring components of a reserved offering
+type ReservedPrice struct {
+	Term        ReservedTerm
+	UpfrontFee  money.Amount // One-time fee paid when the reservation is purchased
+	HourlyPrice money.Amount // Recurring hourly charge
+}
+
+// AmortizedUpfrontPrice spreads the upfront fee evenly over the hours in the term
+func (r *ReservedPrice) AmortizedUpfrontPrice() money.Amount {
+	return r.UpfrontFee.DivFloat(r.Term.Hours())
+}
+
+// EffectiveHourlyPrice is the recurring hourly charge plus the amortized upfront fee
+func (r *ReservedPrice) EffectiveHourlyPrice() money.Amount {
+	return r.HourlyPrice.Add(r.AmortizedUpfrontPrice())
+}
+
+// ReservedTermFromProperties reads the purchaseOption, term, offeringClass and
+// paymentOption resource properties. It returns nil for on-demand and spot resources.
+func ReservedTermFromProperties(properties map[string]interface{}) (*ReservedTerm, error) {
+	stringProperty := func(key, defaultValue string) (string, error) {
+		value, exists := properties[key]
+		if !exists {
+			return defaultValue, nil
+		}
+		str, ok := value.(string)
+		if !ok {
+			return "", errors.ValidationError(fmt.Sprintf("%s must be a string", key)).
+				WithContext(key, value)
+		}
+		return str, nil
+	}
+
+	purchaseOption, err := stringProperty("purchaseOption", PurchaseOptionOnDemand)
+	if err != nil {
+		return nil, err
+	}
+
+	switch purchaseOption {
+	case PurchaseOptionOnDemand, PurchaseOptionSpot:
+		for _, key := range []string{"term", "offeringClass", "paymentOption"} {
+			if _, exists := properties[key]; exists {
+				return nil, errors.ValidationError(fmt.Sprintf("%s only applies to reserved pricing", key)).
+					WithSuggestion("Set purchaseOption to 'Reserved' or remove the property")
+			}
+		}
+		return nil, nil
+	case PurchaseOptionReserved:
+	default:
+		return nil, errors.ValidationError("invalid purchaseOption").
+			WithContext("purchaseOption", purchaseOption).
+			WithSuggestion(fmt.Sprintf("Use one of: %s, %s, %s", PurchaseOptionOnDemand, PurchaseOptionReserved, PurchaseOptionSpot))
+	}
+
+	term := &ReservedTerm{}
+	values := []struct {
+		key          string
+		defaultValue string
+		valid        []string
+		target       *string
+	}{
+		{"term", "1yr", reservedTermLengths, &term.Length},
+		{"offeringClass", "standard", reservedOfferingClasses, &term.OfferingClass},
+		{"paymentOption", "No Upfront", reservedPaymentOptions, &term.PaymentOption},
+	}
+	for _, v := range values {
+		value, err := stringProperty(v.key, v.defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		if !containsString(v.valid, value) {
+			return nil, errors.ValidationError(fmt.Sprintf("invalid %s", v.key)).
+				WithContext(v.key, value).
+				WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(v.valid, ", ")))
+		}
+		*v.target = value
+	}
+
+	return term, nil
+}
+
+// ExtractReservedPrice extracts the upfront fee and recurring hourly price of the
+// reserved offering matching the given term. Offering class is only matched when
+// the product lists one, since RDS reservations do not distinguish classes.
+func (p *PricingService) ExtractReservedPrice(product interfaces.PricingProduct, term ReservedTerm) (*ReservedPrice, error) {
+	reservedTerms, ok := product.Terms["Reserved"].(map[string]interface{})
+	if !ok {
+		return nil, errors.APIError("no reserved pricing terms found").
+			WithContext("sku", product.SKU).
+			WithSuggestion("The product may only have on-demand pricing")
+	}
+
+	for _, termData := range reservedTerms {
+		termInfo, ok := termData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attributes, _ := termInfo["termAttributes"].(map[string]interface{})
+		if !matchesTermAttribute(attributes, "LeaseContractLength", term.Length, true) ||
+			!matchesTermAttribute(attributes, "PurchaseOption", term.PaymentOption, true) ||
+			!matchesTermAttribute(attributes, "OfferingClass", term.OfferingClass, false) {
+			continue
+		}
+
+		dimensions, ok := termInfo["priceDimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		price := &ReservedPrice{Term: term}
+		for _, dimensionData := range dimensions {
+			dimension, ok := dimensionData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			priceMap, _ := dimension["pricePerUnit"].(map[string]interface{})
+			priceStr, ok := priceMap["USD"].(string)
+			if !ok {
+				continue
+			}
+
+			amount, err := money.ParseAmount(priceStr)
+			if err != nil {
+				return nil, errors.APIErrorWithCause("failed to parse price", err).
+					WithContext("sku", product.SKU).
+					WithContext("priceString", priceStr)
+			}
+
+			// Upfront fees are charged per reservation, recurring fees per hour
+			if unit, _ := dimension["unit"].(string); unit == "Quantity" {
+				price.UpfrontFee = price.UpfrontFee.Add(amount)
+			} else {
+				price.HourlyPrice = price.HourlyPrice.Add(amount)
+			}
+		}
+
+		return price, nil
+	}
+
+	return nil, errors.APIError("no reserved pricing found for the requested term").
+		WithContext("sku", product.SKU).
+		WithContext("term", term.String()).
+		WithSuggestion("Check that the offering is available for this instance type and region").
+		WithSuggestion("Try a different term, offeringClass or paymentOption")
+}
+
+// matchesTermAttribute compares a reserved term attribute case-insensitively.
+// Attributes missing from the term only match when they are not required.
+func matchesTermAttribute(attributes map[string]interface{}, key, want string, required bool) bool {
+	value, ok := attributes[key].(string)
+	if !ok {
+		return !required
+	}
+	return strings.EqualFold(value, want)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // regionToLocation converts AWS region codes to pricing API location names
 func (p *PricingService) regionToLocation(region string) string {
 	// Map of common AWS regions to their pricing API location names
@@ -6,6 +6,7 @@ import (
 
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
+	"shylock/internal/money"
 )
 
 // MockAWSClient implements the AWSPricingClient interface for testing
@@ -226,6 +227,171 @@ func TestGetS3Pricing(t *testing.T) {
 	}
 }
 
+func TestGetEBSPricing(t *testing.T) {
+	tests := []struct {
+		name         string
+		volumeType   string
+		region       string
+		mockProducts []interfaces.PricingProduct
+		shouldFail   bool
+		expectError  bool
+		errorType    errors.ErrorType
+	}{
+		{
+			name:       "valid EBS request",
+			volumeType: "gp3",
+			region:     "us-east-1",
+			mockProducts: []interfaces.PricingProduct{
+				{
+					SKU:           "EBSTEST123",
+					ProductFamily: "Storage",
+					ServiceCode:   "AmazonEC2",
+					Attributes: map[string]string{
+						"volumeApiName": "gp3",
+						"usageType":     "EBS:VolumeUsage.gp3",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:        "empty volume type",
+			volumeType:  "",
+			region:      "us-east-1",
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+		{
+			name:        "unsupported region",
+			volumeType:  "gp3",
+			region:      "invalid-region",
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+		{
+			name:        "API failure",
+			volumeType:  "gp3",
+			region:      "us-east-1",
+			shouldFail:  true,
+			expectError: true,
+			errorType:   errors.APIErrorType,
+		},
+		{
+			name:        "no products found",
+			volumeType:  "gp3",
+			region:      "us-east-1",
+			expectError: true,
+			errorType:   errors.APIErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockAWSClient{
+				products:      tt.mockProducts,
+				shouldFailGet: tt.shouldFail,
+			}
+			service := NewPricingService(mockClient)
+
+			products, err := service.GetEBSPricing(context.Background(), tt.volumeType, tt.region)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				if tt.errorType != "" && !errors.IsErrorType(err, tt.errorType) {
+					t.Errorf("expected error type %s, got %s", tt.errorType, errors.GetErrorType(err))
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if len(products) == 0 {
+					t.Error("expected products but got none")
+				}
+			}
+		})
+	}
+}
+
+func TestGetDataTransferPricing(t *testing.T) {
+	products := []interfaces.PricingProduct{
+		{
+			SKU:         "DTTEST123",
+			ServiceCode: "AWSDataTransfer",
+			Attributes: map[string]string{
+				"transferType": TransferTypeInterRegion,
+				"usageType":    "USE1-USW2-AWS-Out-Bytes",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		transferType string
+		fromRegion   string
+		toRegion     string
+		mockProducts []interfaces.PricingProduct
+		expectError  bool
+		errorType    errors.ErrorType
+	}{
+		{
+			name:         "valid inter-region request",
+			transferType: TransferTypeInterRegion,
+			fromRegion:   "us-east-1",
+			toRegion:     "us-west-2",
+			mockProducts: products,
+		},
+		{
+			name:         "empty transfer type",
+			fromRegion:   "us-east-1",
+			mockProducts: products,
+			expectError:  true,
+			errorType:    errors.ValidationErrorType,
+		},
+		{
+			name:         "unsupported destination region",
+			transferType: TransferTypeInterRegion,
+			fromRegion:   "us-east-1",
+			toRegion:     "invalid-region",
+			mockProducts: products,
+			expectError:  true,
+			errorType:    errors.ValidationErrorType,
+		},
+		{
+			name:         "no products found",
+			transferType: TransferTypeInternet,
+			fromRegion:   "us-east-1",
+			expectError:  true,
+			errorType:    errors.APIErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewPricingService(&MockAWSClient{products: tt.mockProducts})
+
+			result, err := service.GetDataTransferPricing(context.Background(), tt.transferType, tt.fromRegion, tt.toRegion)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				if tt.errorType != "" && !errors.IsErrorType(err, tt.errorType) {
+					t.Errorf("expected error type %s, got %s", tt.errorType, errors.GetErrorType(err))
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if len(result) == 0 {
+					t.Error("expected products but got none")
+				}
+			}
+		})
+	}
+}
+
 func TestExtractHourlyPrice(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -308,9 +474,169 @@ func TestExtractHourlyPrice(t *testing.T) {
 				if err != nil {
 					t.Errorf("unexpected error: %v", err)
 				}
-				if price != tt.expectedPrice {
-					t.Errorf("expected price %.4f, got %.4f", tt.expectedPrice, price)
+				if !price.Equal(money.NewAmount(tt.expectedPrice)) {
+					t.Errorf("expected price %g, got %s", tt.expectedPrice, price)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractReservedPrice(t *testing.T) {
+	reservedProduct := interfaces.PricingProduct{
+		SKU: "TEST123",
+		Terms: map[string]interface{}{
+			"Reserved": map[string]interface{}{
+				"TEST123.4NA7Y494T4": map[string]interface{}{
+					"termAttributes": map[string]interface{}{
+						"LeaseContractLength": "1yr",
+						"OfferingClass":       "standard",
+						"PurchaseOption":      "Partial Upfront",
+					},
+					"priceDimensions": map[string]interface{}{
+						"TEST123.4NA7Y494T4.2TG2D8R56U": map[string]interface{}{
+							"unit":         "Quantity",
+							"pricePerUnit": map[string]interface{}{"USD": "438"},
+						},
+						"TEST123.4NA7Y494T4.6YS6EN2CT7": map[string]interface{}{
+							"unit":         "Hrs",
+							"pricePerUnit": map[string]interface{}{"USD": "0.05"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		product         interfaces.PricingProduct
+		term            ReservedTerm
+		expectedUpfront float64
+		expectedHourly  float64
+		expectError     bool
+	}{
+		{
+			name:            "matching term",
+			product:         reservedProduct,
+			term:            ReservedTerm{Length: "1yr", OfferingClass: "standard", PaymentOption: "Partial Upfront"},
+			expectedUpfront: 438,
+			expectedHourly:  0.05,
+		},
+		{
+			name:        "no matching term",
+			product:     reservedProduct,
+			term:        ReservedTerm{Length: "3yr", OfferingClass: "standard", PaymentOption: "Partial Upfront"},
+			expectError: true,
+		},
+		{
+			name:        "no reserved terms",
+			product:     interfaces.PricingProduct{SKU: "TEST123", Terms: map[string]interface{}{"OnDemand": map[string]interface{}{}}},
+			term:        ReservedTerm{Length: "1yr", OfferingClass: "standard", PaymentOption: "No Upfront"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &PricingService{}
+
+			price, err := service.ExtractReservedPrice(tt.product, tt.term)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !price.UpfrontFee.Equal(money.NewAmount(tt.expectedUpfront)) {
+				t.Errorf("expected upfront fee %g, got %s", tt.expectedUpfront, price.UpfrontFee)
+			}
+			if !price.HourlyPrice.Equal(money.NewAmount(tt.expectedHourly)) {
+				t.Errorf("expected hourly price %g, got %s", tt.expectedHourly, price.HourlyPrice)
+			}
+			// 438 / 8760 is exactly 0.05 per hour
+			if effective := price.EffectiveHourlyPrice(); !effective.Equal(money.NewAmount(tt.expectedHourly + tt.expectedUpfront/8760)) {
+				t.Errorf("expected effective hourly price %g, got %s", tt.expectedHourly+tt.expectedUpfront/8760, effective)
+			}
+		})
+	}
+}
+
+func TestReservedTermFromProperties(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expected    *ReservedTerm
+		expectError bool
+	}{
+		{
+			name:       "on-demand by default",
+			properties: map[string]interface{}{},
+			expected:   nil,
+		},
+		{
+			name:       "reserved defaults",
+			properties: map[string]interface{}{"purchaseOption": "Reserved"},
+			expected:   &ReservedTerm{Length: "1yr", OfferingClass: "standard", PaymentOption: "No Upfront"},
+		},
+		{
+			name: "reserved with all options",
+			properties: map[string]interface{}{
+				"purchaseOption": "Reserved",
+				"term":           "3yr",
+				"offeringClass":  "convertible",
+				"paymentOption":  "All Upfront",
+			},
+			expected: &ReservedTerm{Length: "3yr", OfferingClass: "convertible", PaymentOption: "All Upfront"},
+		},
+		{
+			name:       "spot is not reserved",
+			properties: map[string]interface{}{"purchaseOption": "Spot"},
+			expected:   nil,
+		},
+		{
+			name:        "unknown purchase option",
+			properties:  map[string]interface{}{"purchaseOption": "Dedicated"},
+			expectError: true,
+		},
+		{
+			name:        "invalid payment option",
+			properties:  map[string]interface{}{"purchaseOption": "Reserved", "paymentOption": "Some Upfront"},
+			expectError: true,
+		},
+		{
+			name:        "reserved option without reserved purchase",
+			properties:  map[string]interface{}{"term": "1yr"},
+			expectError: true,
+		},
+		{
+			name:        "non-string term",
+			properties:  map[string]interface{}{"purchaseOption": "Reserved", "term": 1},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			term, err := ReservedTermFromProperties(tt.properties)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %s", errors.GetErrorType(err))
 				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (term == nil) != (tt.expected == nil) || (term != nil && *term != *tt.expected) {
+				t.Errorf("expected term %v, got %v", tt.expected, term)
 			}
 		})
 	}
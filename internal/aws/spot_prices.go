@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/money"
+)
+
+// Percentiles used to select a price from the spot price history
+const (
+	SpotPercentileP50 = "p50"
+	SpotPercentileP90 = "p90"
+	SpotPercentileMax = "max"
+)
+
+// SpotPercentiles lists the supported spot price percentiles
+var SpotPercentiles = []string{SpotPercentileP50, SpotPercentileP90, SpotPercentileMax}
+
+// spotPriceHistory mirrors the output of `aws ec2 describe-spot-price-history`
+type spotPriceHistory struct {
+	SpotPriceHistory []struct {
+		AvailabilityZone   string `json:"AvailabilityZone"`
+		InstanceType       string `json:"InstanceType"`
+		ProductDescription string `json:"ProductDescription"`
+		SpotPrice          string `json:"SpotPrice"`
+		Timestamp          string `json:"Timestamp"`
+	} `json:"SpotPriceHistory"`
+}
+
+// FileSpotPriceSource serves spot prices from a saved spot price history file
+type FileSpotPriceSource struct {
+	path   string
+	prices []interfaces.SpotPrice
+}
+
+// NewFileSpotPriceSource loads a spot price history file in the JSON format
+// written by `aws ec2 describe-spot-price-history`
+func NewFileSpotPriceSource(path string) (*FileSpotPriceSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read spot price history", err).
+			WithContext("spotPriceHistory", path).
+			WithSuggestion("Export the history with 'aws ec2 describe-spot-price-history --output json'")
+	}
+
+	var history spotPriceHistory
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, errors.FileErrorWithCause("failed to parse spot price history", err).
+			WithContext("spotPriceHistory", path)
+	}
+
+	source := &FileSpotPriceSource{
+		path:   path,
+		prices: make([]interfaces.SpotPrice, 0, len(history.SpotPriceHistory)),
+	}
+
+	for i, entry := range history.SpotPriceHistory {
+		price, err := money.ParseAmount(entry.SpotPrice)
+		if err != nil {
+			return nil, errors.FileErrorWithCause("invalid spot price", err).
+				WithContext("spotPriceHistory", path).
+				WithContext("entry", i).
+				WithContext("spotPrice", entry.SpotPrice)
+		}
+
+		// Timestamps weight each price by how long it was in effect; prices
+		// without an RFC 3339 timestamp are weighted alike
+		timestamp, _ := time.Parse(time.RFC3339, entry.Timestamp)
+
+		source.prices = append(source.prices, interfaces.SpotPrice{
+			AvailabilityZone:   entry.AvailabilityZone,
+			InstanceType:       entry.InstanceType,
+			ProductDescription: entry.ProductDescription,
+			Price:              price,
+			Timestamp:          timestamp,
+		})
+	}
+
+	if len(source.prices) == 0 {
+		return nil, errors.FileError("spot price history contains no prices").
+			WithContext("spotPriceHistory", path).
+			WithSuggestion("Check the --start-time and --instance-types used for the export")
+	}
+
+	return source, nil
+}
+
+// GetSpotPrices returns the history entries for an instance type and product
+// description in the availability zones of a region
+func (s *FileSpotPriceSource) GetSpotPrices(ctx context.Context, instanceType, region, productDescription string) ([]interfaces.SpotPrice, error) {
+	var prices []interfaces.SpotPrice
+	for _, price := range s.prices {
+		if price.InstanceType == instanceType &&
+			inRegion(price.AvailabilityZone, region) &&
+			normalizeProductDescription(price.ProductDescription) == productDescription {
+			prices = append(prices, price)
+		}
+	}
+
+	if len(prices) == 0 {
+		return nil, errors.APIError("no spot price history found").
+			WithContext("instanceType", instanceType).
+			WithContext("region", region).
+			WithContext("productDescription", productDescription).
+			WithContext("spotPriceHistory", s.path).
+			WithSuggestion(fmt.Sprintf("Export it with 'aws ec2 describe-spot-price-history --region %s --instance-types %s --product-descriptions \"%s\"'",
+				region, instanceType, productDescription))
+	}
+
+	return prices, nil
+}
+
+// inRegion reports whether an availability zone (e.g. "us-east-1a") or a
+// local zone (e.g. "us-west-2-lax-1a") belongs to a region
+func inRegion(availabilityZone, region string) bool {
+	suffix, found := strings.CutPrefix(availabilityZone, region)
+	if !found {
+		return false
+	}
+	return len(suffix) == 1 || strings.HasPrefix(suffix, "-")
+}
+
+// normalizeProductDescription treats EC2-Classic and VPC descriptions alike,
+// e.g. "Linux/UNIX (Amazon VPC)" matches "Linux/UNIX"
+func normalizeProductDescription(description string) string {
+	return strings.TrimSuffix(description, " (Amazon VPC)")
+}
+
+// SpotProductDescription converts an EC2 operating system to the product
+// description used in the spot price history
+func SpotProductDescription(operatingSystem string) string {
+	descriptions := map[string]string{
+		"Linux":   "Linux/UNIX",
+		"Windows": "Windows",
+		"RHEL":    "Red Hat Enterprise Linux",
+		"SUSE":    "SUSE Linux",
+	}
+
+	if description, exists := descriptions[operatingSystem]; exists {
+		return description
+	}
+	return operatingSystem
+}
+
+// SpotPriceStats summarizes the spot price history of one availability zone
+type SpotPriceStats struct {
+	AvailabilityZone string
+	P50              money.Amount
+	P90              money.Amount
+	Max              money.Amount
+	Samples          int
+}
+
+// Price returns the statistic for a percentile (p50, p90 or max)
+func (s SpotPriceStats) Price(percentile string) money.Amount {
+	switch percentile {
+	case SpotPercentileP90:
+		return s.P90
+	case SpotPercentileMax:
+		return s.Max
+	default:
+		return s.P50
+	}
+}
+
+// SummarizeSpotPrices computes percentiles over the spot price history of
+// each availability zone, ordered by zone name. A spot price holds until the
+// next change in its zone, so each price point is weighted by how long it was
+// in effect. The last one in a zone, the price still in effect, holds until
+// the history ends: the latest timestamp plus the median interval between
+// price changes. Zones whose prices cannot be timed, such as histories
+// without timestamps or with a single price point per zone, weight every
+// price point alike.
+func SummarizeSpotPrices(prices []interfaces.SpotPrice) []SpotPriceStats {
+	byZone := make(map[string][]interfaces.SpotPrice)
+	for _, price := range prices {
+		byZone[price.AvailabilityZone] = append(byZone[price.AvailabilityZone], price)
+	}
+
+	var latest time.Time
+	var intervals []time.Duration
+	for _, zonePrices := range byZone {
+		sort.SliceStable(zonePrices, func(i, j int) bool {
+			return zonePrices[i].Timestamp.Before(zonePrices[j].Timestamp)
+		})
+		for i, price := range zonePrices {
+			if price.Timestamp.After(latest) {
+				latest = price.Timestamp
+			}
+			if i > 0 {
+				if interval := price.Timestamp.Sub(zonePrices[i-1].Timestamp); interval > 0 {
+					intervals = append(intervals, interval)
+				}
+			}
+		}
+	}
+	end := latest.Add(medianInterval(intervals))
+
+	stats := make([]SpotPriceStats, 0, len(byZone))
+	for zone, zonePrices := range byZone {
+		samples := weightSpotPrices(zonePrices, end)
+		sort.SliceStable(samples, func(i, j int) bool {
+			return samples[i].price.Cmp(samples[j].price) < 0
+		})
+		stats = append(stats, SpotPriceStats{
+			AvailabilityZone: zone,
+			P50:              percentile(samples, 50),
+			P90:              percentile(samples, 90),
+			Max:              samples[len(samples)-1].price,
+			Samples:          len(samples),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].AvailabilityZone < stats[j].AvailabilityZone
+	})
+
+	return stats
+}
+
+// medianInterval returns the median of the intervals between price changes,
+// or zero when there are none
+func medianInterval(intervals []time.Duration) time.Duration {
+	if len(intervals) == 0 {
+		return 0
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+	middle := len(intervals) / 2
+	if len(intervals)%2 == 0 {
+		return (intervals[middle-1] + intervals[middle]) / 2
+	}
+	return intervals[middle]
+}
+
+// spotSample is a spot price weighted by how long it was in effect
+type spotSample struct {
+	price  money.Amount
+	weight float64
+}
+
+// weightSpotPrices weights the price points of one zone, sorted by time, by
+// the hours until the next price point, or until end for the last one. Every
+// price point weighs the same when the zone's prices cannot be timed.
+func weightSpotPrices(sorted []interfaces.SpotPrice, end time.Time) []spotSample {
+	samples := make([]spotSample, len(sorted))
+	var total float64
+	for i, price := range sorted {
+		until := end
+		if i+1 < len(sorted) {
+			until = sorted[i+1].Timestamp
+		}
+		samples[i] = spotSample{price: price.Price, weight: until.Sub(price.Timestamp).Hours()}
+		total += samples[i].weight
+	}
+
+	if total <= 0 || sorted[0].Timestamp.IsZero() {
+		for i := range samples {
+			samples[i].weight = 1
+		}
+	}
+	return samples
+}
+
+// percentile returns the weighted nearest-rank percentile of samples sorted
+// by price: the lowest price in effect for at least p percent of the time
+func percentile(sorted []spotSample, p float64) money.Amount {
+	var total float64
+	for _, sample := range sorted {
+		total += sample.weight
+	}
+
+	target := p / 100 * total
+	var cumulative float64
+	for _, sample := range sorted {
+		cumulative += sample.weight
+		if sample.weight > 0 && cumulative >= target {
+			return sample.price
+		}
+	}
+	return sorted[len(sorted)-1].price
+}
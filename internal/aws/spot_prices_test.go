@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/money"
+)
+
+const testSpotPriceHistory = `{
+  "SpotPriceHistory": [
+    {"AvailabilityZone": "us-east-1a", "InstanceType": "c5.xlarge", "ProductDescription": "Linux/UNIX", "SpotPrice": "0.060000", "Timestamp": "2026-10-01T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-1a", "InstanceType": "c5.xlarge", "ProductDescription": "Linux/UNIX", "SpotPrice": "0.070000", "Timestamp": "2026-10-02T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-1b", "InstanceType": "c5.xlarge", "ProductDescription": "Linux/UNIX (Amazon VPC)", "SpotPrice": "0.050000", "Timestamp": "2026-10-01T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-1-bos-1a", "InstanceType": "c5.xlarge", "ProductDescription": "Linux/UNIX", "SpotPrice": "0.090000", "Timestamp": "2026-10-01T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-1a", "InstanceType": "c5.xlarge", "ProductDescription": "Windows", "SpotPrice": "0.200000", "Timestamp": "2026-10-01T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-1a", "InstanceType": "m5.large", "ProductDescription": "Linux/UNIX", "SpotPrice": "0.030000", "Timestamp": "2026-10-01T00:00:00+00:00"},
+    {"AvailabilityZone": "us-east-10a", "InstanceType": "c5.xlarge", "ProductDescription": "Linux/UNIX", "SpotPrice": "0.010000", "Timestamp": "2026-10-01T00:00:00+00:00"}
+  ]
+}`
+
+func writeSpotPriceHistory(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "spot-prices.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write spot price history: %v", err)
+	}
+	return path
+}
+
+func TestNewFileSpotPriceSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		missing     bool
+		expectError bool
+	}{
+		{name: "valid history", content: testSpotPriceHistory},
+		{name: "missing file", missing: true, expectError: true},
+		{name: "invalid JSON", content: `{"SpotPriceHistory": [`, expectError: true},
+		{name: "invalid price", content: `{"SpotPriceHistory": [{"AvailabilityZone": "us-east-1a", "SpotPrice": "cheap"}]}`, expectError: true},
+		{name: "empty history", content: `{"SpotPriceHistory": []}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "missing.json")
+			if !tt.missing {
+				path = writeSpotPriceHistory(t, tt.content)
+			}
+
+			source, err := NewFileSpotPriceSource(path)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.FileErrorType) {
+					t.Errorf("expected file error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(source.prices) != 7 {
+				t.Errorf("expected 7 prices, got %d", len(source.prices))
+			}
+		})
+	}
+}
+
+func TestFileSpotPriceSourceGetSpotPrices(t *testing.T) {
+	source, err := NewFileSpotPriceSource(writeSpotPriceHistory(t, testSpotPriceHistory))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		instanceType       string
+		region             string
+		productDescription string
+		expectedZones      []string
+		expectError        bool
+	}{
+		{
+			name:               "zones and local zones in the region",
+			instanceType:       "c5.xlarge",
+			region:             "us-east-1",
+			productDescription: "Linux/UNIX",
+			expectedZones:      []string{"us-east-1a", "us-east-1a", "us-east-1b", "us-east-1-bos-1a"},
+		},
+		{
+			name:               "product description",
+			instanceType:       "c5.xlarge",
+			region:             "us-east-1",
+			productDescription: "Windows",
+			expectedZones:      []string{"us-east-1a"},
+		},
+		{
+			name:               "no history for the region",
+			instanceType:       "c5.xlarge",
+			region:             "us-west-2",
+			productDescription: "Linux/UNIX",
+			expectError:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices, err := source.GetSpotPrices(context.Background(), tt.instanceType, tt.region, tt.productDescription)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.APIErrorType) {
+					t.Errorf("expected API error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(prices) != len(tt.expectedZones) {
+				t.Fatalf("expected %d prices, got %d", len(tt.expectedZones), len(prices))
+			}
+			for i, price := range prices {
+				if price.AvailabilityZone != tt.expectedZones[i] {
+					t.Errorf("expected zone %s, got %s", tt.expectedZones[i], price.AvailabilityZone)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeSpotPrices(t *testing.T) {
+	var prices []interfaces.SpotPrice
+	for i := 1; i <= 10; i++ {
+		prices = append(prices, interfaces.SpotPrice{AvailabilityZone: "us-east-1b", Price: money.NewAmount(float64(i) / 100)})
+	}
+	prices = append(prices, interfaces.SpotPrice{AvailabilityZone: "us-east-1a", Price: money.NewAmount(0.05)})
+
+	stats := SummarizeSpotPrices(prices)
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(stats))
+	}
+
+	if stats[0].AvailabilityZone != "us-east-1a" || !stats[0].P50.Equal(money.NewAmount(0.05)) || !stats[0].Max.Equal(money.NewAmount(0.05)) || stats[0].Samples != 1 {
+		t.Errorf("unexpected single sample stats: %+v", stats[0])
+	}
+
+	zone := stats[1]
+	expected := map[string]float64{
+		SpotPercentileP50: 0.05,
+		SpotPercentileP90: 0.09,
+		SpotPercentileMax: 0.10,
+	}
+	for percentile, price := range expected {
+		if !zone.Price(percentile).Equal(money.NewAmount(price)) {
+			t.Errorf("expected %s price %g, got %s", percentile, price, zone.Price(percentile))
+		}
+	}
+	if zone.Samples != 10 {
+		t.Errorf("expected 10 samples, got %d", zone.Samples)
+	}
+}
+
+func TestSummarizeSpotPricesWeighted(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	at := func(zone string, hour int, price float64) interfaces.SpotPrice {
+		return interfaces.SpotPrice{AvailabilityZone: zone, Price: money.NewAmount(price), Timestamp: start.Add(time.Duration(hour) * time.Hour)}
+	}
+
+	// In us-east-1a, 0.10 holds for 1 hour and 0.02 for 18. The current 0.05
+	// holds for 10.5 hours: 1 until the latest price change, in us-east-1b,
+	// and the 9.5-hour median interval after it.
+	stats := SummarizeSpotPrices([]interfaces.SpotPrice{
+		at("us-east-1a", 19, 0.05),
+		at("us-east-1a", 0, 0.10),
+		at("us-east-1a", 1, 0.02),
+		at("us-east-1b", 20, 0.04),
+	})
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(stats))
+	}
+
+	expected := map[string]float64{
+		SpotPercentileP50: 0.02,
+		SpotPercentileP90: 0.05,
+		SpotPercentileMax: 0.10,
+	}
+	for percentile, price := range expected {
+		if !stats[0].Price(percentile).Equal(money.NewAmount(price)) {
+			t.Errorf("expected %s price %g, got %s", percentile, price, stats[0].Price(percentile))
+		}
+	}
+	if stats[0].Samples != 3 {
+		t.Errorf("expected 3 samples, got %d", stats[0].Samples)
+	}
+
+	// A single price point cannot be timed and is its own percentiles
+	if zone := stats[1]; !zone.P50.Equal(money.NewAmount(0.04)) || !zone.P90.Equal(money.NewAmount(0.04)) {
+		t.Errorf("unexpected single sample stats: %+v", zone)
+	}
+}
+
+func TestSummarizeSpotPricesCurrentPrice(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// The current 0.50 holds for the 1-hour median interval, as long as 0.10 did
+	stats := SummarizeSpotPrices([]interfaces.SpotPrice{
+		{AvailabilityZone: "us-east-1a", Price: money.NewAmount(0.10), Timestamp: start},
+		{AvailabilityZone: "us-east-1a", Price: money.NewAmount(0.50), Timestamp: start.Add(time.Hour)},
+	})
+	if len(stats) != 1 {
+		t.Fatalf("expected 1 zone, got %d", len(stats))
+	}
+
+	expected := map[string]float64{
+		SpotPercentileP50: 0.10,
+		SpotPercentileP90: 0.50,
+		SpotPercentileMax: 0.50,
+	}
+	for percentile, price := range expected {
+		if !stats[0].Price(percentile).Equal(money.NewAmount(price)) {
+			t.Errorf("expected %s price %g, got %s", percentile, price, stats[0].Price(percentile))
+		}
+	}
+}
+
+func TestSpotProductDescription(t *testing.T) {
+	tests := map[string]string{
+		"Linux":   "Linux/UNIX",
+		"Windows": "Windows",
+		"RHEL":    "Red Hat Enterprise Linux",
+		"SUSE":    "SUSE Linux",
+		"Other":   "Other",
+	}
+
+	for operatingSystem, expected := range tests {
+		if actual := SpotProductDescription(operatingSystem); actual != expected {
+			t.Errorf("expected %s for %s, got %s", expected, operatingSystem, actual)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/money"
+)
+
+// PriceTier is one range of a tiered on-demand price. Ranges are expressed in
+// the unit of the price dimension, e.g. GB-Mo for the "First 50 TB / Month" of
+// S3 storage.
+type PriceTier struct {
+	BeginRange   float64
+	EndRange     float64 // math.Inf(1) for the last, unbounded tier
+	PricePerUnit money.Amount
+	Unit         string
+	Description  string
+}
+
+// TierCharge is the part of a quantity billed in one tier
+type TierCharge struct {
+	Tier     PriceTier
+	Quantity float64
+	Cost     money.Amount
+}
+
+// TieredCost is a quantity priced across the tiers of a product
+type TieredCost struct {
+	Quantity float64
+	Total    money.Amount
+	Charges  []TierCharge
+}
+
+// String describes the charge in each tier, e.g.
+// "51200 GB-Mo @ USD 0.023 (0-51200) = USD 1177.6000; 8800 GB-Mo @ USD 0.022 (51200-512000) = USD 193.6000"
+func (c *TieredCost) String() string {
+	parts := make([]string, 0, len(c.Charges))
+	for _, charge := range c.Charges {
+		quantity := strings.TrimSpace(formatAmount(charge.Quantity) + " " + charge.Tier.Unit)
+		parts = append(parts, fmt.Sprintf("%s @ USD %s (%s) = USD %s",
+			quantity, charge.Tier.PricePerUnit, charge.Tier.rangeString(), charge.Cost.StringFixed(4)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (t PriceTier) rangeString() string {
+	if math.IsInf(t.EndRange, 1) {
+		return formatAmount(t.BeginRange) + "+"
+	}
+	return formatAmount(t.BeginRange) + "-" + formatAmount(t.EndRange)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// ExtractPriceTiers extracts the on-demand price dimensions of a product as
+// tiers ordered by their beginning range. Dimensions without a range are
+// treated as a single tier covering any quantity.
+func (p *PricingService) ExtractPriceTiers(product interfaces.PricingProduct) ([]PriceTier, error) {
+	onDemandTerms, ok := product.Terms["OnDemand"].(map[string]interface{})
+	if !ok {
+		return nil, errors.APIError("no on-demand pricing terms found").
+			WithContext("sku", product.SKU).
+			WithSuggestion("The product may only have reserved or spot pricing")
+	}
+
+	var tiers []PriceTier
+	for _, termData := range onDemandTerms {
+		termInfo, ok := termData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		dimensions, ok := termInfo["priceDimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, dimensionData := range dimensions {
+			dimension, ok := dimensionData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			priceMap, _ := dimension["pricePerUnit"].(map[string]interface{})
+			priceStr, ok := priceMap["USD"].(string)
+			if !ok {
+				continue
+			}
+
+			price, err := money.ParseAmount(priceStr)
+			if err != nil {
+				return nil, errors.APIErrorWithCause("failed to parse price", err).
+					WithContext("sku", product.SKU).
+					WithContext("priceString", priceStr)
+			}
+
+			beginRange, err := parseRange(dimension["beginRange"], 0)
+			if err != nil {
+				return nil, errors.APIErrorWithCause("failed to parse price tier range", err).
+					WithContext("sku", product.SKU).
+					WithContext("beginRange", dimension["beginRange"])
+			}
+			endRange, err := parseRange(dimension["endRange"], math.Inf(1))
+			if err != nil {
+				return nil, errors.APIErrorWithCause("failed to parse price tier range", err).
+					WithContext("sku", product.SKU).
+					WithContext("endRange", dimension["endRange"])
+			}
+
+			unit, _ := dimension["unit"].(string)
+			description, _ := dimension["description"].(string)
+			tiers = append(tiers, PriceTier{
+				BeginRange:   beginRange,
+				EndRange:     endRange,
+				PricePerUnit: price,
+				Unit:         unit,
+				Description:  description,
+			})
+		}
+	}
+
+	if len(tiers) == 0 {
+		return nil, errors.APIError("no USD pricing found in product").
+			WithContext("sku", product.SKU).
+			WithSuggestion("The product may not have USD pricing available")
+	}
+
+	// Map iteration order is random, so order ties deterministically as well
+	sort.Slice(tiers, func(i, j int) bool {
+		if tiers[i].BeginRange != tiers[j].BeginRange {
+			return tiers[i].BeginRange < tiers[j].BeginRange
+		}
+		if tiers[i].EndRange != tiers[j].EndRange {
+			return tiers[i].EndRange < tiers[j].EndRange
+		}
+		return tiers[i].PricePerUnit.Cmp(tiers[j].PricePerUnit) < 0
+	})
+
+	return tiers, nil
+}
+
+// CalculateTieredCost prices a monthly quantity, in the unit of the product's
+// price dimensions, by walking the tiers in order and billing each slice of
+// the quantity at the price of the tier it falls in
+func (p *PricingService) CalculateTieredCost(product interfaces.PricingProduct, quantity float64) (*TieredCost, error) {
+	tiers, err := p.ExtractPriceTiers(product)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := &TieredCost{Quantity: quantity}
+	billed := 0.0
+	for _, tier := range tiers {
+		if billed >= quantity {
+			break
+		}
+		// Skip tiers already covered, e.g. duplicate dimensions for the same range
+		if tier.EndRange <= billed {
+			continue
+		}
+
+		start := math.Max(tier.BeginRange, billed)
+		end := math.Min(tier.EndRange, quantity)
+		if end <= start {
+			continue
+		}
+
+		charge := TierCharge{
+			Tier:     tier,
+			Quantity: end - start,
+			Cost:     tier.PricePerUnit.MulFloat(end - start),
+		}
+		cost.Charges = append(cost.Charges, charge)
+		cost.Total = cost.Total.Add(charge.Cost)
+		billed = end
+	}
+
+	if billed < quantity {
+		return nil, errors.APIError("price tiers do not cover the requested quantity").
+			WithContext("sku", product.SKU).
+			WithContext("quantity", quantity).
+			WithContext("coveredQuantity", billed)
+	}
+
+	return cost, nil
+}
+
+// parseRange parses a beginRange or endRange value, returning the fallback
+// when the range is missing and +Inf for "Inf"
+func parseRange(value interface{}, fallback float64) (float64, error) {
+	rangeStr, _ := value.(string)
+	switch rangeStr {
+	case "":
+		return fallback, nil
+	case "Inf":
+		return math.Inf(1), nil
+	}
+	return strconv.ParseFloat(rangeStr, 64)
+}
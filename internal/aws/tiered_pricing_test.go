@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"math"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/money"
+)
+
+// tieredProduct builds a product with one on-demand price dimension per tier,
+// each given as {beginRange, endRange, price}
+func tieredProduct(tiers ...[3]string) interfaces.PricingProduct {
+	dimensions := make(map[string]interface{})
+	for i, tier := range tiers {
+		dimensions[string(rune('A'+i))] = map[string]interface{}{
+			"beginRange":   tier[0],
+			"endRange":     tier[1],
+			"unit":         "GB-Mo",
+			"pricePerUnit": map[string]interface{}{"USD": tier[2]},
+		}
+	}
+
+	return interfaces.PricingProduct{
+		SKU: "TIERED123",
+		Terms: map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"TIERED123.JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": dimensions,
+				},
+			},
+		},
+	}
+}
+
+func TestCalculateTieredCost(t *testing.T) {
+	s3Standard := tieredProduct(
+		[3]string{"512000", "Inf", "0.021"},
+		[3]string{"0", "51200", "0.023"},
+		[3]string{"51200", "512000", "0.022"},
+	)
+
+	tests := []struct {
+		name            string
+		product         interfaces.PricingProduct
+		quantity        float64
+		expectError     bool
+		expectedTotal   float64
+		expectedCharges []float64
+		expectedString  string
+	}{
+		{
+			name:            "within the first tier",
+			product:         s3Standard,
+			quantity:        100,
+			expectedTotal:   100 * 0.023,
+			expectedCharges: []float64{100},
+			expectedString:  "100 GB-Mo @ USD 0.023 (0-51200) = USD 2.3000",
+		},
+		{
+			name:            "spans two tiers",
+			product:         s3Standard,
+			quantity:        60000,
+			expectedTotal:   51200*0.023 + 8800*0.022,
+			expectedCharges: []float64{51200, 8800},
+			expectedString:  "51200 GB-Mo @ USD 0.023 (0-51200) = USD 1177.6000; 8800 GB-Mo @ USD 0.022 (51200-512000) = USD 193.6000",
+		},
+		{
+			name:            "reaches the unbounded tier",
+			product:         s3Standard,
+			quantity:        600000,
+			expectedTotal:   51200*0.023 + 460800*0.022 + 88000*0.021,
+			expectedCharges: []float64{51200, 460800, 88000},
+		},
+		{
+			name:            "zero quantity",
+			product:         s3Standard,
+			quantity:        0,
+			expectedTotal:   0,
+			expectedCharges: []float64{},
+		},
+		{
+			name:            "dimension without ranges",
+			product:         tieredProduct([3]string{"", "", "0.115"}),
+			quantity:        20,
+			expectedTotal:   20 * 0.115,
+			expectedCharges: []float64{20},
+			expectedString:  "20 GB-Mo @ USD 0.115 (0+) = USD 2.3000",
+		},
+		{
+			name:        "tiers do not cover the quantity",
+			product:     tieredProduct([3]string{"0", "100", "0.1"}),
+			quantity:    150,
+			expectError: true,
+		},
+		{
+			name:        "invalid range",
+			product:     tieredProduct([3]string{"zero", "Inf", "0.1"}),
+			quantity:    1,
+			expectError: true,
+		},
+		{
+			name:        "no on-demand terms",
+			product:     interfaces.PricingProduct{SKU: "EMPTY"},
+			quantity:    1,
+			expectError: true,
+		},
+	}
+
+	service := NewPricingService(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := service.CalculateTieredCost(tt.product, tt.quantity)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.APIErrorType) {
+					t.Errorf("expected API error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !cost.Total.Equal(money.NewAmount(tt.expectedTotal)) {
+				t.Errorf("expected total %g, got %s", tt.expectedTotal, cost.Total)
+			}
+			if len(cost.Charges) != len(tt.expectedCharges) {
+				t.Fatalf("expected %d tier charges, got %d", len(tt.expectedCharges), len(cost.Charges))
+			}
+			for i, charge := range cost.Charges {
+				if charge.Quantity != tt.expectedCharges[i] {
+					t.Errorf("expected tier %d quantity %.0f, got %.0f", i, tt.expectedCharges[i], charge.Quantity)
+				}
+			}
+			if tt.expectedString != "" && cost.String() != tt.expectedString {
+				t.Errorf("expected breakdown %q, got %q", tt.expectedString, cost.String())
+			}
+		})
+	}
+}
+
+func TestExtractPriceTiers(t *testing.T) {
+	service := NewPricingService(nil)
+	tiers, err := service.ExtractPriceTiers(tieredProduct(
+		[3]string{"51200", "Inf", "0.022"},
+		[3]string{"0", "51200", "0.023"},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tiers) != 2 {
+		t.Fatalf("expected 2 tiers, got %d", len(tiers))
+	}
+	if tiers[0].BeginRange != 0 || tiers[0].EndRange != 51200 || !tiers[0].PricePerUnit.Equal(money.NewAmount(0.023)) {
+		t.Errorf("unexpected first tier: %+v", tiers[0])
+	}
+	if tiers[1].BeginRange != 51200 || !math.IsInf(tiers[1].EndRange, 1) || tiers[1].Unit != "GB-Mo" {
+		t.Errorf("unexpected last tier: %+v", tiers[1])
+	}
+}
@@ -0,0 +1,373 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+)
+
+// DiskCacheVersion is the on-disk entry format version. Entries written with a
+// different version are treated as misses and removed by CleanupExpired.
+const DiskCacheVersion = 1
+
+const (
+	diskEntryExt          = ".json"
+	diskTempPrefix        = ".tmp-"
+	defaultDiskTTL        = 24 * time.Hour
+	defaultDiskMaxEntries = 5000
+	defaultDiskMaxBytes   = 256 * 1024 * 1024 // 256 MB
+)
+
+// DiskCache persists pricing data across CLI invocations. Each entry is a
+// separate file named after the generateKey digest, written to a temporary
+// file and atomically renamed into place so parallel processes never observe
+// partially written entries.
+type DiskCache struct {
+	dir        string
+	ttl        time.Duration
+	maxEntries int
+	maxBytes   int64
+	hits       int64 // Accessed atomically
+	misses     int64 // Accessed atomically
+}
+
+// DiskCacheConfig holds configuration for the disk cache
+type DiskCacheConfig struct {
+	Dir        string        // Cache directory (defaults to DefaultDiskCacheDir)
+	TTL        time.Duration // Entry lifetime (defaults to 24 hours)
+	MaxEntries int           // Maximum number of entries (defaults to 5000)
+	MaxBytes   int64         // Maximum total size in bytes (defaults to 256 MB)
+}
+
+// diskEntry is the versioned on-disk representation of a cached pricing result
+type diskEntry struct {
+	Version     int                         `json:"version"`
+	ServiceCode string                      `json:"serviceCode"`
+	Filters     map[string]string           `json:"filters"`
+	Products    []interfaces.PricingProduct `json:"products"`
+	Timestamp   time.Time                   `json:"timestamp"`
+	ExpiresAt   time.Time                   `json:"expiresAt"` // Timestamp plus the TTL the entry was written with
+}
+
+// diskFile describes an entry file found in the cache directory
+type diskFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// DefaultDiskCacheDir returns the default cache directory under the user cache dir
+func DefaultDiskCacheDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", errors.FileErrorWithCause("failed to determine user cache directory", err).
+			WithSuggestion("Set --cache-dir to an explicit cache directory")
+	}
+	return filepath.Join(userCacheDir, "shylock", "pricing"), nil
+}
+
+// NewDiskCache creates a disk cache, creating its directory if needed
+func NewDiskCache(config *DiskCacheConfig) (*DiskCache, error) {
+	if config == nil {
+		config = &DiskCacheConfig{}
+	}
+
+	dir := config.Dir
+	if dir == "" {
+		defaultDir, err := DefaultDiskCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = defaultDir
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.FileErrorWithCause("failed to create cache directory", err).
+			WithContext("cacheDir", dir).
+			WithSuggestion("Check directory permissions or set --cache-dir to a writable directory")
+	}
+
+	cache := &DiskCache{
+		dir:        dir,
+		ttl:        config.TTL,
+		maxEntries: config.MaxEntries,
+		maxBytes:   config.MaxBytes,
+	}
+	if cache.ttl <= 0 {
+		cache.ttl = defaultDiskTTL
+	}
+	if cache.maxEntries <= 0 {
+		cache.maxEntries = defaultDiskMaxEntries
+	}
+	if cache.maxBytes <= 0 {
+		cache.maxBytes = defaultDiskMaxBytes
+	}
+
+	return cache, nil
+}
+
+// Dir returns the cache directory
+func (c *DiskCache) Dir() string {
+	return c.dir
+}
+
+// Get retrieves cached pricing data if available, current and not expired.
+// An entry is fresh for the shorter of the TTL it was written with and the
+// TTL of this cache.
+func (c *DiskCache) Get(serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, bool) {
+	entry, err := c.readEntry(c.entryPath(generateKey(serviceCode, filters)))
+	if err != nil {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+
+	if entry.Version != DiskCacheVersion || time.Since(entry.Timestamp) > c.ttl || c.expired(entry) {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+
+	// Guard against digest collisions
+	if entry.ServiceCode != serviceCode || !sameFilters(entry.Filters, filters) {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+
+	atomic.AddInt64(&c.hits, 1)
+	return entry.Products, true
+}
+
+// Set stores pricing data on disk, evicting the oldest entries if limits are exceeded.
+// Write failures are ignored: the cache is an optimization, never a source of errors.
+func (c *DiskCache) Set(serviceCode string, filters map[string]string, products []interfaces.PricingProduct) {
+	now := time.Now()
+	entry := diskEntry{
+		Version:     DiskCacheVersion,
+		ServiceCode: serviceCode,
+		Filters:     filters,
+		Products:    products,
+		Timestamp:   now,
+		ExpiresAt:   now.Add(c.ttl),
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	temp, err := os.CreateTemp(c.dir, diskTempPrefix+"*")
+	if err != nil {
+		return
+	}
+	tempPath := temp.Name()
+
+	_, writeErr := temp.Write(data)
+	closeErr := temp.Close()
+	if writeErr != nil || closeErr != nil {
+		os.Remove(tempPath)
+		return
+	}
+
+	// Rename is atomic, so concurrent readers see either the old or the new entry
+	if err := os.Rename(tempPath, c.entryPath(generateKey(serviceCode, filters))); err != nil {
+		os.Remove(tempPath)
+		return
+	}
+
+	c.enforceLimits()
+}
+
+// Clear removes all entries from the cache and returns the number removed
+func (c *DiskCache) Clear() int {
+	files, err := c.listFiles()
+	if err != nil {
+		return 0
+	}
+
+	var removed int
+	for _, file := range files {
+		if removeFile(file.path) {
+			removed++
+		}
+	}
+	return removed
+}
+
+// Stats returns cache statistics. TotalHits and TotalMisses only count lookups made by this process.
+func (c *DiskCache) Stats() CacheStats {
+	stats := CacheStats{
+		TotalHits:    atomic.LoadInt64(&c.hits),
+		TotalMisses:  atomic.LoadInt64(&c.misses),
+		TTL:          c.ttl,
+		MaxEntries:   c.maxEntries,
+		MaxSizeBytes: c.maxBytes,
+	}
+
+	files, err := c.listFiles()
+	if err != nil {
+		return stats
+	}
+
+	for _, file := range files {
+		stats.TotalEntries++
+		stats.SizeBytes += file.size
+		if c.isStale(file.path) {
+			stats.ExpiredEntries++
+		}
+	}
+
+	return stats
+}
+
+// CleanupExpired removes expired, unreadable and incompatible-version entries
+func (c *DiskCache) CleanupExpired() int {
+	files, err := c.listFiles()
+	if err != nil {
+		return 0
+	}
+
+	var removed int
+	for _, file := range files {
+		if c.isStale(file.path) && removeFile(file.path) {
+			removed++
+		}
+	}
+	return removed
+}
+
+// Prune removes stale entries and then evicts the oldest entries until the
+// cache is within its size limits. It returns the total number removed.
+func (c *DiskCache) Prune() int {
+	return c.CleanupExpired() + c.enforceLimits()
+}
+
+// enforceLimits evicts the least recently written entries until both the
+// entry count and total size limits are satisfied
+func (c *DiskCache) enforceLimits() int {
+	files, err := c.listFiles()
+	if err != nil {
+		return 0
+	}
+
+	var totalBytes int64
+	for _, file := range files {
+		totalBytes += file.size
+	}
+
+	if len(files) <= c.maxEntries && totalBytes <= c.maxBytes {
+		return 0
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	var removed int
+	remaining := len(files)
+	for _, file := range files {
+		if remaining <= c.maxEntries && totalBytes <= c.maxBytes {
+			break
+		}
+		if removeFile(file.path) {
+			removed++
+		}
+		// Another process may have removed it first; either way it no longer counts
+		remaining--
+		totalBytes -= file.size
+	}
+
+	return removed
+}
+
+// isStale reports whether an entry file is expired, unreadable or from another
+// format version. Entries expire with the TTL they were written with, so
+// pruning with a shorter TTL keeps entries written for longer.
+func (c *DiskCache) isStale(path string) bool {
+	entry, err := c.readEntry(path)
+	if err != nil {
+		return true
+	}
+	return entry.Version != DiskCacheVersion || c.expired(entry)
+}
+
+// expired reports whether an entry is past its expiry time. Entries written
+// without one expire after the TTL of this cache.
+func (c *DiskCache) expired(entry *diskEntry) bool {
+	expiresAt := entry.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = entry.Timestamp.Add(c.ttl)
+	}
+	return time.Now().After(expiresAt)
+}
+
+// readEntry reads and decodes an entry file
+func (c *DiskCache) readEntry(path string) (*diskEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// listFiles returns the entry files in the cache directory, skipping in-flight temporary files
+func (c *DiskCache) listFiles() ([]diskFile, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []diskFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, diskTempPrefix) || filepath.Ext(name) != diskEntryExt {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue // Removed by another process since ReadDir
+		}
+
+		files = append(files, diskFile{
+			path:    filepath.Join(c.dir, name),
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		})
+	}
+
+	return files, nil
+}
+
+// entryPath returns the file path for a cache key
+func (c *DiskCache) entryPath(key string) string {
+	return filepath.Join(c.dir, key+diskEntryExt)
+}
+
+// removeFile removes a file, reporting whether this call removed it
+func removeFile(path string) bool {
+	return os.Remove(path) == nil
+}
+
+// sameFilters reports whether two filter sets are identical
+func sameFilters(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, exists := b[key]; !exists || other != value {
+			return false
+		}
+	}
+	return true
+}
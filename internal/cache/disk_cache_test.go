@@ -0,0 +1,265 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"shylock/internal/interfaces"
+)
+
+func newTestDiskCache(t *testing.T, config *DiskCacheConfig) *DiskCache {
+	t.Helper()
+	if config.Dir == "" {
+		config.Dir = t.TempDir()
+	}
+	cache, err := NewDiskCache(config)
+	if err != nil {
+		t.Fatalf("failed to create disk cache: %v", err)
+	}
+	return cache
+}
+
+func TestNewDiskCache_Defaults(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{})
+
+	if cache.ttl != 24*time.Hour {
+		t.Errorf("Expected default TTL 24h, got %v", cache.ttl)
+	}
+	if cache.maxEntries != 5000 {
+		t.Errorf("Expected default max entries 5000, got %d", cache.maxEntries)
+	}
+	if cache.maxBytes != 256*1024*1024 {
+		t.Errorf("Expected default max bytes 256MB, got %d", cache.maxBytes)
+	}
+}
+
+func TestNewDiskCache_CreatesDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "cache")
+	newTestDiskCache(t, &DiskCacheConfig{Dir: dir})
+
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		t.Errorf("Expected cache directory to be created, got %v", err)
+	}
+}
+
+func TestDiskCache_PersistsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+	products := []interfaces.PricingProduct{
+		{SKU: "TEST123", ServiceCode: "AmazonEC2", Attributes: map[string]string{"instanceType": "t3.micro"}},
+	}
+	filters := map[string]string{"instanceType": "t3.micro"}
+
+	first := newTestDiskCache(t, &DiskCacheConfig{Dir: dir})
+	first.Set("AmazonEC2", filters, products)
+
+	// A second instance simulates a later CLI invocation
+	second := newTestDiskCache(t, &DiskCacheConfig{Dir: dir})
+	cached, found := second.Get("AmazonEC2", filters)
+	if !found {
+		t.Fatal("Expected to find cached products written by another instance")
+	}
+	if len(cached) != 1 || cached[0].SKU != "TEST123" {
+		t.Errorf("Unexpected cached products: %+v", cached)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, generateKey("AmazonEC2", filters)+".json")); err != nil {
+		t.Errorf("Expected entry file named after the cache key: %v", err)
+	}
+
+	if _, found := second.Get("AmazonEC2", map[string]string{"instanceType": "t3.small"}); found {
+		t.Error("Expected miss for different filters")
+	}
+	if stats := second.Stats(); stats.TotalHits != 1 || stats.TotalMisses != 1 {
+		t.Errorf("Expected 1 hit and 1 miss, got %d hits and %d misses", stats.TotalHits, stats.TotalMisses)
+	}
+}
+
+func TestDiskCache_Expiration(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{TTL: 50 * time.Millisecond})
+	cache.Set("AmazonEC2", nil, []interfaces.PricingProduct{{SKU: "TEST123"}})
+
+	if _, found := cache.Get("AmazonEC2", nil); !found {
+		t.Fatal("Expected to find fresh entry")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if _, found := cache.Get("AmazonEC2", nil); found {
+		t.Error("Expected expired entry to be a miss")
+	}
+
+	stats := cache.Stats()
+	if stats.TotalEntries != 1 || stats.ExpiredEntries != 1 {
+		t.Errorf("Expected 1 expired entry, got %+v", stats)
+	}
+
+	if removed := cache.CleanupExpired(); removed != 1 {
+		t.Errorf("Expected 1 removed entry, got %d", removed)
+	}
+	if stats := cache.Stats(); stats.TotalEntries != 0 {
+		t.Errorf("Expected empty cache after cleanup, got %d entries", stats.TotalEntries)
+	}
+}
+
+func TestDiskCache_EntryTTL(t *testing.T) {
+	dir := t.TempDir()
+	weekly := newTestDiskCache(t, &DiskCacheConfig{Dir: dir, TTL: 7 * 24 * time.Hour})
+	weekly.Set("AmazonEC2", nil, []interfaces.PricingProduct{{SKU: "TEST123"}})
+
+	// Age the entry by three days, past the default TTL but within its own
+	path := weekly.entryPath(generateKey("AmazonEC2", nil))
+	entry, err := weekly.readEntry(path)
+	if err != nil {
+		t.Fatalf("failed to read entry: %v", err)
+	}
+	entry.Timestamp = entry.Timestamp.Add(-72 * time.Hour)
+	entry.ExpiresAt = entry.ExpiresAt.Add(-72 * time.Hour)
+	data, _ := json.Marshal(entry)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("failed to write entry: %v", err)
+	}
+
+	daily := newTestDiskCache(t, &DiskCacheConfig{Dir: dir})
+	if stats := daily.Stats(); stats.ExpiredEntries != 0 {
+		t.Errorf("Expected no expired entries, got %d", stats.ExpiredEntries)
+	}
+	if removed := daily.Prune(); removed != 0 {
+		t.Errorf("Expected prune to keep the entry, removed %d", removed)
+	}
+	if _, found := weekly.Get("AmazonEC2", nil); !found {
+		t.Error("Expected entry to be fresh with a 7-day TTL")
+	}
+	if _, found := daily.Get("AmazonEC2", nil); found {
+		t.Error("Expected entry older than the 24h TTL to be a miss")
+	}
+}
+
+func TestDiskCache_IgnoresOtherVersions(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{})
+	key := generateKey("AmazonEC2", nil)
+
+	entry := fmt.Sprintf(`{"version": %d, "serviceCode": "AmazonEC2", "products": [], "timestamp": %q}`,
+		DiskCacheVersion+1, time.Now().Format(time.RFC3339Nano))
+	if err := os.WriteFile(cache.entryPath(key), []byte(entry), 0644); err != nil {
+		t.Fatalf("failed to write entry: %v", err)
+	}
+	if err := os.WriteFile(cache.entryPath("corrupt"), []byte("{not json"), 0644); err != nil {
+		t.Fatalf("failed to write entry: %v", err)
+	}
+
+	if _, found := cache.Get("AmazonEC2", nil); found {
+		t.Error("Expected entry with a different format version to be a miss")
+	}
+	if removed := cache.CleanupExpired(); removed != 2 {
+		t.Errorf("Expected incompatible and corrupt entries to be removed, got %d", removed)
+	}
+}
+
+func TestDiskCache_Clear(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{})
+	cache.Set("AmazonEC2", map[string]string{"a": "1"}, nil)
+	cache.Set("AmazonEC2", map[string]string{"a": "2"}, nil)
+
+	if removed := cache.Clear(); removed != 2 {
+		t.Errorf("Expected 2 removed entries, got %d", removed)
+	}
+	if stats := cache.Stats(); stats.TotalEntries != 0 || stats.SizeBytes != 0 {
+		t.Errorf("Expected empty cache after clear, got %+v", stats)
+	}
+}
+
+func TestDiskCache_EntryLimit(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{MaxEntries: 2})
+
+	for i := 0; i < 3; i++ {
+		cache.Set("AmazonEC2", map[string]string{"index": fmt.Sprintf("%d", i)}, nil)
+		// Ensure distinct modification times for oldest-first eviction
+		path := cache.entryPath(generateKey("AmazonEC2", map[string]string{"index": fmt.Sprintf("%d", i)}))
+		when := time.Now().Add(time.Duration(i-3) * time.Minute)
+		os.Chtimes(path, when, when)
+	}
+
+	cache.Prune()
+
+	if stats := cache.Stats(); stats.TotalEntries != 2 {
+		t.Errorf("Expected 2 entries after eviction, got %d", stats.TotalEntries)
+	}
+	if _, found := cache.Get("AmazonEC2", map[string]string{"index": "0"}); found {
+		t.Error("Expected oldest entry to be evicted")
+	}
+	if _, found := cache.Get("AmazonEC2", map[string]string{"index": "2"}); !found {
+		t.Error("Expected newest entry to be kept")
+	}
+}
+
+func TestDiskCache_SizeLimit(t *testing.T) {
+	cache := newTestDiskCache(t, &DiskCacheConfig{MaxBytes: 1})
+	cache.Set("AmazonEC2", nil, []interfaces.PricingProduct{{SKU: "TEST123"}})
+
+	stats := cache.Stats()
+	if stats.TotalEntries != 0 {
+		t.Errorf("Expected entries larger than the size limit to be evicted, got %d", stats.TotalEntries)
+	}
+	if stats.MaxSizeBytes != 1 {
+		t.Errorf("Expected max size 1, got %d", stats.MaxSizeBytes)
+	}
+}
+
+func TestDiskCache_ConcurrentAccess(t *testing.T) {
+	dir := t.TempDir()
+	products := []interfaces.PricingProduct{{SKU: "TEST123"}}
+
+	// Separate instances over one directory behave like parallel CLI processes
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache, err := NewDiskCache(&DiskCacheConfig{Dir: dir})
+			if err != nil {
+				t.Errorf("failed to create disk cache: %v", err)
+				return
+			}
+			for j := 0; j < 20; j++ {
+				cache.Set("AmazonEC2", map[string]string{"index": fmt.Sprintf("%d", j%4)}, products)
+				if cached, found := cache.Get("AmazonEC2", map[string]string{"index": fmt.Sprintf("%d", j%4)}); found && len(cached) != 1 {
+					t.Errorf("Read a partially written entry: %+v", cached)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	cache := newTestDiskCache(t, &DiskCacheConfig{Dir: dir})
+	if stats := cache.Stats(); stats.TotalEntries != 4 {
+		t.Errorf("Expected 4 entries, got %d", stats.TotalEntries)
+	}
+
+	leftovers, _ := filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	if len(leftovers) != 0 {
+		t.Errorf("Expected no leftover temporary files, got %v", leftovers)
+	}
+}
+
+func TestCachedAWSClient_DiskStore(t *testing.T) {
+	mockClient := &MockAWSClient{
+		products: []interfaces.PricingProduct{{SKU: "TEST123"}},
+	}
+	cache := newTestDiskCache(t, &DiskCacheConfig{})
+	cachedClient := NewCachedAWSClient(mockClient, cache)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cachedClient.GetProducts(nil, "AmazonEC2", map[string]string{"a": "b"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if mockClient.callCount != 1 {
+		t.Errorf("Expected 1 API call, got %d", mockClient.callCount)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"shylock/internal/interfaces"
@@ -17,13 +18,14 @@ type PricingCache struct {
 	mutex      sync.RWMutex
 	ttl        time.Duration
 	maxEntries int
+	misses     int64 // Accessed atomically
 }
 
 // CacheEntry represents a cached pricing result
 type CacheEntry struct {
 	Products  []interfaces.PricingProduct
 	Timestamp time.Time
-	Hits      int64
+	Hits      int64 // Accessed atomically
 }
 
 // NewPricingCache creates a new pricing cache
@@ -51,16 +53,18 @@ func (c *PricingCache) Get(serviceCode string, filters map[string]string) ([]int
 	entry, exists := c.cache[key]
 
 	if !exists {
+		atomic.AddInt64(&c.misses, 1)
 		return nil, false
 	}
 
 	// Check if entry is expired
 	if time.Since(entry.Timestamp) > c.ttl {
+		atomic.AddInt64(&c.misses, 1)
 		return nil, false
 	}
 
-	// Increment hit counter
-	entry.Hits++
+	// Increment hit counter; concurrent readers share the read lock
+	atomic.AddInt64(&entry.Hits, 1)
 
 	return entry.Products, true
 }
@@ -102,7 +106,7 @@ func (c *PricingCache) Stats() CacheStats {
 	now := time.Now()
 
 	for _, entry := range c.cache {
-		totalHits += entry.Hits
+		totalHits += atomic.LoadInt64(&entry.Hits)
 		if now.Sub(entry.Timestamp) > c.ttl {
 			expiredEntries++
 		}
@@ -112,6 +116,7 @@ func (c *PricingCache) Stats() CacheStats {
 		TotalEntries:   len(c.cache),
 		ExpiredEntries: expiredEntries,
 		TotalHits:      totalHits,
+		TotalMisses:    atomic.LoadInt64(&c.misses),
 		TTL:            c.ttl,
 		MaxEntries:     c.maxEntries,
 	}
@@ -122,13 +127,27 @@ type CacheStats struct {
 	TotalEntries   int
 	ExpiredEntries int
 	TotalHits      int64
+	TotalMisses    int64
 	TTL            time.Duration
 	MaxEntries     int
+	SizeBytes      int64 // Only reported by the disk cache
+	MaxSizeBytes   int64 // Only reported by the disk cache
+}
+
+// Store is a pricing data store that CachedAWSClient reads through
+type Store interface {
+	Get(serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, bool)
+	Set(serviceCode string, filters map[string]string, products []interfaces.PricingProduct)
 }
 
 // generateKey creates a cache key from service code and filters
 func (c *PricingCache) generateKey(serviceCode string, filters map[string]string) string {
-	// Create a deterministic key from service code and filters
+	return generateKey(serviceCode, filters)
+}
+
+// generateKey creates a deterministic cache key from service code and filters.
+// It is shared by the in-memory and on-disk caches so both index entries identically.
+func generateKey(serviceCode string, filters map[string]string) string {
 	hasher := sha256.New()
 	hasher.Write([]byte(serviceCode))
 
@@ -200,11 +219,11 @@ func (c *PricingCache) CleanupExpired() int {
 // CachedAWSClient wraps an AWS client with caching capabilities
 type CachedAWSClient struct {
 	client interfaces.AWSPricingClient
-	cache  *PricingCache
+	cache  Store
 }
 
-// NewCachedAWSClient creates a new cached AWS client
-func NewCachedAWSClient(client interfaces.AWSPricingClient, cache *PricingCache) interfaces.AWSPricingClient {
+// NewCachedAWSClient creates a new cached AWS client backed by an in-memory or on-disk store
+func NewCachedAWSClient(client interfaces.AWSPricingClient, cache Store) interfaces.AWSPricingClient {
 	return &CachedAWSClient{
 		client: client,
 		cache:  cache,
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -151,6 +152,40 @@ func TestPricingCache_Stats(t *testing.T) {
 	if stats.TotalHits != 2 {
 		t.Errorf("Expected 2 hits, got %d", stats.TotalHits)
 	}
+
+	// Get a miss
+	cache.Get("EC2", map[string]string{"type": "t3.large"})
+
+	stats = cache.Stats()
+	if stats.TotalMisses != 1 {
+		t.Errorf("Expected 1 miss, got %d", stats.TotalMisses)
+	}
+}
+
+func TestPricingCache_ConcurrentAccess(t *testing.T) {
+	cache := NewPricingCache(10*time.Minute, 100)
+	cache.Set("EC2", map[string]string{"type": "t3.micro"}, []interfaces.PricingProduct{{SKU: "1"}})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cache.Get("EC2", map[string]string{"type": "t3.micro"})
+				cache.Get("EC2", map[string]string{"type": "t3.large"})
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := cache.Stats()
+	if stats.TotalHits != 1000 {
+		t.Errorf("Expected 1000 hits, got %d", stats.TotalHits)
+	}
+	if stats.TotalMisses != 1000 {
+		t.Errorf("Expected 1000 misses, got %d", stats.TotalMisses)
+	}
 }
 
 func TestPricingCache_Clear(t *testing.T) {
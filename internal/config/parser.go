@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"shylock/internal/errors"
@@ -21,11 +22,20 @@ type Parser struct {
 func NewParser() interfaces.ConfigParser {
 	return &Parser{
 		supportedResourceTypes: map[string]bool{
-			"EC2":    true,
-			"S3":     true,
-			"RDS":    true,
-			"ALB":    true,
-			"Lambda": true,
+			"EC2":          true,
+			"EBS":          true,
+			"S3":           true,
+			"RDS":          true,
+			"ALB":          true,
+			"Lambda":       true,
+			"NATGateway":   true,
+			"DataTransfer": true,
+			"DynamoDB":     true,
+			"CloudFront":   true,
+			"ElastiCache":  true,
+			"Fargate":      true,
+			"EKS":          true,
+			"APIGateway":   true,
 			// Add more supported types as they are implemented
 		},
 	}
@@ -143,6 +153,16 @@ func (p *Parser) ValidateConfig(config *models.EstimationConfig) error {
 		return errors.WrapError(err, errors.ValidationErrorType, "configuration options validation failed")
 	}
 
+	if err := p.validateBudget(config); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "budget validation failed").
+			WithSuggestion("Budget ceilings are monthly amounts in the configuration currency")
+	}
+
+	if err := p.validateSavingsPlans(config.Options.SavingsPlans); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "savings plans validation failed").
+			WithSuggestion("hourlyCommitment is the hourly spend committed at Savings Plan rates")
+	}
+
 	return nil
 }
 
@@ -151,6 +171,8 @@ func (p *Parser) validateResourceSpecific(resource *models.ResourceSpec) error {
 	switch resource.Type {
 	case "EC2":
 		return p.validateEC2Resource(resource)
+	case "EBS":
+		return p.validateEBSResource(resource)
 	case "S3":
 		return p.validateS3Resource(resource)
 	case "RDS":
@@ -159,6 +181,22 @@ func (p *Parser) validateResourceSpecific(resource *models.ResourceSpec) error {
 		return p.validateALBResource(resource)
 	case "Lambda":
 		return p.validateLambdaResource(resource)
+	case "NATGateway":
+		return p.validateNATGatewayResource(resource)
+	case "DataTransfer":
+		return p.validateDataTransferResource(resource)
+	case "DynamoDB":
+		return p.validateDynamoDBResource(resource)
+	case "CloudFront":
+		return p.validateCloudFrontResource(resource)
+	case "ElastiCache":
+		return p.validateElastiCacheResource(resource)
+	case "Fargate":
+		return p.validateFargateResource(resource)
+	case "EKS":
+		return p.validateEKSResource(resource)
+	case "APIGateway":
+		return p.validateAPIGatewayResource(resource)
 	default:
 		return fmt.Errorf("validation not implemented for resource type: %s", resource.Type)
 	}
@@ -196,7 +234,45 @@ func (p *Parser) validateEC2Resource(resource *models.ResourceSpec) error {
 		}
 	}
 
-	return nil
+	// Validate attached volumes, monitoring and addresses
+	if value, exists := resource.GetProperty("rootVolume"); exists {
+		if err := p.validateAttachedVolume(value); err != nil {
+			return fmt.Errorf("invalid rootVolume: %w", err)
+		}
+	}
+	if value, exists := resource.GetProperty("volumes"); exists {
+		volumes, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("volumes must be a list of volumes")
+		}
+		for i, volume := range volumes {
+			if err := p.validateAttachedVolume(volume); err != nil {
+				return fmt.Errorf("invalid volumes[%d]: %w", i, err)
+			}
+		}
+	}
+	if value, exists := resource.GetProperty("detailedMonitoring"); exists {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("detailedMonitoring must be a boolean")
+		}
+	}
+	if _, exists := resource.GetProperty("elasticIPs"); exists {
+		elasticIPs, err := resource.GetIntProperty("elasticIPs")
+		if err != nil {
+			return fmt.Errorf("elasticIPs must be a number: %w", err)
+		}
+		if elasticIPs < 0 {
+			return fmt.Errorf("elasticIPs must be non-negative, got %d", elasticIPs)
+		}
+	}
+
+	if err := p.validatePurchaseOption(resource, []string{"OnDemand", "Reserved", "Spot"}); err != nil {
+		return err
+	}
+	if err := p.validateSpotProperties(resource); err != nil {
+		return err
+	}
+	return p.validateSchedule(resource)
 }
 
 // validateS3Resource validates S3-specific properties
@@ -236,6 +312,100 @@ func (p *Parser) validateS3Resource(resource *models.ResourceSpec) error {
 	return nil
 }
 
+// validateEBSResource validates EBS-specific properties
+func (p *Parser) validateEBSResource(resource *models.ResourceSpec) error {
+	// Required properties for EBS
+	requiredProps := []string{"volumeType", "sizeGB"}
+
+	for _, prop := range requiredProps {
+		if _, exists := resource.GetProperty(prop); !exists {
+			return fmt.Errorf("missing required property '%s' for EBS resource", prop)
+		}
+	}
+
+	if err := p.validateVolume(resource); err != nil {
+		return err
+	}
+
+	if _, exists := resource.GetProperty("snapshotGB"); exists {
+		snapshotGB, err := resource.GetIntProperty("snapshotGB")
+		if err != nil {
+			return fmt.Errorf("snapshotGB must be a number: %w", err)
+		}
+		if snapshotGB < 0 {
+			return fmt.Errorf("snapshotGB must be non-negative, got %d", snapshotGB)
+		}
+	}
+
+	if _, exists := resource.GetProperty("count"); exists {
+		count, err := resource.GetIntProperty("count")
+		if err != nil {
+			return fmt.Errorf("count must be a number: %w", err)
+		}
+		if count <= 0 {
+			return fmt.Errorf("count must be greater than 0, got %d", count)
+		}
+	}
+
+	return nil
+}
+
+// validateVolume validates the volumeType, sizeGB, iops and throughputMBps
+// properties of an EBS volume
+func (p *Parser) validateVolume(volume *models.ResourceSpec) error {
+	volumeType, err := volume.GetStringProperty("volumeType")
+	if err != nil {
+		return fmt.Errorf("volumeType must be a string: %w", err)
+	}
+
+	validVolumeTypes := []string{"gp2", "gp3", "io1", "io2", "st1", "sc1"}
+	if !p.contains(validVolumeTypes, volumeType) {
+		return fmt.Errorf("invalid volume type '%s'. Valid options: %s",
+			volumeType, strings.Join(validVolumeTypes, ", "))
+	}
+
+	sizeGB, err := volume.GetIntProperty("sizeGB")
+	if err != nil {
+		return fmt.Errorf("sizeGB must be a number: %w", err)
+	}
+	if sizeGB <= 0 {
+		return fmt.Errorf("sizeGB must be greater than 0, got %d", sizeGB)
+	}
+
+	// Validate optional numeric properties
+	numericProps := []string{"iops", "throughputMBps"}
+	for _, prop := range numericProps {
+		if _, exists := volume.GetProperty(prop); exists {
+			value, err := volume.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAttachedVolume validates a volume declared on an EC2 resource
+func (p *Parser) validateAttachedVolume(value interface{}) error {
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("must be an object with volumeType and sizeGB")
+	}
+
+	volume := &models.ResourceSpec{Properties: properties}
+	for _, prop := range []string{"volumeType", "sizeGB"} {
+		if _, exists := volume.GetProperty(prop); !exists {
+			return fmt.Errorf("missing required property '%s'", prop)
+		}
+	}
+
+	return p.validateVolume(volume)
+}
+
 // validateRDSResource validates RDS-specific properties
 func (p *Parser) validateRDSResource(resource *models.ResourceSpec) error {
 	// Required properties for RDS
@@ -259,6 +429,91 @@ func (p *Parser) validateRDSResource(resource *models.ResourceSpec) error {
 			engine, strings.Join(validEngines, ", "))
 	}
 
+	if err := p.validatePurchaseOption(resource, []string{"OnDemand", "Reserved"}); err != nil {
+		return err
+	}
+	return p.validateSchedule(resource)
+}
+
+// validatePurchaseOption validates the purchaseOption property against the
+// options a resource type supports, and the reserved term properties that only
+// apply when it is Reserved
+func (p *Parser) validatePurchaseOption(resource *models.ResourceSpec, validOptions []string) error {
+	reservedProps := map[string][]string{
+		"term":          {"1yr", "3yr"},
+		"offeringClass": {"standard", "convertible"},
+		"paymentOption": {"No Upfront", "Partial Upfront", "All Upfront"},
+	}
+
+	purchaseOption := "OnDemand"
+	if _, exists := resource.GetProperty("purchaseOption"); exists {
+		value, err := resource.GetStringProperty("purchaseOption")
+		if err != nil {
+			return fmt.Errorf("purchaseOption must be a string: %w", err)
+		}
+		if !p.contains(validOptions, value) {
+			return fmt.Errorf("invalid purchaseOption '%s'. Valid options: %s",
+				value, strings.Join(validOptions, ", "))
+		}
+		purchaseOption = value
+	}
+
+	for _, prop := range []string{"term", "offeringClass", "paymentOption"} {
+		if _, exists := resource.GetProperty(prop); !exists {
+			continue
+		}
+		if purchaseOption != "Reserved" {
+			return fmt.Errorf("%s only applies when purchaseOption is 'Reserved'", prop)
+		}
+		value, err := resource.GetStringProperty(prop)
+		if err != nil {
+			return fmt.Errorf("%s must be a string: %w", prop, err)
+		}
+		if !p.contains(reservedProps[prop], value) {
+			return fmt.Errorf("invalid %s '%s'. Valid options: %s",
+				prop, value, strings.Join(reservedProps[prop], ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateSpotProperties validates the EC2 properties that only apply when
+// purchaseOption is Spot
+func (p *Parser) validateSpotProperties(resource *models.ResourceSpec) error {
+	isSpot := false
+	if purchaseOption, err := resource.GetStringProperty("purchaseOption"); err == nil {
+		isSpot = purchaseOption == "Spot"
+	}
+
+	for _, prop := range []string{"spotPercentile", "availabilityZone"} {
+		if _, exists := resource.GetProperty(prop); exists && !isSpot {
+			return fmt.Errorf("%s only applies when purchaseOption is 'Spot'", prop)
+		}
+	}
+
+	if _, exists := resource.GetProperty("spotPercentile"); exists {
+		percentile, err := resource.GetStringProperty("spotPercentile")
+		if err != nil {
+			return fmt.Errorf("spotPercentile must be a string: %w", err)
+		}
+		validPercentiles := []string{"p50", "p90", "max"}
+		if !p.contains(validPercentiles, percentile) {
+			return fmt.Errorf("invalid spotPercentile '%s'. Valid options: %s",
+				percentile, strings.Join(validPercentiles, ", "))
+		}
+	}
+
+	if _, exists := resource.GetProperty("availabilityZone"); exists {
+		zone, err := resource.GetStringProperty("availabilityZone")
+		if err != nil {
+			return fmt.Errorf("availabilityZone must be a string: %w", err)
+		}
+		if !strings.HasPrefix(zone, resource.Region) {
+			return fmt.Errorf("availabilityZone '%s' is not in region %s", zone, resource.Region)
+		}
+	}
+
 	return nil
 }
 
@@ -299,6 +554,416 @@ func (p *Parser) validateALBResource(resource *models.ResourceSpec) error {
 		}
 	}
 
+	return p.validateSchedule(resource)
+}
+
+// validateNATGatewayResource validates NAT gateway-specific properties
+func (p *Parser) validateNATGatewayResource(resource *models.ResourceSpec) error {
+	if _, exists := resource.GetProperty("count"); exists {
+		count, err := resource.GetIntProperty("count")
+		if err != nil {
+			return fmt.Errorf("count must be a number: %w", err)
+		}
+		if count <= 0 {
+			return fmt.Errorf("count must be greater than 0, got %d", count)
+		}
+	}
+
+	if _, exists := resource.GetProperty("dataProcessedGB"); exists {
+		dataProcessedGB, err := resource.GetIntProperty("dataProcessedGB")
+		if err != nil {
+			return fmt.Errorf("dataProcessedGB must be a number: %w", err)
+		}
+		if dataProcessedGB < 0 {
+			return fmt.Errorf("dataProcessedGB must be non-negative, got %d", dataProcessedGB)
+		}
+	}
+
+	return nil
+}
+
+// validateDataTransferResource validates data transfer-specific properties
+func (p *Parser) validateDataTransferResource(resource *models.ResourceSpec) error {
+	// At least one kind of transfer is required
+	transferProps := []string{"internetEgressGB", "interRegionGB", "interAZGB", "cloudFrontOriginGB"}
+	found := false
+	for _, prop := range transferProps {
+		if _, exists := resource.GetProperty(prop); !exists {
+			continue
+		}
+		found = true
+		value, err := resource.GetIntProperty(prop)
+		if err != nil {
+			return fmt.Errorf("%s must be a number: %w", prop, err)
+		}
+		if value < 0 {
+			return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+		}
+	}
+	if !found {
+		return fmt.Errorf("DataTransfer resource requires at least one of: %s", strings.Join(transferProps, ", "))
+	}
+
+	// Inter-region transfer needs a destination in another region
+	_, hasInterRegion := resource.GetProperty("interRegionGB")
+	_, hasDestination := resource.GetProperty("destinationRegion")
+	if hasInterRegion != hasDestination {
+		return fmt.Errorf("interRegionGB and destinationRegion must be set together")
+	}
+	if hasDestination {
+		destinationRegion, err := resource.GetStringProperty("destinationRegion")
+		if err != nil {
+			return fmt.Errorf("destinationRegion must be a string: %w", err)
+		}
+		if destinationRegion == resource.Region {
+			return fmt.Errorf("destinationRegion must differ from the resource region %s", resource.Region)
+		}
+	}
+
+	return nil
+}
+
+// validateDynamoDBResource validates DynamoDB-specific properties
+func (p *Parser) validateDynamoDBResource(resource *models.ResourceSpec) error {
+	capacityMode := "OnDemand"
+	if _, exists := resource.GetProperty("capacityMode"); exists {
+		mode, err := resource.GetStringProperty("capacityMode")
+		if err != nil {
+			return fmt.Errorf("capacityMode must be a string: %w", err)
+		}
+		validModes := []string{"OnDemand", "Provisioned"}
+		if !p.contains(validModes, mode) {
+			return fmt.Errorf("invalid capacityMode '%s'. Valid options: %s",
+				mode, strings.Join(validModes, ", "))
+		}
+		capacityMode = mode
+	}
+
+	if _, exists := resource.GetProperty("tableClass"); exists {
+		tableClass, err := resource.GetStringProperty("tableClass")
+		if err != nil {
+			return fmt.Errorf("tableClass must be a string: %w", err)
+		}
+		validClasses := []string{"STANDARD", "STANDARD_INFREQUENT_ACCESS"}
+		if !p.contains(validClasses, tableClass) {
+			return fmt.Errorf("invalid tableClass '%s'. Valid options: %s",
+				tableClass, strings.Join(validClasses, ", "))
+		}
+	}
+
+	// Request units apply to on-demand tables, capacity units to provisioned ones
+	if capacityMode == "Provisioned" {
+		for _, prop := range []string{"readCapacityUnits", "writeCapacityUnits"} {
+			if _, exists := resource.GetProperty(prop); !exists {
+				return fmt.Errorf("missing required property '%s' for provisioned DynamoDB resource", prop)
+			}
+		}
+		for _, prop := range []string{"readRequestUnits", "writeRequestUnits"} {
+			if _, exists := resource.GetProperty(prop); exists {
+				return fmt.Errorf("%s only applies to capacityMode 'OnDemand'", prop)
+			}
+		}
+	} else {
+		for _, prop := range []string{"readCapacityUnits", "writeCapacityUnits", "autoScaling"} {
+			if _, exists := resource.GetProperty(prop); exists {
+				return fmt.Errorf("%s only applies to capacityMode 'Provisioned'", prop)
+			}
+		}
+	}
+
+	numericProps := []string{"readRequestUnits", "writeRequestUnits", "readCapacityUnits", "writeCapacityUnits",
+		"storageGB", "globalTableReplicas", "backupStorageGB"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	if value, exists := resource.GetProperty("autoScaling"); exists {
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("autoScaling must be an object with minimum and maximum read and write capacity")
+		}
+	}
+
+	if value, exists := resource.GetProperty("pointInTimeRecovery"); exists {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("pointInTimeRecovery must be a boolean")
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontResource validates CloudFront-specific properties
+func (p *Parser) validateCloudFrontResource(resource *models.ResourceSpec) error {
+	if _, exists := resource.GetProperty("priceClass"); exists {
+		priceClass, err := resource.GetStringProperty("priceClass")
+		if err != nil {
+			return fmt.Errorf("priceClass must be a string: %w", err)
+		}
+		validClasses := []string{"PriceClass_All", "PriceClass_200", "PriceClass_100"}
+		if !p.contains(validClasses, priceClass) {
+			return fmt.Errorf("invalid priceClass '%s'. Valid options: %s",
+				priceClass, strings.Join(validClasses, ", "))
+		}
+	}
+
+	numericProps := []string{"dataTransferOutGB", "httpRequests", "httpsRequests", "originShieldRequests",
+		"invalidationPaths", "functionInvocations"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	// The traffic split gives the percentage of traffic served in each geography
+	if value, exists := resource.GetProperty("trafficSplit"); exists {
+		split, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("trafficSplit must be an object of geography percentages")
+		}
+		validGeographies := []string{"NorthAmerica", "Europe", "SouthAfrica", "MiddleEast", "SouthAmerica",
+			"Japan", "Australia", "AsiaPacific", "India"}
+		splitSpec := models.ResourceSpec{Properties: split}
+		total := 0
+		for geography := range split {
+			if !p.contains(validGeographies, geography) {
+				return fmt.Errorf("invalid trafficSplit geography '%s'. Valid options: %s",
+					geography, strings.Join(validGeographies, ", "))
+			}
+			percent, err := splitSpec.GetIntProperty(geography)
+			if err != nil {
+				return fmt.Errorf("trafficSplit %s must be a number: %w", geography, err)
+			}
+			if percent < 0 {
+				return fmt.Errorf("trafficSplit %s must be non-negative, got %d", geography, percent)
+			}
+			total += percent
+		}
+		if total != 100 {
+			return fmt.Errorf("trafficSplit percentages must add up to 100, got %d", total)
+		}
+	}
+
+	return nil
+}
+
+// validateElastiCacheResource validates ElastiCache-specific properties
+func (p *Parser) validateElastiCacheResource(resource *models.ResourceSpec) error {
+	if _, exists := resource.GetProperty("engine"); !exists {
+		return fmt.Errorf("missing required property 'engine' for ElastiCache resource")
+	}
+	engine, err := resource.GetStringProperty("engine")
+	if err != nil {
+		return fmt.Errorf("engine must be a string: %w", err)
+	}
+	validEngines := []string{"redis", "valkey", "memcached"}
+	if !p.contains(validEngines, engine) {
+		return fmt.Errorf("invalid engine '%s'. Valid options: %s",
+			engine, strings.Join(validEngines, ", "))
+	}
+
+	deploymentType := "NodeBased"
+	if _, exists := resource.GetProperty("deploymentType"); exists {
+		deploymentType, err = resource.GetStringProperty("deploymentType")
+		if err != nil {
+			return fmt.Errorf("deploymentType must be a string: %w", err)
+		}
+		validTypes := []string{"NodeBased", "Serverless"}
+		if !p.contains(validTypes, deploymentType) {
+			return fmt.Errorf("invalid deploymentType '%s'. Valid options: %s",
+				deploymentType, strings.Join(validTypes, ", "))
+		}
+	}
+
+	// Node-based clusters are sized by node type, serverless caches by usage
+	if deploymentType == "NodeBased" {
+		if _, exists := resource.GetProperty("nodeType"); !exists {
+			return fmt.Errorf("missing required property 'nodeType' for node-based ElastiCache resource")
+		}
+		nodeType, err := resource.GetStringProperty("nodeType")
+		if err != nil {
+			return fmt.Errorf("nodeType must be a string: %w", err)
+		}
+		if !strings.HasPrefix(nodeType, "cache.") {
+			return fmt.Errorf("invalid nodeType '%s'. Node types start with 'cache.' (e.g., cache.r6g.large)", nodeType)
+		}
+	}
+
+	numericProps := []string{"shards", "replicasPerShard", "dataStoredGB", "ecpusPerMonth",
+		"snapshotSizeGB", "backupRetentionDays"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateFargateResource validates Fargate-specific properties
+func (p *Parser) validateFargateResource(resource *models.ResourceSpec) error {
+	// Required properties for Fargate
+	requiredProps := []string{"vcpu", "memoryGB"}
+
+	for _, prop := range requiredProps {
+		if _, exists := resource.GetProperty(prop); !exists {
+			return fmt.Errorf("missing required property '%s' for Fargate resource", prop)
+		}
+		value, err := resource.GetFloatProperty(prop)
+		if err != nil {
+			return fmt.Errorf("%s must be a number: %w", prop, err)
+		}
+		if value <= 0 {
+			return fmt.Errorf("%s must be positive, got %g", prop, value)
+		}
+	}
+
+	stringProps := map[string][]string{
+		"operatingSystem": {"Linux", "Windows"},
+		"architecture":    {"x86_64", "arm64"},
+	}
+	for prop, validValues := range stringProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetStringProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a string: %w", prop, err)
+			}
+			if !p.contains(validValues, value) {
+				return fmt.Errorf("invalid %s '%s'. Valid options: %s",
+					prop, value, strings.Join(validValues, ", "))
+			}
+		}
+	}
+
+	numericProps := []string{"tasks", "ephemeralStorageGB", "spotPercentage"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	if spot, err := resource.GetIntProperty("spotPercentage"); err == nil && spot > 100 {
+		return fmt.Errorf("spotPercentage must be between 0 and 100, got %d", spot)
+	}
+
+	return p.validateSchedule(resource)
+}
+
+// validateEKSResource validates EKS-specific properties
+func (p *Parser) validateEKSResource(resource *models.ResourceSpec) error {
+	if _, exists := resource.GetProperty("clusters"); exists {
+		clusters, err := resource.GetIntProperty("clusters")
+		if err != nil {
+			return fmt.Errorf("clusters must be a number: %w", err)
+		}
+		if clusters < 1 {
+			return fmt.Errorf("clusters must be at least 1, got %d", clusters)
+		}
+	}
+
+	if _, exists := resource.GetProperty("supportType"); exists {
+		supportType, err := resource.GetStringProperty("supportType")
+		if err != nil {
+			return fmt.Errorf("supportType must be a string: %w", err)
+		}
+		validTypes := []string{"Standard", "Extended"}
+		if !p.contains(validTypes, supportType) {
+			return fmt.Errorf("invalid supportType '%s'. Valid options: %s",
+				supportType, strings.Join(validTypes, ", "))
+		}
+	}
+
+	return p.validateSchedule(resource)
+}
+
+// validateAPIGatewayResource validates API Gateway-specific properties
+func (p *Parser) validateAPIGatewayResource(resource *models.ResourceSpec) error {
+	if _, exists := resource.GetProperty("apiType"); !exists {
+		return fmt.Errorf("missing required property 'apiType' for APIGateway resource")
+	}
+	apiType, err := resource.GetStringProperty("apiType")
+	if err != nil {
+		return fmt.Errorf("apiType must be a string: %w", err)
+	}
+	validTypes := []string{"REST", "HTTP", "WebSocket"}
+	if !p.contains(validTypes, apiType) {
+		return fmt.Errorf("invalid apiType '%s'. Valid options: %s",
+			apiType, strings.Join(validTypes, ", "))
+	}
+
+	numericProps := []string{"requestsPerMonth", "averageRequestSizeKB", "messagesPerMonth",
+		"averageMessageSizeKB", "connectionMinutesPerMonth"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return fmt.Errorf("%s must be a number: %w", prop, err)
+			}
+			if value < 0 {
+				return fmt.Errorf("%s must be non-negative, got %d", prop, value)
+			}
+		}
+	}
+
+	// Caching is only available for REST APIs
+	if _, exists := resource.GetProperty("cacheSizeGB"); exists {
+		if apiType != "REST" {
+			return fmt.Errorf("cacheSizeGB is only supported for REST APIs, got apiType '%s'", apiType)
+		}
+		cacheSize, err := resource.GetFloatProperty("cacheSizeGB")
+		if err != nil {
+			return fmt.Errorf("cacheSizeGB must be a number: %w", err)
+		}
+		validSizes := []string{"0.5", "1.6", "6.1", "13.5", "28.4", "58.2", "118", "237"}
+		if !p.contains(validSizes, strconv.FormatFloat(cacheSize, 'f', -1, 64)) {
+			return fmt.Errorf("invalid cacheSizeGB %g. Valid options: %s",
+				cacheSize, strings.Join(validSizes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateSchedule validates the optional schedule property of resources that
+// can be stopped outside working hours
+func (p *Parser) validateSchedule(resource *models.ResourceSpec) error {
+	schedule, err := resource.GetSchedule()
+	if err != nil {
+		return fmt.Errorf("invalid schedule: %w. %s", err, models.ScheduleSuggestion)
+	}
+	if schedule == nil {
+		return nil
+	}
+
+	if purchaseOption, err := resource.GetStringProperty("purchaseOption"); err == nil && purchaseOption == "Reserved" {
+		return fmt.Errorf("schedule cannot be combined with purchaseOption 'Reserved'")
+	}
+
 	return nil
 }
 
@@ -371,10 +1036,98 @@ func (p *Parser) validateOptions(options *models.ConfigOptions) error {
 
 	// Validate timeFrame if specified
 	if options.TimeFrame != "" {
-		validTimeFrames := []string{"hourly", "daily", "monthly"}
-		if !p.contains(validTimeFrames, options.TimeFrame) {
+		if !p.contains(models.TimeFrames, options.TimeFrame) {
 			return fmt.Errorf("invalid timeFrame '%s'. Valid options: %s",
-				options.TimeFrame, strings.Join(validTimeFrames, ", "))
+				options.TimeFrame, strings.Join(models.TimeFrames, ", "))
+		}
+	}
+
+	// Validate month if specified
+	if options.Month != "" {
+		if _, err := models.HoursInMonth(options.Month); err != nil {
+			return fmt.Errorf("invalid month: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateBudget validates budget ceilings. Ceilings must name supported
+// resource types and resources in the configuration, so a typo cannot
+// silently disable a limit.
+func (p *Parser) validateBudget(config *models.EstimationConfig) error {
+	budget := config.Options.Budget
+	if budget == nil {
+		return nil
+	}
+
+	if budget.MaxMonthly < 0 {
+		return fmt.Errorf("maxMonthly cannot be negative, got %.2f", budget.MaxMonthly)
+	}
+
+	for resourceType, ceiling := range budget.ResourceTypes {
+		if !p.supportedResourceTypes[resourceType] {
+			return fmt.Errorf("budget for unsupported resource type '%s'. Valid options: %s",
+				resourceType, p.getSupportedTypesString())
+		}
+		if ceiling <= 0 {
+			return fmt.Errorf("budget for resource type '%s' must be positive, got %.2f", resourceType, ceiling)
+		}
+	}
+
+	names := make(map[string]bool, len(config.Resources))
+	for _, resource := range config.Resources {
+		names[resource.Name] = true
+	}
+	for name, ceiling := range budget.Resources {
+		if !names[name] {
+			return fmt.Errorf("budget for unknown resource '%s'", name)
+		}
+		if ceiling <= 0 {
+			return fmt.Errorf("budget for resource '%s' must be positive, got %.2f", name, ceiling)
+		}
+	}
+
+	return nil
+}
+
+// validateSavingsPlans validates the Savings Plan commitment
+func (p *Parser) validateSavingsPlans(plan *models.SavingsPlans) error {
+	if plan == nil {
+		return nil
+	}
+
+	// Resource types each plan type can cover
+	coveredTypes := map[string][]string{
+		models.SavingsPlanTypeCompute:     {"EC2", "Fargate", "Lambda"},
+		models.SavingsPlanTypeEC2Instance: {"EC2"},
+	}
+
+	covered, exists := coveredTypes[plan.Type]
+	if !exists {
+		return fmt.Errorf("invalid savings plan type '%s'. Valid options: %s, %s",
+			plan.Type, models.SavingsPlanTypeCompute, models.SavingsPlanTypeEC2Instance)
+	}
+
+	if plan.HourlyCommitment <= 0 {
+		return fmt.Errorf("hourlyCommitment must be positive, got %.4f", plan.HourlyCommitment)
+	}
+
+	if plan.Type == models.SavingsPlanTypeEC2Instance {
+		if plan.InstanceFamily == "" || plan.Region == "" {
+			return fmt.Errorf("EC2Instance savings plans require instanceFamily and region")
+		}
+	} else if plan.InstanceFamily != "" || plan.Region != "" {
+		return fmt.Errorf("instanceFamily and region only apply to EC2Instance savings plans")
+	}
+
+	for resourceType, discount := range plan.Discounts {
+		if !p.contains(covered, resourceType) {
+			return fmt.Errorf("%s savings plans do not cover resource type '%s'. Covered types: %s",
+				plan.Type, resourceType, strings.Join(covered, ", "))
+		}
+		if discount <= 0 || discount >= 100 {
+			return fmt.Errorf("discount for '%s' must be between 0 and 100 percent, got %.2f", resourceType, discount)
 		}
 	}
 
@@ -388,7 +1141,7 @@ func (p *Parser) applyDefaults(config *models.EstimationConfig) {
 		config.Options.Currency = "USD"
 	}
 	if config.Options.TimeFrame == "" {
-		config.Options.TimeFrame = "monthly"
+		config.Options.TimeFrame = models.TimeFrameMonthly
 	}
 
 	// Apply default region to resources that don't have one
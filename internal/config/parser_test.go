@@ -190,6 +190,264 @@ func TestValidateEC2Resource(t *testing.T) {
 			expectError: true,
 			errorMsg:    "count must be greater than 0",
 		},
+		{
+			name: "valid reserved EC2 resource",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Reserved",
+					"term":           "3yr",
+					"offeringClass":  "convertible",
+					"paymentOption":  "All Upfront",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid purchaseOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Dedicated",
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid purchaseOption 'Dedicated'",
+		},
+		{
+			name: "valid spot EC2 resource",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "batch-worker",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":     "c5.xlarge",
+					"purchaseOption":   "Spot",
+					"spotPercentile":   "p90",
+					"availabilityZone": "us-east-1b",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid spotPercentile",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "batch-worker",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "c5.xlarge",
+					"purchaseOption": "Spot",
+					"spotPercentile": "p99",
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid spotPercentile 'p99'",
+		},
+		{
+			name: "availabilityZone outside the region",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "batch-worker",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":     "c5.xlarge",
+					"purchaseOption":   "Spot",
+					"availabilityZone": "us-west-2a",
+				},
+			},
+			expectError: true,
+			errorMsg:    "availabilityZone 'us-west-2a' is not in region us-east-1",
+		},
+		{
+			name: "spotPercentile without spot purchaseOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "batch-worker",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "c5.xlarge",
+					"spotPercentile": "p50",
+				},
+			},
+			expectError: true,
+			errorMsg:    "spotPercentile only applies when purchaseOption is 'Spot'",
+		},
+		{
+			name: "invalid paymentOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Reserved",
+					"paymentOption":  "Some Upfront",
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid paymentOption 'Some Upfront'",
+		},
+		{
+			name: "term without reserved purchaseOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "t3.micro",
+					"term":         "1yr",
+				},
+			},
+			expectError: true,
+			errorMsg:    "term only applies when purchaseOption is 'Reserved'",
+		},
+		{
+			name: "instance with volumes, monitoring and Elastic IPs",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "m5.large",
+					"rootVolume":   map[string]interface{}{"volumeType": "gp3", "sizeGB": 30},
+					"volumes": []interface{}{
+						map[string]interface{}{"volumeType": "io2", "sizeGB": 500, "iops": 10000},
+					},
+					"detailedMonitoring": true,
+					"elasticIPs":         1,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "rootVolume not an object",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "m5.large",
+					"rootVolume":   "gp3",
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid rootVolume: must be an object",
+		},
+		{
+			name: "volume without sizeGB",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "m5.large",
+					"volumes": []interface{}{
+						map[string]interface{}{"volumeType": "gp3", "sizeGB": 100},
+						map[string]interface{}{"volumeType": "gp3"},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid volumes[1]: missing required property 'sizeGB'",
+		},
+		{
+			name: "invalid volume type",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "m5.large",
+					"volumes": []interface{}{
+						map[string]interface{}{"volumeType": "magnetic", "sizeGB": 100},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid volume type 'magnetic'",
+		},
+		{
+			name: "detailedMonitoring not a boolean",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":       "m5.large",
+					"detailedMonitoring": "enabled",
+				},
+			},
+			expectError: true,
+			errorMsg:    "detailedMonitoring must be a boolean",
+		},
+		{
+			name: "negative elasticIPs",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "app-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "m5.large",
+					"elasticIPs":   -2,
+				},
+			},
+			expectError: true,
+			errorMsg:    "elasticIPs must be non-negative",
+		},
+		{
+			name: "scheduled instance",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "dev-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "t3.large",
+					"schedule": map[string]interface{}{
+						"days":     "weekdays",
+						"start":    "08:00",
+						"end":      "20:00",
+						"timeZone": "Europe/London",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid schedule",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "dev-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType": "t3.large",
+					"schedule":     map[string]interface{}{"days": "weekdays", "start": "08:00"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid schedule: schedule needs both start and end",
+		},
+		{
+			name: "schedule with reserved purchaseOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "dev-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.large",
+					"purchaseOption": "Reserved",
+					"schedule":       map[string]interface{}{"hoursPerMonth": float64(200)},
+				},
+			},
+			expectError: true,
+			errorMsg:    "schedule cannot be combined with purchaseOption 'Reserved'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -296,38 +554,784 @@ func TestValidateS3Resource(t *testing.T) {
 	}
 }
 
-func TestValidateOptions(t *testing.T) {
-	parser := &Parser{}
+func TestValidateEBSResource(t *testing.T) {
+	parser := &Parser{
+		supportedResourceTypes: map[string]bool{"EBS": true},
+	}
 
 	tests := []struct {
 		name        string
-		options     models.ConfigOptions
+		properties  map[string]interface{}
 		expectError bool
 		errorMsg    string
 	}{
 		{
-			name: "valid options",
-			options: models.ConfigOptions{
-				Currency:  "USD",
-				TimeFrame: "monthly",
-			},
-			expectError: false,
+			name:       "valid EBS resource",
+			properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "iops": 6000, "count": 2, "snapshotGB": 20},
 		},
 		{
-			name: "invalid currency",
-			options: models.ConfigOptions{
-				Currency: "INVALID",
-			},
+			name:        "missing volumeType",
+			properties:  map[string]interface{}{"sizeGB": 100},
 			expectError: true,
-			errorMsg:    "invalid currency",
+			errorMsg:    "missing required property 'volumeType'",
 		},
 		{
-			name: "invalid timeFrame",
-			options: models.ConfigOptions{
-				TimeFrame: "yearly",
-			},
+			name:        "missing sizeGB",
+			properties:  map[string]interface{}{"volumeType": "gp3"},
 			expectError: true,
-			errorMsg:    "invalid timeFrame",
+			errorMsg:    "missing required property 'sizeGB'",
+		},
+		{
+			name:        "invalid volumeType",
+			properties:  map[string]interface{}{"volumeType": "gp4", "sizeGB": 100},
+			expectError: true,
+			errorMsg:    "invalid volume type",
+		},
+		{
+			name:        "negative iops",
+			properties:  map[string]interface{}{"volumeType": "io2", "sizeGB": 100, "iops": -1},
+			expectError: true,
+			errorMsg:    "iops must be non-negative",
+		},
+		{
+			name:        "zero count",
+			properties:  map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "count": 0},
+			expectError: true,
+			errorMsg:    "count must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "EBS", Name: "data-volume", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateEBSResource(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateNetworkResources(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name         string
+		resourceType string
+		properties   map[string]interface{}
+		expectError  bool
+		errorMsg     string
+	}{
+		{
+			name:         "valid NAT gateway",
+			resourceType: "NATGateway",
+			properties:   map[string]interface{}{"count": 2, "dataProcessedGB": 500},
+		},
+		{
+			name:         "NAT gateway with defaults",
+			resourceType: "NATGateway",
+			properties:   map[string]interface{}{},
+		},
+		{
+			name:         "zero NAT gateways",
+			resourceType: "NATGateway",
+			properties:   map[string]interface{}{"count": 0},
+			expectError:  true,
+			errorMsg:     "count must be greater than 0",
+		},
+		{
+			name:         "negative dataProcessedGB",
+			resourceType: "NATGateway",
+			properties:   map[string]interface{}{"dataProcessedGB": -5},
+			expectError:  true,
+			errorMsg:     "dataProcessedGB must be non-negative",
+		},
+		{
+			name:         "valid data transfer",
+			resourceType: "DataTransfer",
+			properties:   map[string]interface{}{"internetEgressGB": 2000, "interRegionGB": 300, "destinationRegion": "eu-west-1", "interAZGB": 800},
+		},
+		{
+			name:         "no data transfer",
+			resourceType: "DataTransfer",
+			properties:   map[string]interface{}{},
+			expectError:  true,
+			errorMsg:     "requires at least one of",
+		},
+		{
+			name:         "negative internetEgressGB",
+			resourceType: "DataTransfer",
+			properties:   map[string]interface{}{"internetEgressGB": -1},
+			expectError:  true,
+			errorMsg:     "internetEgressGB must be non-negative",
+		},
+		{
+			name:         "inter-region without destination",
+			resourceType: "DataTransfer",
+			properties:   map[string]interface{}{"interRegionGB": 300},
+			expectError:  true,
+			errorMsg:     "interRegionGB and destinationRegion must be set together",
+		},
+		{
+			name:         "destination in the same region",
+			resourceType: "DataTransfer",
+			properties:   map[string]interface{}{"interRegionGB": 300, "destinationRegion": "us-east-1"},
+			expectError:  true,
+			errorMsg:     "destinationRegion must differ from the resource region",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: tt.resourceType, Name: "network", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDynamoDBResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:       "valid on-demand table",
+			properties: map[string]interface{}{"readRequestUnits": 50000000, "writeRequestUnits": 10000000, "storageGB": 100},
+		},
+		{
+			name: "valid provisioned table with auto scaling",
+			properties: map[string]interface{}{
+				"capacityMode": "Provisioned", "readCapacityUnits": 100, "writeCapacityUnits": 50,
+				"autoScaling": map[string]interface{}{"minReadCapacity": 10, "maxReadCapacity": 200, "minWriteCapacity": 5, "maxWriteCapacity": 100},
+			},
+		},
+		{
+			name:        "invalid capacity mode",
+			properties:  map[string]interface{}{"capacityMode": "Burst"},
+			expectError: true,
+			errorMsg:    "invalid capacityMode 'Burst'",
+		},
+		{
+			name:        "invalid table class",
+			properties:  map[string]interface{}{"tableClass": "ARCHIVE"},
+			expectError: true,
+			errorMsg:    "invalid tableClass 'ARCHIVE'",
+		},
+		{
+			name:        "provisioned without capacity",
+			properties:  map[string]interface{}{"capacityMode": "Provisioned", "writeCapacityUnits": 5},
+			expectError: true,
+			errorMsg:    "missing required property 'readCapacityUnits'",
+		},
+		{
+			name:        "auto scaling on on-demand table",
+			properties:  map[string]interface{}{"autoScaling": map[string]interface{}{}},
+			expectError: true,
+			errorMsg:    "autoScaling only applies to capacityMode 'Provisioned'",
+		},
+		{
+			name:        "negative replicas",
+			properties:  map[string]interface{}{"globalTableReplicas": -1},
+			expectError: true,
+			errorMsg:    "globalTableReplicas must be non-negative",
+		},
+		{
+			name:        "pointInTimeRecovery not a boolean",
+			properties:  map[string]interface{}{"pointInTimeRecovery": "enabled"},
+			expectError: true,
+			errorMsg:    "pointInTimeRecovery must be a boolean",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "DynamoDB", Name: "table", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "valid distribution",
+			properties: map[string]interface{}{
+				"priceClass": "PriceClass_200", "dataTransferOutGB": 5000, "httpsRequests": 50000000,
+				"trafficSplit": map[string]interface{}{"NorthAmerica": 60, "AsiaPacific": 40},
+			},
+		},
+		{
+			name:        "invalid price class",
+			properties:  map[string]interface{}{"priceClass": "PriceClass_300"},
+			expectError: true,
+			errorMsg:    "invalid priceClass 'PriceClass_300'",
+		},
+		{
+			name:        "negative invalidation paths",
+			properties:  map[string]interface{}{"invalidationPaths": -10},
+			expectError: true,
+			errorMsg:    "invalidationPaths must be non-negative",
+		},
+		{
+			name:        "unknown geography",
+			properties:  map[string]interface{}{"trafficSplit": map[string]interface{}{"Mars": 100}},
+			expectError: true,
+			errorMsg:    "invalid trafficSplit geography 'Mars'",
+		},
+		{
+			name:        "split not adding up to 100",
+			properties:  map[string]interface{}{"trafficSplit": map[string]interface{}{"NorthAmerica": 60, "Europe": 60}},
+			expectError: true,
+			errorMsg:    "must add up to 100, got 120",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "CloudFront", Name: "cdn", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateElastiCacheResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:       "valid cluster",
+			properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "shards": 2, "replicasPerShard": 1},
+		},
+		{
+			name:       "valid serverless cache",
+			properties: map[string]interface{}{"engine": "valkey", "deploymentType": "Serverless", "dataStoredGB": 5, "ecpusPerMonth": 1000000},
+		},
+		{
+			name:        "missing engine",
+			properties:  map[string]interface{}{"nodeType": "cache.r6g.large"},
+			expectError: true,
+			errorMsg:    "missing required property 'engine'",
+		},
+		{
+			name:        "invalid engine",
+			properties:  map[string]interface{}{"engine": "mongodb", "nodeType": "cache.r6g.large"},
+			expectError: true,
+			errorMsg:    "invalid engine 'mongodb'",
+		},
+		{
+			name:        "invalid deployment type",
+			properties:  map[string]interface{}{"engine": "redis", "deploymentType": "Provisioned"},
+			expectError: true,
+			errorMsg:    "invalid deploymentType 'Provisioned'",
+		},
+		{
+			name:        "missing node type",
+			properties:  map[string]interface{}{"engine": "redis"},
+			expectError: true,
+			errorMsg:    "missing required property 'nodeType'",
+		},
+		{
+			name:        "RDS instance class",
+			properties:  map[string]interface{}{"engine": "memcached", "nodeType": "db.r6g.large"},
+			expectError: true,
+			errorMsg:    "invalid nodeType 'db.r6g.large'",
+		},
+		{
+			name:        "negative snapshot size",
+			properties:  map[string]interface{}{"engine": "valkey", "deploymentType": "Serverless", "snapshotSizeGB": -5},
+			expectError: true,
+			errorMsg:    "snapshotSizeGB must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "ElastiCache", Name: "cache", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFargateResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "valid tasks",
+			properties: map[string]interface{}{
+				"vcpu": 0.5, "memoryGB": 1, "tasks": 4, "architecture": "arm64",
+				"schedule": map[string]interface{}{"hoursPerMonth": 400},
+			},
+		},
+		{
+			name:        "missing memory",
+			properties:  map[string]interface{}{"vcpu": 1},
+			expectError: true,
+			errorMsg:    "missing required property 'memoryGB'",
+		},
+		{
+			name:        "zero vcpu",
+			properties:  map[string]interface{}{"vcpu": 0, "memoryGB": 2},
+			expectError: true,
+			errorMsg:    "vcpu must be positive",
+		},
+		{
+			name:        "invalid operating system",
+			properties:  map[string]interface{}{"vcpu": 1, "memoryGB": 2, "operatingSystem": "FreeBSD"},
+			expectError: true,
+			errorMsg:    "invalid operatingSystem 'FreeBSD'",
+		},
+		{
+			name:        "Spot share above 100",
+			properties:  map[string]interface{}{"vcpu": 1, "memoryGB": 2, "spotPercentage": 150},
+			expectError: true,
+			errorMsg:    "spotPercentage must be between 0 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "Fargate", Name: "service", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateEKSResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:       "valid clusters",
+			properties: map[string]interface{}{"clusters": 2, "supportType": "Extended"},
+		},
+		{
+			name:       "defaults",
+			properties: map[string]interface{}{},
+		},
+		{
+			name:        "zero clusters",
+			properties:  map[string]interface{}{"clusters": 0},
+			expectError: true,
+			errorMsg:    "clusters must be at least 1",
+		},
+		{
+			name:        "invalid support type",
+			properties:  map[string]interface{}{"supportType": "LTS"},
+			expectError: true,
+			errorMsg:    "invalid supportType 'LTS'",
+		},
+		{
+			name:        "invalid schedule",
+			properties:  map[string]interface{}{"schedule": map[string]interface{}{"days": "someday"}},
+			expectError: true,
+			errorMsg:    "invalid schedule",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "EKS", Name: "cluster", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAPIGatewayResource(t *testing.T) {
+	parser := NewParser().(*Parser)
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:       "valid REST API with cache",
+			properties: map[string]interface{}{"apiType": "REST", "requestsPerMonth": 1000000, "cacheSizeGB": 0.5},
+		},
+		{
+			name:       "valid WebSocket API",
+			properties: map[string]interface{}{"apiType": "WebSocket", "messagesPerMonth": 1000000, "connectionMinutesPerMonth": 500000},
+		},
+		{
+			name:        "missing API type",
+			properties:  map[string]interface{}{"requestsPerMonth": 1000000},
+			expectError: true,
+			errorMsg:    "missing required property 'apiType'",
+		},
+		{
+			name:        "invalid API type",
+			properties:  map[string]interface{}{"apiType": "GraphQL"},
+			expectError: true,
+			errorMsg:    "invalid apiType 'GraphQL'",
+		},
+		{
+			name:        "negative requests",
+			properties:  map[string]interface{}{"apiType": "HTTP", "requestsPerMonth": -1},
+			expectError: true,
+			errorMsg:    "requestsPerMonth must be non-negative",
+		},
+		{
+			name:        "cache on HTTP API",
+			properties:  map[string]interface{}{"apiType": "HTTP", "cacheSizeGB": 0.5},
+			expectError: true,
+			errorMsg:    "cacheSizeGB is only supported for REST APIs",
+		},
+		{
+			name:        "invalid cache size",
+			properties:  map[string]interface{}{"apiType": "REST", "cacheSizeGB": 2},
+			expectError: true,
+			errorMsg:    "invalid cacheSizeGB 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "APIGateway", Name: "api", Region: "us-east-1", Properties: tt.properties}
+			err := parser.validateResourceSpecific(&resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if tt.errorMsg != "" && !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateBudget(t *testing.T) {
+	parser := NewParser().(*Parser)
+	resources := []models.ResourceSpec{
+		{Type: "EC2", Name: "web", Region: "us-east-1", Properties: map[string]interface{}{"instanceType": "t3.micro"}},
+	}
+
+	tests := []struct {
+		name        string
+		budget      *models.Budget
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:   "no budget",
+			budget: nil,
+		},
+		{
+			name: "valid budget",
+			budget: &models.Budget{
+				MaxMonthly:    500,
+				ResourceTypes: map[string]float64{"EC2": 200},
+				Resources:     map[string]float64{"web": 50},
+			},
+		},
+		{
+			name:        "negative total",
+			budget:      &models.Budget{MaxMonthly: -1},
+			expectError: true,
+			errorMsg:    "maxMonthly cannot be negative",
+		},
+		{
+			name:        "unsupported resource type",
+			budget:      &models.Budget{ResourceTypes: map[string]float64{"Redshift": 100}},
+			expectError: true,
+			errorMsg:    "unsupported resource type 'Redshift'",
+		},
+		{
+			name:        "unknown resource",
+			budget:      &models.Budget{Resources: map[string]float64{"wbe": 100}},
+			expectError: true,
+			errorMsg:    "unknown resource 'wbe'",
+		},
+		{
+			name:        "zero resource ceiling",
+			budget:      &models.Budget{Resources: map[string]float64{"web": 0}},
+			expectError: true,
+			errorMsg:    "must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &models.EstimationConfig{
+				Version:   "1.0",
+				Resources: resources,
+				Options:   models.ConfigOptions{Budget: tt.budget},
+			}
+
+			err := parser.ValidateConfig(config)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateSavingsPlans(t *testing.T) {
+	parser := NewParser().(*Parser)
+	resources := []models.ResourceSpec{
+		{Type: "EC2", Name: "web", Region: "us-east-1", Properties: map[string]interface{}{"instanceType": "m5.large"}},
+	}
+
+	tests := []struct {
+		name        string
+		plan        *models.SavingsPlans
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "no savings plans",
+			plan: nil,
+		},
+		{
+			name: "valid compute plan",
+			plan: &models.SavingsPlans{
+				Type:             "Compute",
+				HourlyCommitment: 1.5,
+				Discounts:        map[string]float64{"EC2": 30, "Lambda": 10},
+			},
+		},
+		{
+			name: "valid EC2 instance plan",
+			plan: &models.SavingsPlans{
+				Type:             "EC2Instance",
+				HourlyCommitment: 0.5,
+				InstanceFamily:   "m5",
+				Region:           "us-east-1",
+			},
+		},
+		{
+			name:        "invalid type",
+			plan:        &models.SavingsPlans{Type: "SageMaker", HourlyCommitment: 1},
+			expectError: true,
+			errorMsg:    "invalid savings plan type 'SageMaker'",
+		},
+		{
+			name:        "zero commitment",
+			plan:        &models.SavingsPlans{Type: "Compute"},
+			expectError: true,
+			errorMsg:    "hourlyCommitment must be positive",
+		},
+		{
+			name:        "EC2 instance plan without family",
+			plan:        &models.SavingsPlans{Type: "EC2Instance", HourlyCommitment: 1, Region: "us-east-1"},
+			expectError: true,
+			errorMsg:    "require instanceFamily and region",
+		},
+		{
+			name:        "compute plan with family",
+			plan:        &models.SavingsPlans{Type: "Compute", HourlyCommitment: 1, InstanceFamily: "m5"},
+			expectError: true,
+			errorMsg:    "only apply to EC2Instance savings plans",
+		},
+		{
+			name: "uncovered resource type discount",
+			plan: &models.SavingsPlans{
+				Type:             "EC2Instance",
+				HourlyCommitment: 1,
+				InstanceFamily:   "m5",
+				Region:           "us-east-1",
+				Discounts:        map[string]float64{"Lambda": 10},
+			},
+			expectError: true,
+			errorMsg:    "do not cover resource type 'Lambda'",
+		},
+		{
+			name:        "discount out of range",
+			plan:        &models.SavingsPlans{Type: "Compute", HourlyCommitment: 1, Discounts: map[string]float64{"EC2": 100}},
+			expectError: true,
+			errorMsg:    "must be between 0 and 100 percent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &models.EstimationConfig{
+				Version:   "1.0",
+				Resources: resources,
+				Options:   models.ConfigOptions{SavingsPlans: tt.plan},
+			}
+
+			err := parser.ValidateConfig(config)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+	parser := &Parser{}
+
+	tests := []struct {
+		name        string
+		options     models.ConfigOptions
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "valid options",
+			options: models.ConfigOptions{
+				Currency:  "USD",
+				TimeFrame: "monthly",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid currency",
+			options: models.ConfigOptions{
+				Currency: "INVALID",
+			},
+			expectError: true,
+			errorMsg:    "invalid currency",
+		},
+		{
+			name: "invalid timeFrame",
+			options: models.ConfigOptions{
+				TimeFrame: "yearly",
+			},
+			expectError: true,
+			errorMsg:    "invalid timeFrame",
+		},
+		{
+			name: "annual timeFrame with calendar month",
+			options: models.ConfigOptions{
+				TimeFrame: "annual",
+				Month:     "2026-02",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid month",
+			options: models.ConfigOptions{
+				Month: "02/2026",
+			},
+			expectError: true,
+			errorMsg:    "month '02/2026' is not in YYYY-MM format",
 		},
 	}
 
@@ -0,0 +1,243 @@
+package diff
+
+import (
+	"sort"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// ChangeType describes how a resource differs between two estimates
+type ChangeType string
+
+const (
+	Added     ChangeType = "added"
+	Removed   ChangeType = "removed"
+	Changed   ChangeType = "changed"
+	Unchanged ChangeType = "unchanged"
+)
+
+// Costs holds hourly, daily, monthly and annual amounts
+type Costs struct {
+	Hourly  money.Amount `json:"hourly"`
+	Daily   money.Amount `json:"daily"`
+	Monthly money.Amount `json:"monthly"`
+	Annual  money.Amount `json:"annual"`
+}
+
+// For returns the amount for a time frame, or the monthly amount for an unknown one
+func (c Costs) For(timeFrame string) money.Amount {
+	switch timeFrame {
+	case models.TimeFrameHourly:
+		return c.Hourly
+	case models.TimeFrameDaily:
+		return c.Daily
+	case models.TimeFrameAnnual:
+		return c.Annual
+	default:
+		return c.Monthly
+	}
+}
+
+// ResourceDelta is the cost difference for a single resource, matched by name
+type ResourceDelta struct {
+	Name          string     `json:"name"`
+	Type          string     `json:"type"`
+	Region        string     `json:"region"`
+	Change        ChangeType `json:"change"`
+	Old           Costs      `json:"old"`
+	New           Costs      `json:"new"`
+	Delta         Costs      `json:"delta"`
+	PercentChange *float64   `json:"percentChange,omitempty"` // Unset when the old monthly cost is zero
+}
+
+// Summary counts resources by change type
+type Summary struct {
+	Added     int `json:"added"`
+	Removed   int `json:"removed"`
+	Changed   int `json:"changed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// Result is the cost difference between two estimation results
+type Result struct {
+	Currency      string               `json:"currency"`
+	ExchangeRate  *models.ExchangeRate `json:"exchangeRate,omitempty"` // Conversion from USD prices; unset for USD
+	TimeFrame     string               `json:"timeFrame"`              // Time frame the resource changes are reported in
+	OldTotal      Costs                `json:"oldTotal"`
+	NewTotal      Costs                `json:"newTotal"`
+	Delta         Costs                `json:"delta"`
+	PercentChange *float64             `json:"percentChange,omitempty"` // Unset when the old monthly total is zero
+	Summary       Summary              `json:"summary"`
+	Resources     []ResourceDelta      `json:"resources"`
+	GeneratedAt   time.Time            `json:"generatedAt"`
+}
+
+// Compare computes per-resource and total cost differences between an old and
+// a new estimation result. Resources are matched by name, so a renamed
+// resource shows up as removed and added.
+func Compare(oldResult, newResult *models.EstimationResult) (*Result, error) {
+	if oldResult == nil || newResult == nil {
+		return nil, errors.ValidationError("both estimation results are required for a diff")
+	}
+
+	if oldResult.Currency != "" && newResult.Currency != "" && oldResult.Currency != newResult.Currency {
+		return nil, errors.ValidationError("cannot compare estimates in different currencies").
+			WithContext("oldCurrency", oldResult.Currency).
+			WithContext("newCurrency", newResult.Currency).
+			WithSuggestion("Use the same currency in both configurations, or override it with --currency")
+	}
+
+	oldCosts, err := indexByName(oldResult.ResourceCosts)
+	if err != nil {
+		return nil, errors.WrapError(err, "", "old configuration cannot be compared")
+	}
+	newCosts, err := indexByName(newResult.ResourceCosts)
+	if err != nil {
+		return nil, errors.WrapError(err, "", "new configuration cannot be compared")
+	}
+
+	currency := newResult.Currency
+	if currency == "" {
+		currency = oldResult.Currency
+	}
+
+	result := &Result{
+		Currency:     currency,
+		ExchangeRate: newResult.ExchangeRate,
+		TimeFrame:    newResult.EmphasizedTimeFrame(),
+		OldTotal:     totalsOf(oldResult),
+		NewTotal:     totalsOf(newResult),
+		Resources:    make([]ResourceDelta, 0, len(oldCosts)+len(newCosts)),
+		GeneratedAt:  time.Now(),
+	}
+	result.Delta = subtract(result.NewTotal, result.OldTotal)
+	result.PercentChange = percentChange(result.OldTotal.Monthly, result.NewTotal.Monthly)
+
+	for _, cost := range newResult.ResourceCosts {
+		delta := ResourceDelta{
+			Name:   cost.ResourceName,
+			Type:   cost.ResourceType,
+			Region: cost.Region,
+			New:    costsOf(cost),
+		}
+
+		old, existed := oldCosts[cost.ResourceName]
+		switch {
+		case !existed:
+			delta.Change = Added
+			result.Summary.Added++
+		case isChanged(old, cost):
+			delta.Old = costsOf(old)
+			delta.Change = Changed
+			result.Summary.Changed++
+		default:
+			delta.Old = costsOf(old)
+			delta.Change = Unchanged
+			result.Summary.Unchanged++
+		}
+
+		delta.Delta = subtract(delta.New, delta.Old)
+		delta.PercentChange = percentChange(delta.Old.Monthly, delta.New.Monthly)
+		result.Resources = append(result.Resources, delta)
+	}
+
+	for _, cost := range oldResult.ResourceCosts {
+		if _, exists := newCosts[cost.ResourceName]; exists {
+			continue
+		}
+
+		delta := ResourceDelta{
+			Name:   cost.ResourceName,
+			Type:   cost.ResourceType,
+			Region: cost.Region,
+			Change: Removed,
+			Old:    costsOf(cost),
+		}
+		delta.Delta = subtract(delta.New, delta.Old)
+		delta.PercentChange = percentChange(delta.Old.Monthly, delta.New.Monthly)
+		result.Resources = append(result.Resources, delta)
+		result.Summary.Removed++
+	}
+
+	sortResources(result.Resources)
+
+	return result, nil
+}
+
+// HasChanges reports whether any resource was added, removed or changed
+func (r *Result) HasChanges() bool {
+	return r.Summary.Added+r.Summary.Removed+r.Summary.Changed > 0
+}
+
+// indexByName maps resource costs by resource name, rejecting duplicate names
+// because they cannot be matched between configurations
+func indexByName(costs []models.CostEstimate) (map[string]models.CostEstimate, error) {
+	index := make(map[string]models.CostEstimate, len(costs))
+	for _, cost := range costs {
+		if _, exists := index[cost.ResourceName]; exists {
+			return nil, errors.ValidationError("duplicate resource name").
+				WithContext("resourceName", cost.ResourceName).
+				WithSuggestion("Give every resource a unique name so it can be matched between configurations")
+		}
+		index[cost.ResourceName] = cost
+	}
+	return index, nil
+}
+
+// isChanged reports whether a matched resource differs in type, region or cost
+func isChanged(before, after models.CostEstimate) bool {
+	return before.ResourceType != after.ResourceType ||
+		before.Region != after.Region ||
+		!after.HourlyCost.Equal(before.HourlyCost) ||
+		!after.MonthlyCost.Equal(before.MonthlyCost)
+}
+
+// sortResources orders resources by the size of their monthly change, largest
+// first, so the most significant changes lead the report
+func sortResources(resources []ResourceDelta) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if c := resources[i].Delta.Monthly.Abs().Cmp(resources[j].Delta.Monthly.Abs()); c != 0 {
+			return c > 0
+		}
+		// Changes with no cost impact still come before unchanged resources
+		if iUnchanged, jUnchanged := resources[i].Change == Unchanged, resources[j].Change == Unchanged; iUnchanged != jUnchanged {
+			return jUnchanged
+		}
+		return resources[i].Name < resources[j].Name
+	})
+}
+
+func costsOf(cost models.CostEstimate) Costs {
+	return Costs{Hourly: cost.HourlyCost, Daily: cost.DailyCost, Monthly: cost.MonthlyCost, Annual: cost.AnnualCost}
+}
+
+func totalsOf(result *models.EstimationResult) Costs {
+	return Costs{
+		Hourly:  result.TotalHourlyCost,
+		Daily:   result.TotalDailyCost,
+		Monthly: result.TotalMonthlyCost,
+		Annual:  result.TotalAnnualCost,
+	}
+}
+
+func subtract(a, b Costs) Costs {
+	return Costs{
+		Hourly:  a.Hourly.Sub(b.Hourly),
+		Daily:   a.Daily.Sub(b.Daily),
+		Monthly: a.Monthly.Sub(b.Monthly),
+		Annual:  a.Annual.Sub(b.Annual),
+	}
+}
+
+// percentChange returns the relative change from before to after, or nil when
+// before is zero and the change cannot be expressed as a percentage
+func percentChange(before, after money.Amount) *float64 {
+	if before.IsZero() {
+		return nil
+	}
+	percent := after.Sub(before).Div(before).MulFloat(100).Float64()
+	return &percent
+}
@@ -0,0 +1,158 @@
+package diff
+
+import (
+	"math"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+func newCost(name, resourceType, region string, hourly float64) models.CostEstimate {
+	cost := models.CostEstimate{
+		ResourceName: name,
+		ResourceType: resourceType,
+		Region:       region,
+		HourlyCost:   money.NewAmount(hourly),
+		Currency:     "USD",
+	}
+	cost.CalculateCosts()
+	return cost
+}
+
+func newResult(costs ...models.CostEstimate) *models.EstimationResult {
+	result := &models.EstimationResult{Currency: "USD", ResourceCosts: costs}
+	for _, cost := range costs {
+		result.TotalHourlyCost = result.TotalHourlyCost.Add(cost.HourlyCost)
+		result.TotalDailyCost = result.TotalDailyCost.Add(cost.DailyCost)
+		result.TotalMonthlyCost = result.TotalMonthlyCost.Add(cost.MonthlyCost)
+		result.TotalAnnualCost = result.TotalAnnualCost.Add(cost.AnnualCost)
+	}
+	return result
+}
+
+func TestCompare(t *testing.T) {
+	oldResult := newResult(
+		newCost("web", "EC2", "us-east-1", 0.10),
+		newCost("db", "RDS", "us-east-1", 0.50),
+		newCost("legacy", "EC2", "us-east-1", 0.05),
+		newCost("assets", "S3", "us-east-1", 0.01),
+		newCost("cache", "EC2", "us-east-1", 0.02),
+	)
+	newResult := newResult(
+		newCost("web", "EC2", "us-east-1", 0.20),
+		newCost("db", "RDS", "us-east-1", 0.50),
+		newCost("api", "Lambda", "us-east-1", 0.03),
+		newCost("assets", "S3", "us-east-1", 0.01),
+		newCost("cache", "EC2", "eu-west-1", 0.02),
+	)
+
+	result, err := Compare(oldResult, newResult)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedSummary := Summary{Added: 1, Removed: 1, Changed: 2, Unchanged: 2}
+	if result.Summary != expectedSummary {
+		t.Errorf("expected summary %+v, got %+v", expectedSummary, result.Summary)
+	}
+	if !result.HasChanges() {
+		t.Error("expected changes")
+	}
+
+	// Sorted by absolute monthly change, then changed before unchanged, then name
+	expectedOrder := []struct {
+		name   string
+		change ChangeType
+	}{
+		{"web", Changed},
+		{"legacy", Removed},
+		{"api", Added},
+		{"cache", Changed},
+		{"assets", Unchanged},
+		{"db", Unchanged},
+	}
+	if len(result.Resources) != len(expectedOrder) {
+		t.Fatalf("expected %d resources, got %d", len(expectedOrder), len(result.Resources))
+	}
+	for i, expected := range expectedOrder {
+		resource := result.Resources[i]
+		if resource.Name != expected.name || resource.Change != expected.change {
+			t.Errorf("position %d: expected %s (%s), got %s (%s)", i, expected.name, expected.change, resource.Name, resource.Change)
+		}
+	}
+
+	web := result.Resources[0]
+	if !web.Delta.Monthly.Equal(money.NewAmount(73)) || !web.Delta.Annual.Equal(money.NewAmount(876)) || web.PercentChange == nil || math.Abs(*web.PercentChange-100) > 1e-6 {
+		t.Errorf("unexpected delta for web: %+v", web)
+	}
+
+	legacy := result.Resources[1]
+	if !legacy.New.Monthly.IsZero() || !legacy.Delta.Hourly.Equal(money.NewAmount(-0.05)) || *legacy.PercentChange != -100 {
+		t.Errorf("unexpected delta for removed resource: %+v", legacy)
+	}
+
+	if api := result.Resources[2]; api.PercentChange != nil || !api.Old.Monthly.IsZero() {
+		t.Errorf("expected no previous cost or percentage for added resource, got %+v", api)
+	}
+
+	if cache := result.Resources[3]; cache.Region != "eu-west-1" || !cache.Delta.Monthly.IsZero() {
+		t.Errorf("expected region change without cost change, got %+v", cache)
+	}
+
+	if !result.Delta.Hourly.Equal(money.NewAmount(0.08)) || !result.Delta.Daily.Equal(money.NewAmount(1.92)) {
+		t.Errorf("unexpected total delta: %+v", result.Delta)
+	}
+	if result.PercentChange == nil || math.Abs(*result.PercentChange-0.08/0.68*100) > 1e-6 {
+		t.Errorf("unexpected total percent change: %v", result.PercentChange)
+	}
+}
+
+func TestCompareNoChanges(t *testing.T) {
+	result, err := Compare(newResult(newCost("web", "EC2", "us-east-1", 0.1)), newResult(newCost("web", "EC2", "us-east-1", 0.1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.HasChanges() {
+		t.Errorf("expected no changes, got %+v", result.Summary)
+	}
+	if result.PercentChange == nil || *result.PercentChange != 0 {
+		t.Errorf("expected 0%% change, got %v", result.PercentChange)
+	}
+}
+
+func TestCompareFromZero(t *testing.T) {
+	result, err := Compare(newResult(), newResult(newCost("web", "EC2", "us-east-1", 0.1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.PercentChange != nil {
+		t.Errorf("expected no percentage change from a zero total, got %v", *result.PercentChange)
+	}
+}
+
+func TestCompareErrors(t *testing.T) {
+	duplicate := newResult(newCost("web", "EC2", "us-east-1", 0.1), newCost("web", "EC2", "us-west-2", 0.1))
+	euro := newResult(newCost("web", "EC2", "us-east-1", 0.1))
+	euro.Currency = "EUR"
+
+	tests := []struct {
+		name      string
+		oldResult *models.EstimationResult
+		newResult *models.EstimationResult
+	}{
+		{name: "missing result", oldResult: nil, newResult: newResult()},
+		{name: "duplicate old name", oldResult: duplicate, newResult: newResult()},
+		{name: "duplicate new name", oldResult: newResult(), newResult: duplicate},
+		{name: "different currencies", oldResult: newResult(), newResult: euro},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Compare(tt.oldResult, tt.newResult); !errors.IsErrorType(err, errors.ValidationErrorType) {
+				t.Errorf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package diff
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Formats lists the output formats supported for diffs
+var Formats = []string{"table", "json", "csv", "markdown"}
+
+// Write renders a diff result in the given output format
+func Write(w io.Writer, result *Result, format string) error {
+	switch format {
+	case "table":
+		return writeTable(w, result)
+	case "json":
+		return writeJSON(w, result)
+	case "csv":
+		return writeCSV(w, result)
+	case "markdown":
+		return writeMarkdown(w, result)
+	default:
+		return errors.ValidationError("unsupported diff output format").
+			WithContext("format", format).
+			WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(Formats, ", ")))
+	}
+}
+
+// writeTable renders the diff as a human-readable table
+func writeTable(w io.Writer, result *Result) error {
+	var b strings.Builder
+
+	b.WriteString("AWS Cost Diff\n")
+	b.WriteString("=============\n")
+	b.WriteString(fmt.Sprintf("Generated: %s\n", result.GeneratedAt.Format("2006-01-02 15:04:05 MST")))
+	b.WriteString(fmt.Sprintf("Currency: %s\n\n", currencyLabel(result)))
+
+	// Summary section
+	b.WriteString("💰 Cost Change\n")
+	b.WriteString("--------------\n")
+	b.WriteString(fmt.Sprintf("%-9s %12s %12s %14s %10s\n", "", "Old", "New", "Change", "Percent"))
+	for _, row := range totalRows(result) {
+		b.WriteString(fmt.Sprintf("%-9s %12s %12s %14s %10s\n",
+			row.label, formatCost(row.before, result.Currency), formatCost(row.after, result.Currency), formatDelta(row.change, result.Currency), formatPercent(result.PercentChange)))
+	}
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%s\n", formatSummary(result.Summary)))
+
+	if len(result.Resources) == 0 {
+		b.WriteString("\nNo resources found in either configuration.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	// Resource breakdown
+	b.WriteString(fmt.Sprintf("\n📊 Resource Changes (%s)\n", result.TimeFrame))
+	b.WriteString("----------------------------\n")
+
+	nameWidth, typeWidth := len("Resource Name"), len("Type")
+	for _, resource := range result.Resources {
+		nameWidth = max(nameWidth, len(resource.Name))
+		typeWidth = max(typeWidth, len(resource.Type))
+	}
+	nameWidth += 2
+	typeWidth += 2
+
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-10s %%12s %%12s %%14s %%10s\n", nameWidth, typeWidth)
+	b.WriteString(fmt.Sprintf(rowFormat, "Resource Name", "Type", "Change", "Old", "New", "Delta", "Percent"))
+	b.WriteString(strings.Repeat("-", nameWidth+typeWidth+10+12+12+14+10+6) + "\n")
+
+	for _, resource := range result.Resources {
+		b.WriteString(fmt.Sprintf(rowFormat,
+			resource.Name,
+			resource.Type,
+			resource.Change,
+			formatResourceCost(resource, resource.Old.For(result.TimeFrame), Added, result.Currency),
+			formatResourceCost(resource, resource.New.For(result.TimeFrame), Removed, result.Currency),
+			formatDelta(resource.Delta.For(result.TimeFrame), result.Currency),
+			formatPercent(resource.PercentChange)))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeJSON renders the diff as JSON
+func writeJSON(w io.Writer, result *Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// writeCSV renders the diff as CSV with one row per resource and a total row.
+// Costs are hourly and in the time frame of the result, monthly by default.
+// Each cost column is rounded with money.RoundItems, so the rows add up to
+// the total row.
+func writeCSV(w io.Writer, result *Result) error {
+	writer := csv.NewWriter(w)
+	decimals := money.Decimals(result.Currency)
+
+	label := timeFrameLabel(result.TimeFrame)
+	header := []string{
+		"Resource Name",
+		"Resource Type",
+		"Region",
+		"Change",
+		"Old Hourly Cost",
+		"New Hourly Cost",
+		"Hourly Delta",
+		fmt.Sprintf("Old %s Cost", label),
+		fmt.Sprintf("New %s Cost", label),
+		fmt.Sprintf("%s Delta", label),
+		"Percent Change",
+		"Currency",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	costColumns := []func(Costs) money.Amount{
+		func(c Costs) money.Amount { return c.Hourly },
+		func(c Costs) money.Amount { return c.For(result.TimeFrame) },
+	}
+	var columns [][]money.Amount
+	for _, column := range costColumns {
+		for _, side := range []func(ResourceDelta) Costs{
+			func(r ResourceDelta) Costs { return r.Old },
+			func(r ResourceDelta) Costs { return r.New },
+			func(r ResourceDelta) Costs { return r.Delta },
+		} {
+			amounts := make([]money.Amount, len(result.Resources))
+			for i, resource := range result.Resources {
+				amounts[i] = column(side(resource))
+			}
+			columns = append(columns, money.RoundItems(amounts, decimals))
+		}
+	}
+
+	for i, resource := range result.Resources {
+		row := []string{
+			resource.Name,
+			resource.Type,
+			resource.Region,
+			string(resource.Change),
+		}
+		for _, column := range columns {
+			row = append(row, column[i].StringFixed(decimals))
+		}
+		row = append(row, csvPercent(resource.PercentChange), result.Currency)
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	summaryRow := []string{
+		"TOTAL",
+		"",
+		"",
+		"",
+		result.OldTotal.Hourly.StringFixed(decimals),
+		result.NewTotal.Hourly.StringFixed(decimals),
+		result.Delta.Hourly.StringFixed(decimals),
+		result.OldTotal.For(result.TimeFrame).StringFixed(decimals),
+		result.NewTotal.For(result.TimeFrame).StringFixed(decimals),
+		result.Delta.For(result.TimeFrame).StringFixed(decimals),
+		csvPercent(result.PercentChange),
+		result.Currency,
+	}
+	if err := writer.Write(summaryRow); err != nil {
+		return fmt.Errorf("failed to write CSV summary: %w", err)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeMarkdown renders the diff as GitHub-flavored Markdown for pull request
+// comments. Unchanged resources are counted but not listed to keep comments short.
+func writeMarkdown(w io.Writer, result *Result) error {
+	var b strings.Builder
+
+	b.WriteString("### 💰 AWS Cost Diff\n\n")
+	b.WriteString(fmt.Sprintf("%s cost: **%s → %s** (%s, %s)\n\n", timeFrameLabel(result.TimeFrame),
+		formatCost(result.OldTotal.For(result.TimeFrame), result.Currency), formatCost(result.NewTotal.For(result.TimeFrame), result.Currency),
+		formatDelta(result.Delta.For(result.TimeFrame), result.Currency), formatPercent(result.PercentChange)))
+
+	b.WriteString("| Period | Old | New | Change |\n")
+	b.WriteString("|---|---:|---:|---:|\n")
+	for _, row := range totalRows(result) {
+		b.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+			row.label, formatCost(row.before, result.Currency), formatCost(row.after, result.Currency), formatDelta(row.change, result.Currency)))
+	}
+
+	b.WriteString(fmt.Sprintf("\n%s\n", formatSummary(result.Summary)))
+
+	if result.HasChanges() {
+		b.WriteString(fmt.Sprintf("\n| Resource | Type | Change | Old (%s) | New (%s) | Delta | %% |\n", result.TimeFrame, result.TimeFrame))
+		b.WriteString("|---|---|---|---:|---:|---:|---:|\n")
+		for _, resource := range result.Resources {
+			if resource.Change == Unchanged {
+				continue
+			}
+			b.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s | %s | %s | %s |\n",
+				escapeMarkdown(resource.Name),
+				resource.Type,
+				changeLabel(resource.Change),
+				formatResourceCost(resource, resource.Old.For(result.TimeFrame), Added, result.Currency),
+				formatResourceCost(resource, resource.New.For(result.TimeFrame), Removed, result.Currency),
+				formatDelta(resource.Delta.For(result.TimeFrame), result.Currency),
+				formatPercent(resource.PercentChange)))
+		}
+	}
+
+	b.WriteString(fmt.Sprintf("\n<sub>Costs in %s, generated %s</sub>\n", currencyLabel(result), result.GeneratedAt.Format(time.RFC3339)))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type totalRow struct {
+	label                 string
+	before, after, change money.Amount
+}
+
+// totalRows returns the hourly, daily, monthly and annual total rows
+func totalRows(result *Result) []totalRow {
+	rows := make([]totalRow, 0, len(models.TimeFrames))
+	for _, timeFrame := range models.TimeFrames {
+		rows = append(rows, totalRow{
+			label:  timeFrameLabel(timeFrame),
+			before: result.OldTotal.For(timeFrame),
+			after:  result.NewTotal.For(timeFrame),
+			change: result.Delta.For(timeFrame),
+		})
+	}
+	return rows
+}
+
+// timeFrameLabel returns the label of a time frame, e.g. "Monthly"
+func timeFrameLabel(timeFrame string) string {
+	if timeFrame == "" {
+		timeFrame = models.TimeFrameMonthly
+	}
+	return strings.ToUpper(timeFrame[:1]) + timeFrame[1:]
+}
+
+// currencyLabel returns the currency of a diff, with the exchange rate it was
+// converted at if any
+func currencyLabel(result *Result) string {
+	rate := result.ExchangeRate
+	if rate == nil {
+		return result.Currency
+	}
+	return fmt.Sprintf("%s at %.4f per %s, rates effective %s", rate.To, rate.Rate, rate.From, rate.EffectiveDate)
+}
+
+// formatSummary formats the resource counts by change type
+func formatSummary(summary Summary) string {
+	return fmt.Sprintf("%d added, %d removed, %d changed, %d unchanged",
+		summary.Added, summary.Removed, summary.Changed, summary.Unchanged)
+}
+
+// formatResourceCost formats a resource cost, showing a dash on the side
+// where the resource does not exist
+func formatResourceCost(resource ResourceDelta, cost money.Amount, absentWhen ChangeType, currency string) string {
+	if resource.Change == absentWhen {
+		return "-"
+	}
+	return formatCost(cost, currency)
+}
+
+func formatCost(cost money.Amount, currency string) string {
+	return money.Format(cost, currency)
+}
+
+// formatDelta formats a cost change with an explicit sign
+func formatDelta(delta money.Amount, currency string) string {
+	switch delta.Sign() {
+	case 1:
+		return "+" + money.Format(delta, currency)
+	case -1:
+		return "-" + money.Format(delta.Abs(), currency)
+	default:
+		return money.Format(delta, currency)
+	}
+}
+
+// formatPercent formats a percentage change, or "n/a" when there was no previous cost
+func formatPercent(percent *float64) string {
+	if percent == nil {
+		return "n/a"
+	}
+	if math.Abs(*percent) < 0.05 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%+.1f%%", *percent)
+}
+
+func csvPercent(percent *float64) string {
+	if percent == nil {
+		return ""
+	}
+	return fmt.Sprintf("%.2f", *percent)
+}
+
+func changeLabel(change ChangeType) string {
+	labels := map[ChangeType]string{
+		Added:   "🆕 added",
+		Removed: "🗑️ removed",
+		Changed: "✏️ changed",
+	}
+	if label, exists := labels[change]; exists {
+		return label
+	}
+	return string(change)
+}
+
+// escapeMarkdown escapes characters that would break a table cell
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer("|", "\\|", "`", "'").Replace(text)
+}
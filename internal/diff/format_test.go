@@ -0,0 +1,246 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+func newTestDiff(t *testing.T) *Result {
+	t.Helper()
+	result, err := Compare(
+		newResult(newCost("web", "EC2", "us-east-1", 0.10), newCost("old|worker", "EC2", "us-east-1", 0.05), newCost("db", "RDS", "us-east-1", 0.50)),
+		newResult(newCost("web", "EC2", "us-east-1", 0.20), newCost("api", "Lambda", "us-east-1", 0.03), newCost("db", "RDS", "us-east-1", 0.50)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
+
+func TestWriteTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, newTestDiff(t), "table"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	expected := []string{
+		"AWS Cost Diff",
+		"1 added, 1 removed, 1 changed, 1 unchanged",
+		"+$73.0000",
+		"+100.0%",
+		"-$36.5000",
+		"n/a",
+		"unchanged",
+	}
+	for _, text := range expected {
+		if !strings.Contains(output, text) {
+			t.Errorf("expected table output to contain %q, got:\n%s", text, output)
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, newTestDiff(t), "json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded Result
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if decoded.Summary.Added != 1 || len(decoded.Resources) != 4 || decoded.Resources[0].Change != Changed {
+		t.Errorf("unexpected decoded diff: %+v", decoded)
+	}
+	if !strings.Contains(buf.String(), `"percentChange"`) {
+		t.Error("expected percentage change in JSON output")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, newTestDiff(t), "csv"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV output: %v", err)
+	}
+	if len(records) != 6 {
+		t.Fatalf("expected header, 4 resources and total, got %d rows", len(records))
+	}
+	if records[1][0] != "web" || records[1][3] != "changed" || records[1][9] != "73.0000" || records[1][10] != "100.00" {
+		t.Errorf("unexpected resource row: %v", records[1])
+	}
+	total := records[5]
+	if total[0] != "TOTAL" || total[11] != "USD" {
+		t.Errorf("unexpected total row: %v", total)
+	}
+}
+
+func TestWriteCSVRowsAddUpToTotal(t *testing.T) {
+	// Each hourly cost rounds down to 0.3333, while the total rounds to 1.0000
+	result, err := Compare(
+		newResult(),
+		newResult(
+			newCost("a", "EC2", "us-east-1", 0.33333),
+			newCost("b", "EC2", "us-east-1", 0.33333),
+			newCost("c", "EC2", "us-east-1", 0.33334),
+		),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, result, "csv"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV output: %v", err)
+	}
+
+	total := records[len(records)-1]
+	for column := 4; column <= 9; column++ {
+		var sum money.Amount
+		for _, record := range records[1 : len(records)-1] {
+			amount, err := money.ParseAmount(record[column])
+			if err != nil {
+				t.Fatalf("invalid amount in %v: %v", record, err)
+			}
+			sum = sum.Add(amount)
+		}
+		if sum.StringFixed(4) != total[column] {
+			t.Errorf("expected %s rows to add up to %s, got %s", records[0][column], total[column], sum.StringFixed(4))
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, newTestDiff(t), "markdown"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	expected := []string{
+		"### 💰 AWS Cost Diff",
+		"| Monthly | $474.5000 | $532.9000 | +$58.4000 |",
+		"| Annual | $5694.0000 | $6394.8000 | +$700.8000 |",
+		"| `web` | EC2 | ✏️ changed | $73.0000 | $146.0000 | +$73.0000 | +100.0% |",
+		"| `api` | Lambda | 🆕 added | - | $21.9000 | +$21.9000 | n/a |",
+		"| `old\\|worker` | EC2 | 🗑️ removed | $36.5000 | - | -$36.5000 | -100.0% |",
+	}
+	for _, text := range expected {
+		if !strings.Contains(output, text) {
+			t.Errorf("expected markdown output to contain %q, got:\n%s", text, output)
+		}
+	}
+	if strings.Contains(output, "`db`") {
+		t.Error("expected unchanged resources to be omitted from the markdown table")
+	}
+}
+
+func TestWriteAnnualTimeFrame(t *testing.T) {
+	result := newTestDiff(t)
+	result.TimeFrame = models.TimeFrameAnnual
+
+	var markdown bytes.Buffer
+	if err := Write(&markdown, result, "markdown"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, text := range []string{
+		"Annual cost: **$5694.0000 → $6394.8000** (+$700.8000, +12.3%)",
+		"| Resource | Type | Change | Old (annual) | New (annual) | Delta | % |",
+		"| `web` | EC2 | ✏️ changed | $876.0000 | $1752.0000 | +$876.0000 | +100.0% |",
+	} {
+		if !strings.Contains(markdown.String(), text) {
+			t.Errorf("expected markdown output to contain %q, got:\n%s", text, markdown.String())
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, result, "csv"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV output: %v", err)
+	}
+	if records[0][7] != "Old Annual Cost" || records[0][9] != "Annual Delta" || records[1][9] != "876.0000" {
+		t.Errorf("expected annual cost columns, got %v and %v", records[0], records[1])
+	}
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, newTestDiff(t), "yaml"); !errors.IsErrorType(err, errors.ValidationErrorType) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	tests := []struct {
+		percent  *float64
+		expected string
+	}{
+		{nil, "n/a"},
+		{floatPtr(0.01), "0.0%"},
+		{floatPtr(12.345), "+12.3%"},
+		{floatPtr(-50), "-50.0%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := formatPercent(tt.percent); result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func TestWriteCurrency(t *testing.T) {
+	result := newTestDiff(t)
+	result.Currency = "EUR"
+	result.ExchangeRate = &models.ExchangeRate{From: "USD", To: "EUR", Rate: 0.92, EffectiveDate: "2026-10-01"}
+
+	var markdown bytes.Buffer
+	if err := Write(&markdown, result, "markdown"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, text := range []string{
+		"Monthly cost: **€474.5000 → €532.9000** (+€58.4000, +12.3%)",
+		"| `old\\|worker` | EC2 | 🗑️ removed | €36.5000 | - | -€36.5000 | -100.0% |",
+		"<sub>Costs in EUR at 0.9200 per USD, rates effective 2026-10-01,",
+	} {
+		if !strings.Contains(markdown.String(), text) {
+			t.Errorf("expected markdown output to contain %q, got:\n%s", text, markdown.String())
+		}
+	}
+
+	result.Currency = "JPY"
+	var buf bytes.Buffer
+	if err := Write(&buf, result, "csv"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV output: %v", err)
+	}
+	if records[1][9] != "73.00" || records[1][11] != "JPY" {
+		t.Errorf("expected yen amounts with two decimals, got %v", records[1])
+	}
+}
@@ -21,6 +21,8 @@ const (
 	ValidationErrorType ErrorType = "VALIDATION"
 	// FileErrorType represents file system-related errors
 	FileErrorType ErrorType = "FILE"
+	// BudgetErrorType represents estimated costs that exceed a configured budget
+	BudgetErrorType ErrorType = "BUDGET"
 )
 
 // EstimationError is the base error type for all application errors
@@ -254,6 +256,22 @@ func FileErrorWithCause(message string, cause error) *EstimationError {
 	}
 }
 
+// BudgetError creates a new budget error
+func BudgetError(message string) *EstimationError {
+	return &EstimationError{
+		Type:    BudgetErrorType,
+		Message: message,
+	}
+}
+
+// BudgetErrorf creates a new budget error with formatting
+func BudgetErrorf(format string, args ...interface{}) *EstimationError {
+	return &EstimationError{
+		Type:    BudgetErrorType,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
 // WrapError wraps an existing error with additional context
 func WrapError(err error, errorType ErrorType, message string) *EstimationError {
 	if err == nil {
@@ -351,6 +369,8 @@ func GetExitCode(err error) int {
 		return 6
 	case FileErrorType:
 		return 7
+	case BudgetErrorType:
+		return 8
 	default:
 		return 1
 	}
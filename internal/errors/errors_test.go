@@ -69,6 +69,14 @@ func TestEstimationErrorCreation(t *testing.T) {
 			expectType: FileErrorType,
 			expectMsg:  "file not found",
 		},
+		{
+			name: "budget error",
+			createError: func() *EstimationError {
+				return BudgetErrorf("%d budget limits exceeded", 2)
+			},
+			expectType: BudgetErrorType,
+			expectMsg:  "2 budget limits exceeded",
+		},
 	}
 
 	for _, tt := range tests {
@@ -374,6 +382,11 @@ func TestGetExitCode(t *testing.T) {
 			err:          FileError("file error"),
 			expectedCode: 7,
 		},
+		{
+			name:         "budget error",
+			err:          BudgetError("budget error"),
+			expectedCode: 8,
+		},
 	}
 
 	for _, tt := range tests {
@@ -9,6 +9,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Estimator implements the ResourceEstimator interface for ALB (Application Load Balancer)
@@ -81,6 +82,13 @@ func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
 		}
 	}
 
+	// Validate usage schedule
+	if _, err := resource.GetSchedule(); err != nil {
+		return errors.ValidationErrorWithCause("invalid schedule property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(models.ScheduleSuggestion)
+	}
+
 	// Validate region
 	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
 		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for ALB resource").
@@ -145,21 +153,34 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 			WithContext("resourceName", resource.Name)
 	}
 
+	// Load balancer hours and LCUs are only billed while it runs
+	schedule, _ := resource.GetSchedule()
+	if schedule != nil {
+		totalHourlyCost = totalHourlyCost.MulFloat(schedule.UsageFraction())
+	}
+
 	// Create cost estimate
 	estimate := &models.CostEstimate{
-		ResourceName: resource.Name,
-		ResourceType: resource.Type,
-		Region:       resource.Region,
-		HourlyCost:   totalHourlyCost,
-		Currency:     "USD",
-		Timestamp:    time.Now(),
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       totalHourlyCost,
+		HourlyBilledCost: totalHourlyCost, // Load balancer hours and LCU-hours
+		Currency:         "USD",
+		Timestamp:        time.Now(),
 	}
 
-	// Calculate daily and monthly costs
+	// Calculate daily, monthly and annual costs
 	estimate.CalculateCosts()
 
 	// Add assumptions
-	estimate.AddAssumption("24/7 load balancer operation assumed")
+	if schedule != nil {
+		estimate.Schedule, estimate.ScheduledCost = schedule, totalHourlyCost
+		estimate.AddAssumption(fmt.Sprintf("Load balancer runs on a schedule: %s", schedule))
+		estimate.SetDetail("schedule", schedule.String())
+	} else {
+		estimate.AddAssumption("24/7 load balancer operation assumed")
+	}
 	estimate.AddAssumption("Pricing based on Load Balancer Capacity Units (LCU)")
 	if dataProcessingGB == 0 {
 		estimate.AddAssumption("No data processing costs included (set dataProcessingGB for data transfer pricing)")
@@ -180,18 +201,18 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 
 	// Add cost breakdown details
 	for component, cost := range costBreakdown {
-		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("$%.4f/hour", cost))
+		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("USD %s/hour", cost.StringFixed(4)))
 	}
 
 	return estimate, nil
 }
 
 // calculateALBCosts calculates ALB costs based on the LCU pricing model
-func (e *Estimator) calculateALBCosts(products []interfaces.PricingProduct, albType string, dataProcessingGB, newConnectionsPerSecond, activeConnectionsPerMinute, ruleEvaluations int) (float64, map[string]float64, error) {
-	costBreakdown := make(map[string]float64)
+func (e *Estimator) calculateALBCosts(products []interfaces.PricingProduct, albType string, dataProcessingGB, newConnectionsPerSecond, activeConnectionsPerMinute, ruleEvaluations int) (money.Amount, map[string]money.Amount, error) {
+	costBreakdown := make(map[string]money.Amount)
 
 	// Find pricing components
-	var loadBalancerHourPrice, lcuHourPrice float64
+	var loadBalancerHourPrice, lcuHourPrice money.Amount
 
 	for _, product := range products {
 		// Extract pricing based on usage type
@@ -212,10 +233,10 @@ func (e *Estimator) calculateALBCosts(products []interfaces.PricingProduct, albT
 
 	// Calculate LCU consumption based on the highest dimension
 	lcuPerHour := e.calculateLCUConsumption(albType, dataProcessingGB, newConnectionsPerSecond, activeConnectionsPerMinute, ruleEvaluations)
-	lcuCost := lcuPerHour * lcuHourPrice
+	lcuCost := lcuHourPrice.MulFloat(lcuPerHour)
 	costBreakdown["lcu"] = lcuCost
 
-	totalCost := loadBalancerHourPrice + lcuCost
+	totalCost := loadBalancerHourPrice.Add(lcuCost)
 
 	return totalCost, costBreakdown, nil
 }
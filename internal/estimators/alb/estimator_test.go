@@ -7,6 +7,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // MockAWSClient for testing
@@ -326,19 +327,19 @@ func TestALBEstimator_EstimateCost(t *testing.T) {
 				t.Errorf("Expected region %s, got %s", tt.resource.Region, estimate.Region)
 			}
 
-			if estimate.HourlyCost < tt.expectedMinCost {
-				t.Errorf("Expected hourly cost >= %f, got %f", tt.expectedMinCost, estimate.HourlyCost)
+			if estimate.HourlyCost.Cmp(money.NewAmount(tt.expectedMinCost)) < 0 {
+				t.Errorf("Expected hourly cost >= %f, got %s", tt.expectedMinCost, estimate.HourlyCost)
 			}
 
 			// Validate cost calculations
-			expectedDaily := estimate.HourlyCost * 24
-			if estimate.DailyCost != expectedDaily {
-				t.Errorf("Expected daily cost %f, got %f", expectedDaily, estimate.DailyCost)
+			expectedDaily := estimate.HourlyCost.MulFloat(24)
+			if !estimate.DailyCost.Equal(expectedDaily) {
+				t.Errorf("Expected daily cost %s, got %s", expectedDaily, estimate.DailyCost)
 			}
 
-			expectedMonthly := estimate.HourlyCost * 24 * 30
-			if estimate.MonthlyCost != expectedMonthly {
-				t.Errorf("Expected monthly cost %f, got %f", expectedMonthly, estimate.MonthlyCost)
+			expectedMonthly := estimate.HourlyCost.MulFloat(models.HoursPerMonth)
+			if !estimate.MonthlyCost.Equal(expectedMonthly) {
+				t.Errorf("Expected monthly cost %s, got %s", expectedMonthly, estimate.MonthlyCost)
 			}
 
 			// Validate assumptions and details
@@ -353,6 +354,59 @@ func TestALBEstimator_EstimateCost(t *testing.T) {
 	}
 }
 
+func TestALBEstimator_EstimateCostScheduled(t *testing.T) {
+	mockProducts := []interfaces.PricingProduct{
+		{
+			SKU:        "ALB001",
+			Attributes: map[string]string{"usageType": "LoadBalancerUsage"},
+			Terms: map[string]interface{}{
+				"OnDemand": map[string]interface{}{
+					"ALB001.JRTCKXETXF": map[string]interface{}{
+						"priceDimensions": map[string]interface{}{
+							"ALB001.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+								"pricePerUnit": map[string]interface{}{"USD": "0.0225"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	estimator := NewEstimator(&MockAWSClient{products: mockProducts})
+
+	resource := models.ResourceSpec{
+		Type:   "ALB",
+		Name:   "dev-lb",
+		Region: "us-east-1",
+		Properties: map[string]interface{}{
+			"type": "application",
+		},
+	}
+	always, err := estimator.EstimateCost(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resource.Properties["schedule"] = map[string]interface{}{"days": "weekdays", "start": "07:00", "end": "19:00"}
+	scheduled, err := estimator.EstimateCost(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := always.HourlyCost.Float64() * 60 / 168
+	if diff := scheduled.HourlyCost.Float64() - expected; diff > 0.000001 || diff < -0.000001 {
+		t.Errorf("Expected hourly cost %f, got %s", expected, scheduled.HourlyCost)
+	}
+	if scheduled.Assumptions[0] != "Load balancer runs on a schedule: weekdays 07:00-19:00 UTC, 261 hours per month" {
+		t.Errorf("Unexpected schedule assumption: %s", scheduled.Assumptions[0])
+	}
+
+	resource.Properties["schedule"] = map[string]interface{}{"hoursPerMonth": -5}
+	if _, err := estimator.EstimateCost(context.Background(), resource); !errors.IsErrorType(err, errors.ValidationErrorType) {
+		t.Errorf("Expected validation error for an invalid schedule, got %v", err)
+	}
+}
+
 func TestALBEstimator_CalculateLCUConsumption(t *testing.T) {
 	estimator := &Estimator{}
 
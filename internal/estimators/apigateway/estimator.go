@@ -0,0 +1,386 @@
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// API types
+const (
+	APITypeREST      = "REST"
+	APITypeHTTP      = "HTTP"
+	APITypeWebSocket = "WebSocket"
+)
+
+// Metering increments: HTTP API requests are billed per 512 KB and WebSocket
+// messages per 32 KB
+const (
+	httpRequestIncrementKB = 512
+	messageIncrementKB     = 32
+)
+
+// cacheSizesGB are the REST API cache cluster sizes
+var cacheSizesGB = []float64{0.5, 1.6, 6.1, 13.5, 28.4, 58.2, 118, 237}
+
+// apiTypeProps lists the usage properties each API type is billed for
+var apiTypeProps = map[string][]string{
+	APITypeREST:      {"requestsPerMonth", "cacheSizeGB"},
+	APITypeHTTP:      {"requestsPerMonth", "averageRequestSizeKB"},
+	APITypeWebSocket: {"messagesPerMonth", "averageMessageSizeKB", "connectionMinutesPerMonth"},
+}
+
+// regionPrefix matches the region code that prefixes usage types, e.g.
+// "USE1-" in "USE1-ApiGatewayRequest"
+var regionPrefix = regexp.MustCompile(`^[A-Z]+[0-9]+-`)
+
+// Usage types of the API Gateway components, without the region prefix
+const (
+	usageRESTRequest      = "ApiGatewayRequest"
+	usageHTTPRequest      = "ApiGatewayHttpRequest"
+	usageMessage          = "ApiGatewayMessage"
+	usageConnectionMinute = "ApiGatewayMinute"
+	usageCache            = "CacheUsage"
+)
+
+// Estimator implements the ResourceEstimator interface for Amazon API Gateway APIs
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new API Gateway cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "APIGateway"
+}
+
+// ValidateResource validates that the resource specification is valid for API Gateway
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "APIGateway" {
+		return errors.ValidationError("resource type must be 'APIGateway'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'APIGateway' as the resource type")
+	}
+
+	// Validate API type
+	if _, exists := resource.GetProperty("apiType"); !exists {
+		return errors.ValidationError("missing required property 'apiType'").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Add 'apiType' property to the resource configuration (REST, HTTP or WebSocket)")
+	}
+	apiType, err := resource.GetStringProperty("apiType")
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid apiType property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Ensure apiType is a string")
+	}
+	if _, exists := apiTypeProps[apiType]; !exists {
+		return errors.ValidationError("invalid API Gateway API type").
+			WithContext("resourceName", resource.Name).
+			WithContext("apiType", apiType).
+			WithSuggestion(fmt.Sprintf("Use '%s', '%s' or '%s'", APITypeREST, APITypeHTTP, APITypeWebSocket))
+	}
+
+	// Usage properties only apply to the API types billed for them
+	for _, otherType := range e.GetSupportedAPITypes() {
+		if otherType == apiType {
+			continue
+		}
+		for _, prop := range apiTypeProps[otherType] {
+			if _, exists := resource.GetProperty(prop); exists && !e.appliesTo(prop, apiType) {
+				return errors.ValidationError(fmt.Sprintf("%s does not apply to %s APIs", prop, apiType)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Remove %s or set apiType to '%s'", prop, otherType))
+			}
+		}
+	}
+
+	// Validate optional non-negative numeric properties
+	numericProps := []string{"requestsPerMonth", "averageRequestSizeKB", "messagesPerMonth", "averageMessageSizeKB", "connectionMinutesPerMonth"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", prop), err).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", prop))
+			}
+			if value < 0 {
+				return errors.ValidationError(fmt.Sprintf("%s cannot be negative", prop)).
+					WithContext("resourceName", resource.Name).
+					WithContext(prop, value).
+					WithSuggestion(fmt.Sprintf("Set %s to a non-negative integer", prop))
+			}
+		}
+	}
+
+	// Validate cache cluster size
+	if _, exists := resource.GetProperty("cacheSizeGB"); exists {
+		size, err := resource.GetFloatProperty("cacheSizeGB")
+		if err != nil || !e.isValidCacheSize(size) {
+			return errors.ValidationError("invalid API Gateway cache size").
+				WithContext("resourceName", resource.Name).
+				WithContext("cacheSizeGB", resource.Properties["cacheSizeGB"]).
+				WithSuggestion("Use a cache size of 0.5, 1.6, 6.1, 13.5, 28.4, 58.2, 118 or 237 GB")
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for API Gateway resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// appliesTo reports whether a usage property applies to an API type
+func (e *Estimator) appliesTo(prop, apiType string) bool {
+	for _, p := range apiTypeProps[apiType] {
+		if p == prop {
+			return true
+		}
+	}
+	return false
+}
+
+// EstimateCost calculates the cost for an API Gateway API
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	apiType, _ := resource.GetStringProperty("apiType")
+
+	products, err := e.pricingService.GetAPIGatewayPricing(ctx, resource.Region)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve API Gateway pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	productsByUsage := make(map[string]interfaces.PricingProduct)
+	for _, product := range products {
+		productsByUsage[regionPrefix.ReplaceAllString(product.Attributes["usageType"], "")] = product
+	}
+
+	estimate := &models.CostEstimate{
+		ResourceName: resource.Name,
+		ResourceType: resource.Type,
+		Region:       resource.Region,
+		Currency:     "USD",
+		Timestamp:    time.Now(),
+	}
+	estimate.SetDetail("apiType", apiType)
+
+	var monthlyCost money.Amount
+	switch apiType {
+	case APITypeREST:
+		monthlyCost, err = e.estimateREST(resource, productsByUsage, estimate)
+	case APITypeHTTP:
+		monthlyCost, err = e.estimateHTTP(resource, productsByUsage, estimate)
+	case APITypeWebSocket:
+		monthlyCost, err = e.estimateWebSocket(resource, productsByUsage, estimate)
+	}
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate API Gateway costs").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	estimate.HourlyCost = monthlyCost.DivFloat(models.HoursPerMonth)
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	estimate.AddAssumption("Data transfer out is billed separately (use a DataTransfer resource)")
+
+	return estimate, nil
+}
+
+// estimateREST prices REST API requests across the volume tiers, and the
+// cache cluster for every hour of the month
+func (e *Estimator) estimateREST(resource models.ResourceSpec, products map[string]interfaces.PricingProduct, estimate *models.CostEstimate) (money.Amount, error) {
+	requests, _ := resource.GetIntProperty("requestsPerMonth")
+
+	requestCost, err := e.priceUsage(products, usageRESTRequest, float64(requests))
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	estimate.SetDetail("requestsPerMonth", fmt.Sprintf("%d", requests))
+	estimate.SetDetail("monthlyRequestCost", fmt.Sprintf("USD %s", requestCost.StringFixed(4)))
+
+	if _, exists := resource.GetProperty("cacheSizeGB"); !exists {
+		return requestCost, nil
+	}
+
+	cacheSize, _ := resource.GetFloatProperty("cacheSizeGB")
+	product, exists := findCacheProduct(products, formatNumber(cacheSize))
+	if !exists {
+		return money.Amount{}, errors.APIError("no API Gateway cache pricing found").
+			WithContext("cacheSizeGB", cacheSize)
+	}
+	hourlyPrice, err := e.pricingService.ExtractHourlyPrice(product)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	cacheCost := hourlyPrice.MulFloat(models.HoursPerMonth)
+	estimate.HourlyBilledCost = hourlyPrice
+
+	estimate.AddAssumption("24/7 cache cluster operation assumed")
+	estimate.SetDetail("cacheSizeGB", formatNumber(cacheSize))
+	estimate.SetDetail("cacheHourlyPrice", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
+	estimate.SetDetail("monthlyCacheCost", fmt.Sprintf("USD %s", cacheCost.StringFixed(4)))
+
+	return requestCost.Add(cacheCost), nil
+}
+
+// estimateHTTP prices HTTP API requests, each metered in 512 KB increments
+func (e *Estimator) estimateHTTP(resource models.ResourceSpec, products map[string]interfaces.PricingProduct, estimate *models.CostEstimate) (money.Amount, error) {
+	requests, _ := resource.GetIntProperty("requestsPerMonth")
+	sizeKB, _ := resource.GetIntProperty("averageRequestSizeKB")
+	billedRequests := requests * meteredUnits(sizeKB, httpRequestIncrementKB)
+
+	requestCost, err := e.priceUsage(products, usageHTTPRequest, float64(billedRequests))
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	if billedRequests > requests {
+		estimate.AddAssumption(fmt.Sprintf("Requests of %d KB are metered as %d requests of 512 KB",
+			sizeKB, meteredUnits(sizeKB, httpRequestIncrementKB)))
+	}
+
+	estimate.SetDetail("requestsPerMonth", fmt.Sprintf("%d", requests))
+	estimate.SetDetail("billedRequests", fmt.Sprintf("%d", billedRequests))
+	estimate.SetDetail("monthlyRequestCost", fmt.Sprintf("USD %s", requestCost.StringFixed(4)))
+
+	return requestCost, nil
+}
+
+// estimateWebSocket prices WebSocket messages, each metered in 32 KB
+// increments, and connection minutes
+func (e *Estimator) estimateWebSocket(resource models.ResourceSpec, products map[string]interfaces.PricingProduct, estimate *models.CostEstimate) (money.Amount, error) {
+	messages, _ := resource.GetIntProperty("messagesPerMonth")
+	sizeKB, _ := resource.GetIntProperty("averageMessageSizeKB")
+	connectionMinutes, _ := resource.GetIntProperty("connectionMinutesPerMonth")
+	billedMessages := messages * meteredUnits(sizeKB, messageIncrementKB)
+
+	messageCost, err := e.priceUsage(products, usageMessage, float64(billedMessages))
+	if err != nil {
+		return money.Amount{}, err
+	}
+	connectionCost, err := e.priceUsage(products, usageConnectionMinute, float64(connectionMinutes))
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	if billedMessages > messages {
+		estimate.AddAssumption(fmt.Sprintf("Messages of %d KB are metered as %d messages of 32 KB",
+			sizeKB, meteredUnits(sizeKB, messageIncrementKB)))
+	}
+
+	estimate.SetDetail("messagesPerMonth", fmt.Sprintf("%d", messages))
+	estimate.SetDetail("billedMessages", fmt.Sprintf("%d", billedMessages))
+	estimate.SetDetail("connectionMinutesPerMonth", fmt.Sprintf("%d", connectionMinutes))
+	estimate.SetDetail("monthlyMessageCost", fmt.Sprintf("USD %s", messageCost.StringFixed(4)))
+	estimate.SetDetail("monthlyConnectionCost", fmt.Sprintf("USD %s", connectionCost.StringFixed(4)))
+
+	return messageCost.Add(connectionCost), nil
+}
+
+// priceUsage prices a monthly quantity of a usage type across its volume tiers
+func (e *Estimator) priceUsage(products map[string]interfaces.PricingProduct, usageType string, quantity float64) (money.Amount, error) {
+	if quantity == 0 {
+		return money.Amount{}, nil
+	}
+
+	product, exists := products[usageType]
+	if !exists {
+		return money.Amount{}, errors.APIError("no API Gateway pricing found for usage type").
+			WithContext("usageType", usageType)
+	}
+
+	cost, err := e.pricingService.CalculateTieredCost(product, quantity)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return cost.Total, nil
+}
+
+// findCacheProduct finds the cache cluster product of a size in GB
+func findCacheProduct(products map[string]interfaces.PricingProduct, sizeGB string) (interfaces.PricingProduct, bool) {
+	for usageType, product := range products {
+		if strings.Contains(usageType, usageCache) && product.Attributes["cacheMemorySizeGb"] == sizeGB {
+			return product, true
+		}
+	}
+	return interfaces.PricingProduct{}, false
+}
+
+// meteredUnits returns the number of metering increments a payload of sizeKB
+// is billed as, at least one
+func meteredUnits(sizeKB, incrementKB int) int {
+	return max(int(math.Ceil(float64(sizeKB)/float64(incrementKB))), 1)
+}
+
+// formatNumber formats a number without trailing zeros, e.g. "0.5"
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// Helper functions
+
+func (e *Estimator) isValidCacheSize(sizeGB float64) bool {
+	for _, size := range cacheSizesGB {
+		if size == sizeGB {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedAPITypes returns supported API Gateway API types
+func (e *Estimator) GetSupportedAPITypes() []string {
+	return []string{APITypeREST, APITypeHTTP, APITypeWebSocket}
+}
+
+// GetAPITypeDescription returns description for API types
+func (e *Estimator) GetAPITypeDescription(apiType string) string {
+	descriptions := map[string]string{
+		APITypeREST:      "REST APIs with tiered request pricing and an optional cache cluster",
+		APITypeHTTP:      "HTTP APIs, billed per request in 512 KB increments at a lower price than REST APIs",
+		APITypeWebSocket: "WebSocket APIs, billed for messages in 32 KB increments and connection minutes",
+	}
+	if desc, exists := descriptions[apiType]; exists {
+		return desc
+	}
+	return "Unknown API type"
+}
+
+// GetSupportedCacheSizes returns the REST API cache cluster sizes in GB
+func (e *Estimator) GetSupportedCacheSizes() []string {
+	sizes := make([]string, len(cacheSizesGB))
+	for i, size := range cacheSizesGB {
+		sizes[i] = formatNumber(size)
+	}
+	return sizes
+}
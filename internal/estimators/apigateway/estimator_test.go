@@ -0,0 +1,254 @@
+package apigateway
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+// cacheProduct builds a cache cluster product of a size at an hourly price
+func cacheProduct(sku, sizeGB, hourlyPrice string) interfaces.PricingProduct {
+	cache := awstest.FlatProduct(sku, "USE1-ApiGatewayCacheUsage:"+sizeGB+"GB", hourlyPrice)
+	return awstest.WithAttribute(cache, "cacheMemorySizeGb", sizeGB)
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.Product("REST", "USE1-ApiGatewayRequest",
+			[3]string{"0", "333000000", "0.0000035"},
+			[3]string{"333000000", "1000000000", "0.0000028"},
+			[3]string{"1000000000", "Inf", "0.00000238"},
+		),
+		awstest.Product("HTTP", "USE1-ApiGatewayHttpRequest",
+			[3]string{"0", "300000000", "0.000001"},
+			[3]string{"300000000", "Inf", "0.0000009"},
+		),
+		awstest.Product("MESSAGE", "USE1-ApiGatewayMessage",
+			[3]string{"0", "1000000000", "0.000001"},
+			[3]string{"1000000000", "Inf", "0.0000008"},
+		),
+		awstest.Product("MINUTE", "USE1-ApiGatewayMinute", [3]string{"0", "Inf", "0.00000025"}),
+		cacheProduct("CACHE05", "0.5", "0.02"),
+		cacheProduct("CACHE6", "6.1", "0.2"),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "APIGateway" {
+		t.Errorf("expected resource type 'APIGateway', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid REST API with cache", properties: map[string]interface{}{"apiType": "REST", "requestsPerMonth": 1000000, "cacheSizeGB": 0.5}},
+		{name: "valid HTTP API", properties: map[string]interface{}{"apiType": "HTTP", "requestsPerMonth": 1000000, "averageRequestSizeKB": 700}},
+		{name: "valid WebSocket API", properties: map[string]interface{}{"apiType": "WebSocket", "messagesPerMonth": 1000000, "connectionMinutesPerMonth": 5000000}},
+		{name: "integer cache size", properties: map[string]interface{}{"apiType": "REST", "cacheSizeGB": 118}},
+		{name: "wrong resource type", resourceType: "ALB", properties: map[string]interface{}{"apiType": "REST"}, expectError: true},
+		{name: "missing API type", properties: map[string]interface{}{"requestsPerMonth": 1000000}, expectError: true},
+		{name: "invalid API type", properties: map[string]interface{}{"apiType": "GraphQL"}, expectError: true},
+		{name: "cache on HTTP API", properties: map[string]interface{}{"apiType": "HTTP", "cacheSizeGB": 0.5}, expectError: true},
+		{name: "requests on WebSocket API", properties: map[string]interface{}{"apiType": "WebSocket", "requestsPerMonth": 1000000}, expectError: true},
+		{name: "messages on REST API", properties: map[string]interface{}{"apiType": "REST", "messagesPerMonth": 1000000}, expectError: true},
+		{name: "negative requests", properties: map[string]interface{}{"apiType": "REST", "requestsPerMonth": -1}, expectError: true},
+		{name: "message size not a number", properties: map[string]interface{}{"apiType": "WebSocket", "averageMessageSizeKB": "large"}, expectError: true},
+		{name: "invalid cache size", properties: map[string]interface{}{"apiType": "REST", "cacheSizeGB": 2}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{"apiType": "HTTP"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "APIGateway", Name: "api", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedDetails map[string]string
+	}{
+		{
+			// 333M requests at 3.50 and 167M at 2.80 per million, 0.5 GB cache at $0.02/hour
+			name:            "tiered REST requests with cache",
+			properties:      map[string]interface{}{"apiType": "REST", "requestsPerMonth": 500000000, "cacheSizeGB": 0.5},
+			expectedMonthly: "1647.7000",
+			expectedDetails: map[string]string{
+				"apiType":            "REST",
+				"requestsPerMonth":   "500000000",
+				"monthlyRequestCost": "USD 1633.1000",
+				"cacheSizeGB":        "0.5",
+				"cacheHourlyPrice":   "USD 0.0200/hour",
+				"monthlyCacheCost":   "USD 14.6000",
+			},
+		},
+		{
+			// 300M requests at 1.00 and 100M at 0.90 per million
+			name:            "tiered HTTP requests",
+			properties:      map[string]interface{}{"apiType": "HTTP", "requestsPerMonth": 400000000},
+			expectedMonthly: "390.0000",
+			expectedDetails: map[string]string{
+				"billedRequests":     "400000000",
+				"monthlyRequestCost": "USD 390.0000",
+			},
+		},
+		{
+			// 1 MB requests are metered as two 512 KB requests
+			name:            "HTTP requests metered in 512 KB increments",
+			properties:      map[string]interface{}{"apiType": "HTTP", "requestsPerMonth": 10000000, "averageRequestSizeKB": 1024},
+			expectedMonthly: "20.0000",
+			expectedDetails: map[string]string{
+				"requestsPerMonth": "10000000",
+				"billedRequests":   "20000000",
+			},
+		},
+		{
+			// 40 KB messages are metered as two 32 KB messages, plus 1000M connection minutes
+			name: "WebSocket messages and connection minutes",
+			properties: map[string]interface{}{
+				"apiType": "WebSocket", "messagesPerMonth": 100000000, "averageMessageSizeKB": 40,
+				"connectionMinutesPerMonth": 1000000000,
+			},
+			expectedMonthly: "450.0000",
+			expectedDetails: map[string]string{
+				"billedMessages":        "200000000",
+				"monthlyMessageCost":    "USD 200.0000",
+				"monthlyConnectionCost": "USD 250.0000",
+			},
+		},
+		{
+			name:            "REST API without traffic",
+			properties:      map[string]interface{}{"apiType": "REST"},
+			expectedMonthly: "0.0000",
+			expectedDetails: map[string]string{
+				"monthlyRequestCost": "USD 0.0000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "APIGateway", Name: "api", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: map[string]interface{}{"apiType": "HTTP"}},
+		{
+			name:       "no price for cache size",
+			client:     &MockAWSClient{products: testProducts()},
+			properties: map[string]interface{}{"apiType": "REST", "cacheSizeGB": 237},
+		},
+		{
+			name:       "no price for usage type",
+			client:     &MockAWSClient{products: []interfaces.PricingProduct{awstest.Product("REST", "USE1-ApiGatewayRequest", [3]string{"0", "Inf", "0.0000035"})}},
+			properties: map[string]interface{}{"apiType": "WebSocket", "messagesPerMonth": 1000000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "APIGateway", Name: "api", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMeteredUnits(t *testing.T) {
+	tests := []struct {
+		sizeKB, incrementKB, expected int
+	}{
+		{0, 512, 1},
+		{512, 512, 1},
+		{513, 512, 2},
+		{1024, 512, 2},
+		{32, 32, 1},
+		{100, 32, 4},
+	}
+
+	for _, tt := range tests {
+		if got := meteredUnits(tt.sizeKB, tt.incrementKB); got != tt.expected {
+			t.Errorf("meteredUnits(%d, %d) = %d, expected %d", tt.sizeKB, tt.incrementKB, got, tt.expected)
+		}
+	}
+}
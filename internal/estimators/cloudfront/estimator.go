@@ -0,0 +1,441 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Price classes
+const (
+	PriceClassAll = "PriceClass_All"
+	PriceClass200 = "PriceClass_200"
+	PriceClass100 = "PriceClass_100"
+)
+
+// freeInvalidationPaths is the number of invalidation paths free each month
+const freeInvalidationPaths = 1000
+
+// geography is a group of edge locations sharing data transfer and request prices
+type geography struct {
+	name        string // Traffic split key, e.g. "NorthAmerica"
+	code        string // Usage type prefix, e.g. "US" in "US-DataTransfer-Out-Bytes"
+	description string
+}
+
+// geographies lists the edge location geographies in detail order
+var geographies = []geography{
+	{name: "NorthAmerica", code: "US", description: "United States, Mexico and Canada"},
+	{name: "Europe", code: "EU", description: "Europe and Israel"},
+	{name: "SouthAfrica", code: "ZA", description: "South Africa, Kenya and Nigeria"},
+	{name: "MiddleEast", code: "ME", description: "Middle East"},
+	{name: "SouthAmerica", code: "SA", description: "South America"},
+	{name: "Japan", code: "JP", description: "Japan"},
+	{name: "Australia", code: "AU", description: "Australia and New Zealand"},
+	{name: "AsiaPacific", code: "AP", description: "Hong Kong, Indonesia, Philippines, Singapore, South Korea, Taiwan and Thailand"},
+	{name: "India", code: "IN", description: "India"},
+}
+
+// priceClasses lists the geographies whose edge locations serve each price class
+var priceClasses = map[string][]string{
+	PriceClassAll: {"NorthAmerica", "Europe", "SouthAfrica", "MiddleEast", "SouthAmerica", "Japan", "Australia", "AsiaPacific", "India"},
+	PriceClass200: {"NorthAmerica", "Europe", "SouthAfrica", "MiddleEast", "Japan", "AsiaPacific", "India"},
+	PriceClass100: {"NorthAmerica", "Europe"},
+}
+
+var priceClassDescriptions = map[string]string{
+	PriceClassAll: "All edge locations, for the best performance",
+	PriceClass200: "All edge locations except South America and Australia",
+	PriceClass100: "Edge locations in North America and Europe only, for the lowest price",
+}
+
+// share is the percentage of a distribution's traffic served in a geography
+type share struct {
+	geography geography
+	percent   int
+}
+
+// Estimator implements the ResourceEstimator interface for CloudFront distributions
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new CloudFront cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "CloudFront"
+}
+
+// ValidateResource validates that the resource specification is valid for CloudFront
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "CloudFront" {
+		return errors.ValidationError("resource type must be 'CloudFront'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'CloudFront' as the resource type")
+	}
+
+	if _, err := e.priceClassFromResource(resource); err != nil {
+		return err
+	}
+
+	// Validate optional non-negative numeric properties
+	numericProps := []string{"dataTransferOutGB", "httpRequests", "httpsRequests", "originShieldRequests",
+		"invalidationPaths", "functionInvocations"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", prop), err).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", prop))
+			}
+			if value < 0 {
+				return errors.ValidationError(fmt.Sprintf("%s cannot be negative", prop)).
+					WithContext("resourceName", resource.Name).
+					WithContext(prop, value).
+					WithSuggestion(fmt.Sprintf("Set %s to the monthly total", prop))
+			}
+		}
+	}
+
+	if _, err := e.trafficSplitFromResource(resource); err != nil {
+		return err
+	}
+
+	// Validate the origin region, where origin shield is priced
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for CloudFront resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// priceClassFromResource reads the optional priceClass property
+func (e *Estimator) priceClassFromResource(resource models.ResourceSpec) (string, error) {
+	if _, exists := resource.GetProperty("priceClass"); !exists {
+		return PriceClassAll, nil
+	}
+
+	priceClass, err := resource.GetStringProperty("priceClass")
+	if err != nil || priceClasses[priceClass] == nil {
+		return "", errors.ValidationError("invalid priceClass").
+			WithContext("resourceName", resource.Name).
+			WithContext("priceClass", resource.Properties["priceClass"]).
+			WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(e.GetSupportedPriceClasses(), ", ")))
+	}
+	return priceClass, nil
+}
+
+// trafficSplitFromResource reads the optional trafficSplit property: the
+// percentage of traffic served in each geography, adding up to 100. All
+// traffic is served in North America without it.
+func (e *Estimator) trafficSplitFromResource(resource models.ResourceSpec) ([]share, error) {
+	value, exists := resource.GetProperty("trafficSplit")
+	if !exists {
+		return []share{{geography: geographies[0], percent: 100}}, nil
+	}
+
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.ValidationError("trafficSplit must be an object of geography percentages").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(`Use e.g. {"NorthAmerica": 60, "AsiaPacific": 40}`)
+	}
+	split := models.ResourceSpec{Properties: properties}
+
+	for key := range properties {
+		if _, known := findGeography(key); !known {
+			return nil, errors.ValidationError("unknown geography in trafficSplit").
+				WithContext("resourceName", resource.Name).
+				WithContext("geography", key).
+				WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(e.GetSupportedGeographies(), ", ")))
+		}
+	}
+
+	priceClass, err := e.priceClassFromResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares []share
+	total := 0
+	for _, g := range geographies {
+		if _, exists := split.GetProperty(g.name); !exists {
+			continue
+		}
+		percent, err := split.GetIntProperty(g.name)
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, errors.ValidationError("trafficSplit percentages must be integers between 0 and 100").
+				WithContext("resourceName", resource.Name).
+				WithContext("geography", g.name).
+				WithContext("percent", properties[g.name])
+		}
+		if percent == 0 {
+			continue
+		}
+		if !containsString(priceClasses[priceClass], g.name) {
+			return nil, errors.ValidationError(fmt.Sprintf("%s is not served by %s", g.name, priceClass)).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(fmt.Sprintf("Use a price class that includes %s, or remove it from trafficSplit", g.name))
+		}
+		shares = append(shares, share{geography: g, percent: percent})
+		total += percent
+	}
+
+	if total != 100 {
+		return nil, errors.ValidationError("trafficSplit percentages must add up to 100").
+			WithContext("resourceName", resource.Name).
+			WithContext("total", total)
+	}
+
+	return shares, nil
+}
+
+// EstimateCost calculates the monthly cost of a CloudFront distribution
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	priceClass, _ := e.priceClassFromResource(resource)
+	shares, _ := e.trafficSplitFromResource(resource)
+
+	intProperty := func(key string) int {
+		value, _ := resource.GetIntProperty(key)
+		return value
+	}
+	dataTransferOutGB := intProperty("dataTransferOutGB")
+	httpRequests := intProperty("httpRequests")
+	httpsRequests := intProperty("httpsRequests")
+	originShieldRequests := intProperty("originShieldRequests")
+	invalidationPaths := intProperty("invalidationPaths")
+	functionInvocations := intProperty("functionInvocations")
+
+	// Get pricing data from AWS
+	products, err := e.pricingService.GetCloudFrontPricing(ctx)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve CloudFront pricing data").
+			WithContext("resourceName", resource.Name)
+	}
+
+	productsByUsage := make(map[string]interfaces.PricingProduct)
+	for _, product := range products {
+		productsByUsage[product.Attributes["usageType"]] = product
+	}
+
+	// price returns the tiered cost of a month's usage of a usage type
+	price := func(usageType string, quantity float64) (money.Amount, error) {
+		if quantity == 0 {
+			return money.Amount{}, nil
+		}
+		product, exists := productsByUsage[usageType]
+		if !exists {
+			return money.Amount{}, errors.APIError("no CloudFront pricing found for usage type").
+				WithContext("resourceName", resource.Name).
+				WithContext("usageType", usageType).
+				WithSuggestion("Check that the price class and traffic split are supported")
+		}
+		cost, err := e.pricingService.CalculateTieredCost(product, quantity)
+		if err != nil {
+			return money.Amount{}, errors.WrapError(err, errors.APIErrorType, "failed to calculate CloudFront costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("usageType", usageType)
+		}
+		return cost.Total, nil
+	}
+
+	// Data transfer and requests are priced at each geography's rates, with
+	// data transfer tiers applying to the geography's monthly volume
+	var dataTransferCost, requestCost money.Amount
+	geographyDetails := make(map[string]string)
+	for _, s := range shares {
+		fraction := float64(s.percent) / 100
+		gb := float64(dataTransferOutGB) * fraction
+		prefix := s.geography.code + "-"
+
+		transfer, err := price(prefix+"DataTransfer-Out-Bytes", gb)
+		if err != nil {
+			return nil, err
+		}
+		httpCost, err := price(prefix+"Requests-Tier1", float64(httpRequests)*fraction)
+		if err != nil {
+			return nil, err
+		}
+		httpsCost, err := price(prefix+"Requests-Tier2-HTTPS", float64(httpsRequests)*fraction)
+		if err != nil {
+			return nil, err
+		}
+
+		dataTransferCost = dataTransferCost.Add(transfer)
+		requestCost = money.Sum(requestCost, httpCost, httpsCost)
+		geographyDetails["dataTransfer"+s.geography.name] = fmt.Sprintf("%s GB = USD %s",
+			strconv.FormatFloat(gb, 'f', -1, 64), transfer.StringFixed(4))
+	}
+
+	// Origin shield runs in the origin's region
+	shieldGeography := originShieldGeography(resource.Region)
+	originShieldCost, err := price(shieldGeography.code+"-Requests-OriginShield", float64(originShieldRequests))
+	if err != nil {
+		return nil, err
+	}
+
+	invalidationCost, err := price("Invalidations", float64(max(invalidationPaths-freeInvalidationPaths, 0)))
+	if err != nil {
+		return nil, err
+	}
+
+	functionCost, err := price("Executions-CloudFrontFunctions", float64(functionInvocations))
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyCost := money.Sum(dataTransferCost, requestCost, originShieldCost, invalidationCost, functionCost)
+
+	// Create cost estimate
+	estimate := &models.CostEstimate{
+		ResourceName: resource.Name,
+		ResourceType: resource.Type,
+		Region:       resource.Region,
+		HourlyCost:   monthlyCost.DivFloat(models.HoursPerMonth), // CloudFront is priced per month of usage
+		Currency:     "USD",
+		Timestamp:    time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	estimate.AddAssumption("Data transfer and requests priced at the rates of each geography in the traffic split")
+	estimate.AddAssumption("Data transfer from AWS origins to CloudFront is free; origin resources are billed separately")
+	if originShieldRequests > 0 {
+		estimate.AddAssumption(fmt.Sprintf("Origin shield priced in the %s geography of the origin region", shieldGeography.name))
+	}
+	if invalidationPaths > 0 {
+		estimate.AddAssumption(fmt.Sprintf("First %d invalidation paths each month are free", freeInvalidationPaths))
+	}
+
+	// Add details
+	estimate.SetDetail("priceClass", priceClass)
+	estimate.SetDetail("trafficSplit", formatSplit(shares))
+	estimate.SetDetail("dataTransferOutGB", fmt.Sprintf("%d", dataTransferOutGB))
+	estimate.SetDetail("httpRequests", fmt.Sprintf("%d", httpRequests))
+	estimate.SetDetail("httpsRequests", fmt.Sprintf("%d", httpsRequests))
+	estimate.SetDetail("originShieldRequests", fmt.Sprintf("%d", originShieldRequests))
+	estimate.SetDetail("invalidationPaths", fmt.Sprintf("%d", invalidationPaths))
+	estimate.SetDetail("functionInvocations", fmt.Sprintf("%d", functionInvocations))
+	estimate.SetDetail("monthlyDataTransferCost", fmt.Sprintf("USD %s", dataTransferCost.StringFixed(4)))
+	estimate.SetDetail("monthlyRequestCost", fmt.Sprintf("USD %s", requestCost.StringFixed(4)))
+	estimate.SetDetail("monthlyOriginShieldCost", fmt.Sprintf("USD %s", originShieldCost.StringFixed(4)))
+	estimate.SetDetail("monthlyInvalidationCost", fmt.Sprintf("USD %s", invalidationCost.StringFixed(4)))
+	estimate.SetDetail("monthlyFunctionCost", fmt.Sprintf("USD %s", functionCost.StringFixed(4)))
+	for key, value := range geographyDetails {
+		estimate.SetDetail(key, value)
+	}
+
+	return estimate, nil
+}
+
+// originShieldGeography returns the geography of the AWS region hosting an
+// origin shield
+func originShieldGeography(region string) geography {
+	name := "AsiaPacific"
+	switch {
+	case strings.HasPrefix(region, "us-"), strings.HasPrefix(region, "ca-"):
+		name = "NorthAmerica"
+	case strings.HasPrefix(region, "eu-"), strings.HasPrefix(region, "il-"):
+		name = "Europe"
+	case strings.HasPrefix(region, "sa-"):
+		name = "SouthAmerica"
+	case strings.HasPrefix(region, "me-"):
+		name = "MiddleEast"
+	case strings.HasPrefix(region, "af-"):
+		name = "SouthAfrica"
+	case strings.HasPrefix(region, "ap-south-"):
+		name = "India"
+	case region == "ap-northeast-1", region == "ap-northeast-3":
+		name = "Japan"
+	case region == "ap-southeast-2":
+		name = "Australia"
+	}
+	g, _ := findGeography(name)
+	return g
+}
+
+// formatSplit describes a traffic split, e.g. "NorthAmerica 60%, AsiaPacific 40%"
+func formatSplit(shares []share) string {
+	parts := make([]string, 0, len(shares))
+	for _, s := range shares {
+		parts = append(parts, fmt.Sprintf("%s %d%%", s.geography.name, s.percent))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func findGeography(name string) (geography, bool) {
+	for _, g := range geographies {
+		if g.name == name {
+			return g, true
+		}
+	}
+	return geography{}, false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedPriceClasses returns the supported price classes
+func (e *Estimator) GetSupportedPriceClasses() []string {
+	classes := make([]string, 0, len(priceClasses))
+	for priceClass := range priceClasses {
+		classes = append(classes, priceClass)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+// GetPriceClassDescription returns description for price classes
+func (e *Estimator) GetPriceClassDescription(priceClass string) string {
+	if description, exists := priceClassDescriptions[priceClass]; exists {
+		return description
+	}
+	return "Unknown price class"
+}
+
+// GetSupportedGeographies returns the geographies accepted in trafficSplit
+func (e *Estimator) GetSupportedGeographies() []string {
+	names := make([]string, 0, len(geographies))
+	for _, g := range geographies {
+		names = append(names, g.name)
+	}
+	return names
+}
+
+// GetGeographyDescription returns the countries of a traffic split geography
+func (e *Estimator) GetGeographyDescription(name string) string {
+	if g, exists := findGeography(name); exists {
+		return g.description
+	}
+	return "Unknown geography"
+}
@@ -0,0 +1,235 @@
+package cloudfront
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.Product("USOUT", "US-DataTransfer-Out-Bytes",
+			[3]string{"0", "10240", "0.085"},
+			[3]string{"10240", "51200", "0.080"},
+			[3]string{"51200", "Inf", "0.060"},
+		),
+		awstest.Product("USHTTP", "US-Requests-Tier1", [3]string{"0", "Inf", "0.00000075"}),
+		awstest.Product("USHTTPS", "US-Requests-Tier2-HTTPS", [3]string{"0", "Inf", "0.000001"}),
+		awstest.Product("USSHIELD", "US-Requests-OriginShield", [3]string{"0", "Inf", "0.0000075"}),
+		awstest.Product("EUOUT", "EU-DataTransfer-Out-Bytes", [3]string{"0", "10240", "0.085"}, [3]string{"10240", "Inf", "0.080"}),
+		awstest.Product("EUHTTPS", "EU-Requests-Tier2-HTTPS", [3]string{"0", "Inf", "0.0000012"}),
+		awstest.Product("APOUT", "AP-DataTransfer-Out-Bytes", [3]string{"0", "10240", "0.120"}, [3]string{"10240", "Inf", "0.100"}),
+		awstest.Product("APHTTPS", "AP-Requests-Tier2-HTTPS", [3]string{"0", "Inf", "0.000012"}),
+		awstest.Product("INVALIDATIONS", "Invalidations", [3]string{"0", "Inf", "0.005"}),
+		awstest.Product("FUNCTIONS", "Executions-CloudFrontFunctions", [3]string{"0", "Inf", "0.0000001"}),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "CloudFront" {
+		t.Errorf("expected resource type 'CloudFront', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid distribution", properties: map[string]interface{}{"dataTransferOutGB": 1000, "httpsRequests": 10000000}},
+		{name: "defaults", properties: map[string]interface{}{}},
+		{name: "valid traffic split", properties: map[string]interface{}{"trafficSplit": map[string]interface{}{"NorthAmerica": 30, "Europe": 30, "AsiaPacific": 40}}},
+		{name: "wrong resource type", resourceType: "S3", properties: map[string]interface{}{}, expectError: true},
+		{name: "invalid price class", properties: map[string]interface{}{"priceClass": "PriceClass_50"}, expectError: true},
+		{name: "negative requests", properties: map[string]interface{}{"httpRequests": -1}, expectError: true},
+		{name: "invalidation paths not a number", properties: map[string]interface{}{"invalidationPaths": "many"}, expectError: true},
+		{name: "traffic split not an object", properties: map[string]interface{}{"trafficSplit": "NorthAmerica"}, expectError: true},
+		{name: "unknown geography", properties: map[string]interface{}{"trafficSplit": map[string]interface{}{"Antarctica": 100}}, expectError: true},
+		{name: "traffic split below 100", properties: map[string]interface{}{"trafficSplit": map[string]interface{}{"NorthAmerica": 60, "Europe": 30}}, expectError: true},
+		{name: "geography outside price class", properties: map[string]interface{}{"priceClass": "PriceClass_100", "trafficSplit": map[string]interface{}{"NorthAmerica": 60, "AsiaPacific": 40}}, expectError: true},
+		{name: "invalid origin region", region: "invalid-region", properties: map[string]interface{}{}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "CloudFront", Name: "cdn", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedDetails map[string]string
+	}{
+		{
+			// 6000 GB and 60M HTTPS requests at North America rates, 4000 GB and 40M at Asia Pacific rates
+			name: "weighted traffic split",
+			properties: map[string]interface{}{
+				"dataTransferOutGB": 10000, "httpsRequests": 100000000,
+				"trafficSplit": map[string]interface{}{"NorthAmerica": 60, "AsiaPacific": 40},
+			},
+			expectedMonthly: "1530.0000",
+			expectedDetails: map[string]string{
+				"priceClass":               "PriceClass_All",
+				"trafficSplit":             "NorthAmerica 60%, AsiaPacific 40%",
+				"dataTransferNorthAmerica": "6000 GB = USD 510.0000",
+				"dataTransferAsiaPacific":  "4000 GB = USD 480.0000",
+				"monthlyDataTransferCost":  "USD 990.0000",
+				"monthlyRequestCost":       "USD 540.0000",
+			},
+		},
+		{
+			// 10240 GB at 0.085 and 10240 GB at 0.080, 10M HTTP requests, 5M origin shield
+			// requests, 2000 paths beyond the free 1000 and 20M function invocations
+			name: "tiered transfer with origin shield, invalidations and functions",
+			properties: map[string]interface{}{
+				"dataTransferOutGB": 20480, "httpRequests": 10000000, "originShieldRequests": 5000000,
+				"invalidationPaths": 3000, "functionInvocations": 20000000,
+			},
+			expectedMonthly: "1746.6000",
+			expectedDetails: map[string]string{
+				"trafficSplit":            "NorthAmerica 100%",
+				"monthlyDataTransferCost": "USD 1689.6000",
+				"monthlyRequestCost":      "USD 7.5000",
+				"monthlyOriginShieldCost": "USD 37.5000",
+				"monthlyInvalidationCost": "USD 10.0000",
+				"monthlyFunctionCost":     "USD 2.0000",
+			},
+		},
+		{
+			name: "invalidations within the free allowance",
+			properties: map[string]interface{}{
+				"priceClass": "PriceClass_100", "dataTransferOutGB": 100, "invalidationPaths": 500,
+				"trafficSplit": map[string]interface{}{"Europe": 100},
+			},
+			expectedMonthly: "8.5000",
+			expectedDetails: map[string]string{
+				"priceClass":              "PriceClass_100",
+				"invalidationPaths":       "500",
+				"monthlyInvalidationCost": "USD 0.0000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "CloudFront", Name: "cdn", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: map[string]interface{}{"dataTransferOutGB": 100}},
+		{
+			name:   "no price for geography",
+			client: &MockAWSClient{products: testProducts()},
+			properties: map[string]interface{}{
+				"dataTransferOutGB": 100,
+				"trafficSplit":      map[string]interface{}{"NorthAmerica": 50, "SouthAmerica": 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "CloudFront", Name: "cdn", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestOriginShieldGeography(t *testing.T) {
+	tests := map[string]string{
+		"us-east-1":      "NorthAmerica",
+		"ca-central-1":   "NorthAmerica",
+		"eu-west-1":      "Europe",
+		"sa-east-1":      "SouthAmerica",
+		"ap-south-1":     "India",
+		"ap-northeast-1": "Japan",
+		"ap-southeast-2": "Australia",
+		"ap-southeast-1": "AsiaPacific",
+		"ap-northeast-2": "AsiaPacific",
+	}
+
+	for region, expected := range tests {
+		if got := originShieldGeography(region).name; got != expected {
+			t.Errorf("originShieldGeography(%q) = %q, expected %q", region, got, expected)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package estimators
+
+import (
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// ApplyCurrency converts an estimation result from US dollars, the currency
+// AWS publishes prices in, to the target currency. Every amount on the result
+// and its resource costs is converted at the same rate, which is recorded on
+// the result along with the date it was published. Details and assumptions
+// keep quoting AWS list prices in US dollars.
+func ApplyCurrency(target string, rates *money.ExchangeRates, result *models.EstimationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	if target == "" || target == money.USD {
+		result.Currency = money.USD
+		return nil
+	}
+
+	if rates == nil {
+		return errors.ValidationError("exchange rates are required to convert costs").
+			WithContext("currency", target).
+			WithSuggestion("Provide an exchange rates file with --exchange-rates").
+			WithSuggestion("Or estimate in USD")
+	}
+
+	rate, err := rates.Rate(target)
+	if err != nil {
+		return err
+	}
+	conversion := money.NewAmount(rate)
+
+	for i := range result.ResourceCosts {
+		cost := &result.ResourceCosts[i]
+		for _, amount := range []*money.Amount{
+			&cost.HourlyCost, &cost.DailyCost, &cost.MonthlyCost, &cost.AnnualCost,
+			&cost.UpfrontCost, &cost.SavingsPlanEligibleCost, &cost.HourlyBilledCost, &cost.ScheduledCost,
+		} {
+			*amount = amount.Mul(conversion)
+		}
+		for j := range cost.FreeTierUsage {
+			cost.FreeTierUsage[j].UnitPrice = cost.FreeTierUsage[j].UnitPrice.Mul(conversion)
+		}
+		cost.Currency = target
+	}
+
+	for _, amount := range []*money.Amount{
+		&result.TotalHourlyCost, &result.TotalDailyCost, &result.TotalMonthlyCost, &result.TotalAnnualCost,
+		&result.TotalUpfrontCost, &result.FreeTierCredit,
+	} {
+		*amount = amount.Mul(conversion)
+	}
+
+	if coverage := result.SavingsPlans; coverage != nil {
+		for _, amount := range []*money.Amount{
+			&coverage.HourlyCommitment, &coverage.CoveredOnDemand, &coverage.UsedCommitment,
+			&coverage.UnusedCommitment, &coverage.UncoveredOnDemand, &coverage.NetSavings,
+		} {
+			*amount = amount.Mul(conversion)
+		}
+	}
+
+	result.Currency = target
+	result.ExchangeRate = &models.ExchangeRate{
+		From:          money.USD,
+		To:            target,
+		Rate:          rate,
+		EffectiveDate: rates.EffectiveDate,
+		Source:        rates.Source,
+	}
+
+	return nil
+}
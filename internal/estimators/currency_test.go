@@ -0,0 +1,110 @@
+package estimators
+
+import (
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+func TestApplyCurrency(t *testing.T) {
+	rates := &money.ExchangeRates{
+		Base:          money.USD,
+		EffectiveDate: "2026-10-01",
+		Rates:         map[string]float64{"EUR": 0.92, "JPY": 149.5},
+		Source:        "rates.json",
+	}
+
+	tests := []struct {
+		name          string
+		target        string
+		rates         *money.ExchangeRates
+		expectError   errors.ErrorType
+		expectedRate  float64
+		expectRecord  bool
+		expectedLabel string
+	}{
+		{name: "default currency", target: "", expectedRate: 1, expectedLabel: "USD"},
+		{name: "dollars need no rates", target: "USD", expectedRate: 1, expectedLabel: "USD"},
+		{name: "euros", target: "EUR", rates: rates, expectedRate: 0.92, expectRecord: true, expectedLabel: "EUR"},
+		{name: "yen", target: "JPY", rates: rates, expectedRate: 149.5, expectRecord: true, expectedLabel: "JPY"},
+		{name: "no rates file", target: "EUR", expectError: errors.ValidationErrorType},
+		{name: "no rate for currency", target: "GBP", rates: rates, expectError: errors.ValidationErrorType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := resultOf(
+				onDemandCost("web", "EC2", "us-east-1", "m5.large", 0.096, 0.096),
+				onDemandCost("db", "RDS", "us-east-1", "", 0.2, 0),
+			)
+			result.ResourceCosts[0].UpfrontCost = money.NewAmount(100)
+			result.TotalUpfrontCost = money.NewAmount(100)
+			result.FreeTierCredit = money.NewAmount(7.3)
+			result.SavingsPlans = &models.SavingsPlansCoverage{
+				HourlyCommitment: money.NewAmount(0.05),
+				NetSavings:       money.NewAmount(0.01),
+				Utilization:      100,
+			}
+			before := *result
+			beforeCosts := append([]models.CostEstimate(nil), result.ResourceCosts...)
+			beforeCoverage := *result.SavingsPlans
+
+			err := ApplyCurrency(tt.target, tt.rates, result)
+			if tt.expectError != "" {
+				if !errors.IsErrorType(err, tt.expectError) {
+					t.Errorf("expected %s error, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Currency != tt.expectedLabel {
+				t.Errorf("expected currency %s, got %s", tt.expectedLabel, result.Currency)
+			}
+			rate := money.NewAmount(tt.expectedRate)
+			for _, pair := range [][2]money.Amount{
+				{before.TotalHourlyCost, result.TotalHourlyCost},
+				{before.TotalDailyCost, result.TotalDailyCost},
+				{before.TotalMonthlyCost, result.TotalMonthlyCost},
+				{before.TotalAnnualCost, result.TotalAnnualCost},
+				{before.TotalUpfrontCost, result.TotalUpfrontCost},
+				{before.FreeTierCredit, result.FreeTierCredit},
+				{beforeCoverage.HourlyCommitment, result.SavingsPlans.HourlyCommitment},
+				{beforeCoverage.NetSavings, result.SavingsPlans.NetSavings},
+			} {
+				if !pair[1].Equal(pair[0].Mul(rate)) {
+					t.Errorf("expected %s converted to %s, got %s", pair[0], pair[0].Mul(rate), pair[1])
+				}
+			}
+			if result.SavingsPlans.Utilization != 100 {
+				t.Errorf("expected utilization to stay a percentage, got %.2f", result.SavingsPlans.Utilization)
+			}
+
+			for i, cost := range result.ResourceCosts {
+				if !cost.MonthlyCost.Equal(beforeCosts[i].MonthlyCost.Mul(rate)) ||
+					!cost.AnnualCost.Equal(beforeCosts[i].AnnualCost.Mul(rate)) ||
+					!cost.UpfrontCost.Equal(beforeCosts[i].UpfrontCost.Mul(rate)) {
+					t.Errorf("expected %s costs converted at %g, got %+v", cost.ResourceName, tt.expectedRate, cost)
+				}
+				if tt.expectRecord && cost.Currency != tt.target {
+					t.Errorf("expected %s in %s, got %s", cost.ResourceName, tt.target, cost.Currency)
+				}
+			}
+
+			if !tt.expectRecord {
+				if result.ExchangeRate != nil {
+					t.Errorf("expected no exchange rate, got %+v", result.ExchangeRate)
+				}
+				return
+			}
+			expected := models.ExchangeRate{From: "USD", To: tt.target, Rate: tt.expectedRate, EffectiveDate: "2026-10-01", Source: "rates.json"}
+			if result.ExchangeRate == nil || *result.ExchangeRate != expected {
+				t.Errorf("expected exchange rate %+v, got %+v", expected, result.ExchangeRate)
+			}
+		})
+	}
+}
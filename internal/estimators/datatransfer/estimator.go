@@ -0,0 +1,284 @@
+package datatransfer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// transfer is a kind of data transfer and the property holding its monthly GB
+type transfer struct {
+	property     string // Monthly GB property, e.g. "internetEgressGB"
+	name         string // Detail name, e.g. "InternetEgress"
+	description  string
+	transferType string // Transfer type in the AWSDataTransfer price list
+	usageType    string // Usage type substring of the transfer's products
+	directions   int    // Number of times each GB is billed
+}
+
+// transfers lists the supported kinds of data transfer in detail order
+var transfers = []transfer{
+	{
+		property:     "internetEgressGB",
+		name:         "InternetEgress",
+		description:  "Data transfer out to the internet, priced in monthly volume tiers",
+		transferType: aws.TransferTypeInternet,
+		usageType:    "DataTransfer-Out-Bytes", // e.g. "USE1-DataTransfer-Out-Bytes"
+		directions:   1,
+	},
+	{
+		property:     "interRegionGB",
+		name:         "InterRegion",
+		description:  "Data transfer out to another AWS region (set destinationRegion)",
+		transferType: aws.TransferTypeInterRegion,
+		usageType:    "AWS-Out-Bytes", // e.g. "USE1-USW2-AWS-Out-Bytes"
+		directions:   1,
+	},
+	{
+		property:     "interAZGB",
+		name:         "InterAZ",
+		description:  "Data transfer between availability zones, billed in each direction",
+		transferType: aws.TransferTypeIntraRegion,
+		usageType:    "DataTransfer-Regional-Bytes", // e.g. "USE1-DataTransfer-Regional-Bytes"
+		directions:   2,
+	},
+	{
+		property:     "cloudFrontOriginGB",
+		name:         "CloudFrontOrigin",
+		description:  "Data transfer from an AWS origin to CloudFront edge locations",
+		transferType: aws.TransferTypeCloudFront,
+		usageType:    "CloudFront-Out-Bytes", // e.g. "USE1-CloudFront-Out-Bytes"
+		directions:   1,
+	},
+}
+
+// Estimator implements the ResourceEstimator interface for data transfer
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new data transfer cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "DataTransfer"
+}
+
+// ValidateResource validates that the resource specification is valid for data transfer
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "DataTransfer" {
+		return errors.ValidationError("resource type must be 'DataTransfer'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'DataTransfer' as the resource type")
+	}
+
+	// Validate the monthly GB of each kind of transfer
+	found := false
+	for _, t := range transfers {
+		if _, exists := resource.GetProperty(t.property); !exists {
+			continue
+		}
+		found = true
+		gb, err := resource.GetIntProperty(t.property)
+		if err != nil {
+			return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", t.property), err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", t.property))
+		}
+		if gb < 0 {
+			return errors.ValidationError(fmt.Sprintf("%s cannot be negative", t.property)).
+				WithContext("resourceName", resource.Name).
+				WithContext(t.property, gb).
+				WithSuggestion(fmt.Sprintf("Set %s to the GB transferred per month", t.property))
+		}
+	}
+	if !found {
+		return errors.ValidationError("no data transfer specified").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(fmt.Sprintf("Set at least one of: %s", strings.Join(supportedProperties(), ", ")))
+	}
+
+	// Validate destination region of inter-region transfer
+	_, hasInterRegion := resource.GetProperty("interRegionGB")
+	destination, hasDestination := resource.GetProperty("destinationRegion")
+	switch {
+	case hasInterRegion && !hasDestination:
+		return errors.ValidationError("missing required property 'destinationRegion' for inter-region transfer").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Set destinationRegion to the region the data is transferred to")
+	case hasDestination && !hasInterRegion:
+		return errors.ValidationError("destinationRegion only applies to inter-region transfer").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Set interRegionGB or remove destinationRegion")
+	case hasDestination:
+		destinationRegion, ok := destination.(string)
+		if !ok {
+			return errors.ValidationError("destinationRegion must be a string").
+				WithContext("resourceName", resource.Name).
+				WithContext("destinationRegion", destination)
+		}
+		if destinationRegion == resource.Region {
+			return errors.ValidationError("destinationRegion must differ from the resource's region").
+				WithContext("resourceName", resource.Name).
+				WithContext("destinationRegion", destinationRegion).
+				WithSuggestion("Use interAZGB for transfer within a region")
+		}
+		if err := e.pricingService.ValidateRegion(destinationRegion); err != nil {
+			return errors.WrapError(err, errors.ValidationErrorType, "invalid destinationRegion for data transfer resource").
+				WithContext("resourceName", resource.Name)
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for data transfer resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// EstimateCost calculates the monthly cost of data transferred out of a region
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	destinationRegion, _ := resource.GetStringProperty("destinationRegion")
+
+	type transferCost struct {
+		transfer transfer
+		gb       int
+		cost     *aws.TieredCost
+		monthly  money.Amount
+	}
+	var costs []transferCost
+	var monthlyCost money.Amount
+
+	for _, t := range transfers {
+		gb, err := resource.GetIntProperty(t.property)
+		if err != nil || gb == 0 {
+			continue
+		}
+
+		toRegion := ""
+		if t.transferType == aws.TransferTypeInterRegion {
+			toRegion = destinationRegion
+		}
+
+		cost, err := e.priceTransfer(ctx, t, resource.Region, toRegion, float64(gb))
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate data transfer costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("transferType", t.transferType).
+				WithContext("region", resource.Region)
+		}
+
+		monthly := cost.Total.MulFloat(float64(t.directions))
+		costs = append(costs, transferCost{transfer: t, gb: gb, cost: cost, monthly: monthly})
+		monthlyCost = monthlyCost.Add(monthly)
+	}
+
+	// Create cost estimate
+	estimate := &models.CostEstimate{
+		ResourceName: resource.Name,
+		ResourceType: resource.Type,
+		Region:       resource.Region,
+		HourlyCost:   monthlyCost.DivFloat(models.HoursPerMonth), // Data transfer is priced per GB per month
+		Currency:     "USD",
+		Timestamp:    time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	estimate.AddAssumption("Monthly GB transferred out of the region; inbound data transfer is free")
+	for _, c := range costs {
+		if c.transfer.directions > 1 {
+			estimate.AddAssumption("Data transferred between availability zones is billed on both the sending and receiving side")
+		}
+		// The Free Tier covers the first GB at the first tier's price, unless
+		// the price list already includes them in a free first tier
+		if c.transfer.transferType == aws.TransferTypeInternet && c.cost.Charges[0].Tier.PricePerUnit.Sign() > 0 {
+			estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+				Allowance: models.FreeTierDataTransfer,
+				Quantity:  float64(c.gb),
+				UnitPrice: c.cost.Charges[0].Tier.PricePerUnit,
+			})
+		}
+	}
+
+	// Add details
+	for _, c := range costs {
+		estimate.SetDetail(c.transfer.property, fmt.Sprintf("%d", c.gb))
+		estimate.SetDetail(fmt.Sprintf("monthly%sCost", c.transfer.name), fmt.Sprintf("USD %s", c.monthly.StringFixed(4)))
+		estimate.SetDetail(fmt.Sprintf("%sTiers", lowerFirst(c.transfer.name)), c.cost.String())
+	}
+	if destinationRegion != "" {
+		estimate.SetDetail("destinationRegion", destinationRegion)
+	}
+
+	return estimate, nil
+}
+
+// priceTransfer prices a month of one kind of data transfer
+func (e *Estimator) priceTransfer(ctx context.Context, t transfer, fromRegion, toRegion string, gb float64) (*aws.TieredCost, error) {
+	products, err := e.pricingService.GetDataTransferPricing(ctx, t.transferType, fromRegion, toRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, product := range products {
+		if strings.Contains(product.Attributes["usageType"], t.usageType) {
+			return e.pricingService.CalculateTieredCost(product, gb)
+		}
+	}
+
+	return nil, errors.APIError("no data transfer pricing found").
+		WithContext("transferType", t.transferType).
+		WithContext("region", fromRegion).
+		WithSuggestion("Check that the regions are spelled correctly")
+}
+
+// lowerFirst lowercases the first letter of a detail name
+func lowerFirst(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// supportedProperties returns the monthly GB property of each kind of transfer
+func supportedProperties() []string {
+	properties := make([]string, 0, len(transfers))
+	for _, t := range transfers {
+		properties = append(properties, t.property)
+	}
+	return properties
+}
+
+// GetSupportedTransferTypes returns the monthly GB property of each supported kind of transfer
+func (e *Estimator) GetSupportedTransferTypes() []string {
+	return supportedProperties()
+}
+
+// GetTransferTypeDescription returns description for a kind of transfer
+func (e *Estimator) GetTransferTypeDescription(property string) string {
+	for _, t := range transfers {
+		if t.property == property {
+			return t.description
+		}
+	}
+	return "Unknown transfer type"
+}
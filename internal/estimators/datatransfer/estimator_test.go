@@ -0,0 +1,239 @@
+package datatransfer
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws"
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// MockAWSClient returns the products of the transfer type in the filters
+type MockAWSClient struct {
+	products      map[string][]interfaces.PricingProduct // Products by transfer type
+	shouldFailGet bool
+	filters       []map[string]string
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	m.filters = append(m.filters, filters)
+	return m.products[filters["transferType"]], nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+// testClient prices transfer per GB, the unit shown in tiered cost details
+func testClient() *MockAWSClient {
+	client := &MockAWSClient{
+		products: map[string][]interfaces.PricingProduct{
+			aws.TransferTypeInternet: {
+				awstest.Product("OUT", "USE1-DataTransfer-Out-Bytes",
+					[3]string{"0", "10240", "0.09"},
+					[3]string{"10240", "51200", "0.085"},
+					[3]string{"51200", "153600", "0.07"},
+					[3]string{"153600", "Inf", "0.05"},
+				),
+			},
+			aws.TransferTypeInterRegion: {
+				awstest.Product("USW2", "USE1-USW2-AWS-Out-Bytes", [3]string{"0", "Inf", "0.02"}),
+			},
+			aws.TransferTypeIntraRegion: {
+				awstest.Product("REGIONAL", "USE1-DataTransfer-Regional-Bytes", [3]string{"0", "Inf", "0.01"}),
+			},
+			aws.TransferTypeCloudFront: {
+				awstest.Product("CLOUDFRONT", "USE1-CloudFront-Out-Bytes", [3]string{"0", "Inf", "0.00"}),
+			},
+		},
+	}
+	for _, products := range client.products {
+		for i := range products {
+			products[i] = awstest.WithUnit(products[i], "GB")
+		}
+	}
+	return client
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "DataTransfer" {
+		t.Errorf("expected resource type 'DataTransfer', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid internet egress", properties: map[string]interface{}{"internetEgressGB": 1000}},
+		{name: "valid inter-region", properties: map[string]interface{}{"interRegionGB": 500, "destinationRegion": "us-west-2"}},
+		{name: "wrong resource type", resourceType: "S3", properties: map[string]interface{}{"internetEgressGB": 1000}, expectError: true},
+		{name: "no transfer", properties: map[string]interface{}{}, expectError: true},
+		{name: "negative interAZGB", properties: map[string]interface{}{"interAZGB": -10}, expectError: true},
+		{name: "cloudFrontOriginGB not a number", properties: map[string]interface{}{"cloudFrontOriginGB": "lots"}, expectError: true},
+		{name: "inter-region without destination", properties: map[string]interface{}{"interRegionGB": 500}, expectError: true},
+		{name: "destination without inter-region", properties: map[string]interface{}{"internetEgressGB": 1, "destinationRegion": "us-west-2"}, expectError: true},
+		{name: "destination in the same region", properties: map[string]interface{}{"interRegionGB": 500, "destinationRegion": "us-east-1"}, expectError: true},
+		{name: "invalid destination", properties: map[string]interface{}{"interRegionGB": 500, "destinationRegion": "mars-1"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "DataTransfer", Name: "egress", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name             string
+		properties       map[string]interface{}
+		expectedMonthly  string
+		expectedDetails  map[string]string
+		expectedFreeTier float64
+	}{
+		{
+			// 10240 GB at 0.09 and 9760 GB at 0.085
+			name:            "internet egress across tiers",
+			properties:      map[string]interface{}{"internetEgressGB": 20000},
+			expectedMonthly: "1751.2000",
+			expectedDetails: map[string]string{
+				"internetEgressGB":          "20000",
+				"monthlyInternetEgressCost": "USD 1751.2000",
+				"internetEgressTiers":       "10240 GB @ USD 0.09 (0-10240) = USD 921.6000; 9760 GB @ USD 0.085 (10240-51200) = USD 829.6000",
+			},
+			expectedFreeTier: 20000,
+		},
+		{
+			// 500 GB at 0.02 to us-west-2, 800 GB at 0.01 in each direction
+			name:            "inter-region and inter-AZ",
+			properties:      map[string]interface{}{"interRegionGB": 500, "destinationRegion": "us-west-2", "interAZGB": 800},
+			expectedMonthly: "26.0000",
+			expectedDetails: map[string]string{
+				"destinationRegion":      "us-west-2",
+				"monthlyInterRegionCost": "USD 10.0000",
+				"monthlyInterAZCost":     "USD 16.0000",
+			},
+		},
+		{
+			name:            "CloudFront origin transfer is free",
+			properties:      map[string]interface{}{"cloudFrontOriginGB": 5000, "internetEgressGB": 0},
+			expectedMonthly: "0.0000",
+			expectedDetails: map[string]string{"monthlyCloudFrontOriginCost": "USD 0.0000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(testClient())
+			resource := models.ResourceSpec{Type: "DataTransfer", Name: "egress", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+
+			if tt.expectedFreeTier == 0 {
+				if len(estimate.FreeTierUsage) != 0 {
+					t.Errorf("expected no Free Tier usage, got %+v", estimate.FreeTierUsage)
+				}
+				return
+			}
+			if len(estimate.FreeTierUsage) != 1 {
+				t.Fatalf("expected 1 Free Tier usage, got %d", len(estimate.FreeTierUsage))
+			}
+			usage := estimate.FreeTierUsage[0]
+			if usage.Allowance != models.FreeTierDataTransfer || usage.Quantity != tt.expectedFreeTier || !usage.UnitPrice.Equal(money.NewAmount(0.09)) {
+				t.Errorf("unexpected Free Tier usage: %+v", usage)
+			}
+		})
+	}
+}
+
+func TestEstimateCostDestinationFilter(t *testing.T) {
+	client := testClient()
+	estimator := NewEstimator(client)
+	resource := models.ResourceSpec{
+		Type:       "DataTransfer",
+		Name:       "replication",
+		Region:     "us-east-1",
+		Properties: map[string]interface{}{"interRegionGB": 100, "destinationRegion": "us-west-2"},
+	}
+
+	if _, err := estimator.EstimateCost(context.Background(), resource); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(client.filters) != 1 {
+		t.Fatalf("expected 1 pricing request, got %d", len(client.filters))
+	}
+	filters := client.filters[0]
+	if filters["fromLocation"] != "US East (N. Virginia)" || filters["toLocation"] != "US West (Oregon)" {
+		t.Errorf("expected transfer from us-east-1 to us-west-2, got filters %v", filters)
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	noRegional := testClient()
+	delete(noRegional.products, aws.TransferTypeIntraRegion)
+
+	tests := []struct {
+		name   string
+		client *MockAWSClient
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}},
+		{name: "no pricing for transfer type", client: noRegional},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "DataTransfer", Name: "egress", Region: "us-east-1", Properties: map[string]interface{}{"interAZGB": 100}}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Capacity modes
+const (
+	CapacityModeOnDemand    = "OnDemand"
+	CapacityModeProvisioned = "Provisioned"
+)
+
+// Table classes
+const (
+	TableClassStandard   = "STANDARD"
+	TableClassStandardIA = "STANDARD_INFREQUENT_ACCESS"
+)
+
+// Default auto scaling target utilization, in percent
+const defaultTargetUtilization = 70
+
+var capacityModeDescriptions = map[string]string{
+	CapacityModeOnDemand:    "Pay per read and write request unit",
+	CapacityModeProvisioned: "Pay per provisioned read and write capacity unit hour, optionally auto scaled",
+}
+
+var tableClassDescriptions = map[string]string{
+	TableClassStandard:   "Default table class for frequently accessed data",
+	TableClassStandardIA: "Lower storage price and higher request prices for infrequently accessed data",
+}
+
+// regionPrefix matches the region code that prefixes usage types outside
+// us-east-1, e.g. "EUW1-" in "EUW1-ReadRequestUnits"
+var regionPrefix = regexp.MustCompile(`^[A-Z]+[0-9]+-`)
+
+// autoScaling is the auto scaling policy of one capacity dimension
+type autoScaling struct {
+	min               int
+	max               int
+	targetUtilization int // Percent of provisioned capacity consumed
+}
+
+// provisioned returns the capacity auto scaling keeps provisioned for an
+// average consumed capacity
+func (a autoScaling) provisioned(consumed int) int {
+	units := int(math.Ceil(float64(consumed) * 100 / float64(a.targetUtilization)))
+	return min(max(units, a.min), a.max)
+}
+
+// Estimator implements the ResourceEstimator interface for DynamoDB tables
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new DynamoDB cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "DynamoDB"
+}
+
+// ValidateResource validates that the resource specification is valid for DynamoDB
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "DynamoDB" {
+		return errors.ValidationError("resource type must be 'DynamoDB'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'DynamoDB' as the resource type")
+	}
+
+	capacityMode := CapacityModeOnDemand // Default
+	if _, exists := resource.GetProperty("capacityMode"); exists {
+		mode, err := resource.GetStringProperty("capacityMode")
+		if err != nil || capacityModeDescriptions[mode] == "" {
+			return errors.ValidationError("invalid capacityMode").
+				WithContext("resourceName", resource.Name).
+				WithContext("capacityMode", resource.Properties["capacityMode"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s'", CapacityModeOnDemand, CapacityModeProvisioned))
+		}
+		capacityMode = mode
+	}
+
+	if _, exists := resource.GetProperty("tableClass"); exists {
+		tableClass, err := resource.GetStringProperty("tableClass")
+		if err != nil || tableClassDescriptions[tableClass] == "" {
+			return errors.ValidationError("invalid tableClass").
+				WithContext("resourceName", resource.Name).
+				WithContext("tableClass", resource.Properties["tableClass"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s'", TableClassStandard, TableClassStandardIA))
+		}
+	}
+
+	// Request units are billed in on-demand mode and capacity units in provisioned mode
+	modeProps := map[string][]string{
+		CapacityModeOnDemand:    {"readRequestUnits", "writeRequestUnits"},
+		CapacityModeProvisioned: {"readCapacityUnits", "writeCapacityUnits", "autoScaling"},
+	}
+	for mode, props := range modeProps {
+		if mode == capacityMode {
+			continue
+		}
+		for _, prop := range props {
+			if _, exists := resource.GetProperty(prop); exists {
+				return errors.ValidationError(fmt.Sprintf("%s only applies to %s capacity mode", prop, mode)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Set capacityMode to '%s' or remove %s", mode, prop))
+			}
+		}
+	}
+
+	if capacityMode == CapacityModeProvisioned {
+		for _, prop := range []string{"readCapacityUnits", "writeCapacityUnits"} {
+			if _, exists := resource.GetProperty(prop); !exists {
+				return errors.ValidationError(fmt.Sprintf("missing required property '%s' for provisioned capacity", prop)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Add '%s' property to the resource configuration", prop))
+			}
+		}
+		if _, _, err := e.autoScalingFromResource(resource); err != nil {
+			return err
+		}
+	}
+
+	// Validate optional non-negative numeric properties
+	numericProps := []string{"readRequestUnits", "writeRequestUnits", "readCapacityUnits", "writeCapacityUnits",
+		"storageGB", "globalTableReplicas", "backupStorageGB"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", prop), err).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", prop))
+			}
+			if value < 0 {
+				return errors.ValidationError(fmt.Sprintf("%s cannot be negative", prop)).
+					WithContext("resourceName", resource.Name).
+					WithContext(prop, value).
+					WithSuggestion(fmt.Sprintf("Set %s to zero or more", prop))
+			}
+		}
+	}
+
+	if _, exists := resource.GetProperty("pointInTimeRecovery"); exists {
+		if _, ok := resource.Properties["pointInTimeRecovery"].(bool); !ok {
+			return errors.ValidationError("pointInTimeRecovery must be a boolean").
+				WithContext("resourceName", resource.Name).
+				WithContext("pointInTimeRecovery", resource.Properties["pointInTimeRecovery"]).
+				WithSuggestion("Set pointInTimeRecovery to true or false")
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for DynamoDB resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// autoScalingFromResource reads the optional autoScaling property of a
+// provisioned table: minimum and maximum read and write capacity, and the
+// target utilization shared by both. It returns nil policies without it.
+func (e *Estimator) autoScalingFromResource(resource models.ResourceSpec) (read, write *autoScaling, err error) {
+	value, exists := resource.GetProperty("autoScaling")
+	if !exists {
+		return nil, nil, nil
+	}
+
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, errors.ValidationError("autoScaling must be an object").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(`Use {"minReadCapacity": 5, "maxReadCapacity": 100, "minWriteCapacity": 5, "maxWriteCapacity": 50, "targetUtilization": 70}`)
+	}
+	settings := models.ResourceSpec{Properties: properties}
+
+	intSetting := func(key string, fallback int) (int, error) {
+		if _, exists := settings.GetProperty(key); !exists {
+			if fallback > 0 {
+				return fallback, nil
+			}
+			return 0, errors.ValidationError(fmt.Sprintf("missing required autoScaling property '%s'", key)).
+				WithContext("resourceName", resource.Name)
+		}
+		value, err := settings.GetIntProperty(key)
+		if err != nil {
+			return 0, errors.ValidationErrorWithCause(fmt.Sprintf("invalid autoScaling property '%s'", key), err).
+				WithContext("resourceName", resource.Name)
+		}
+		return value, nil
+	}
+
+	targetUtilization, err := intSetting("targetUtilization", defaultTargetUtilization)
+	if err != nil {
+		return nil, nil, err
+	}
+	if targetUtilization < 20 || targetUtilization > 90 {
+		return nil, nil, errors.ValidationError("autoScaling targetUtilization must be between 20 and 90 percent").
+			WithContext("resourceName", resource.Name).
+			WithContext("targetUtilization", targetUtilization)
+	}
+
+	policies := make([]*autoScaling, 0, 2)
+	for _, dimension := range []string{"Read", "Write"} {
+		minimum, err := intSetting("min"+dimension+"Capacity", 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		maximum, err := intSetting("max"+dimension+"Capacity", 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		if minimum < 1 || maximum < minimum {
+			return nil, nil, errors.ValidationError(fmt.Sprintf("autoScaling %s capacity must have 1 <= minimum <= maximum", dimension)).
+				WithContext("resourceName", resource.Name).
+				WithContext("minimum", minimum).
+				WithContext("maximum", maximum)
+		}
+		policies = append(policies, &autoScaling{min: minimum, max: maximum, targetUtilization: targetUtilization})
+	}
+
+	return policies[0], policies[1], nil
+}
+
+// component is one priced part of a table's monthly cost
+type component struct {
+	name      string // Detail name, e.g. "Read"
+	usageType string // Usage type without the region prefix
+	quantity  float64
+	perHour   bool // Whether it is billed per hour, like provisioned capacity
+}
+
+// EstimateCost calculates the cost for a DynamoDB table
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	// Get optional properties with defaults
+	capacityMode := CapacityModeOnDemand
+	if mode, err := resource.GetStringProperty("capacityMode"); err == nil {
+		capacityMode = mode
+	}
+
+	tableClass := TableClassStandard
+	if class, err := resource.GetStringProperty("tableClass"); err == nil {
+		tableClass = class
+	}
+
+	intProperty := func(key string) int {
+		value, _ := resource.GetIntProperty(key)
+		return value
+	}
+	storageGB := intProperty("storageGB")
+	replicas := intProperty("globalTableReplicas")
+	backupStorageGB := intProperty("backupStorageGB")
+	pointInTimeRecovery, _ := resource.Properties["pointInTimeRecovery"].(bool)
+
+	// Standard-IA tables have their own request, capacity and storage prices
+	classPrefix := ""
+	if tableClass == TableClassStandardIA {
+		classPrefix = "IA-"
+	}
+
+	var components []component
+	details := make(map[string]string)
+
+	switch capacityMode {
+	case CapacityModeOnDemand:
+		reads, writes := intProperty("readRequestUnits"), intProperty("writeRequestUnits")
+		components = append(components,
+			component{"Read", classPrefix + "ReadRequestUnits", float64(reads), false},
+			component{"Write", classPrefix + "WriteRequestUnits", float64(writes), false},
+			component{"ReplicatedWrite", classPrefix + "ReplWriteRequestUnits", float64(writes * replicas), false},
+		)
+		details["readRequestUnits"] = fmt.Sprintf("%d", reads)
+		details["writeRequestUnits"] = fmt.Sprintf("%d", writes)
+	case CapacityModeProvisioned:
+		readScaling, writeScaling, _ := e.autoScalingFromResource(resource)
+		reads := provisionedCapacity(intProperty("readCapacityUnits"), readScaling)
+		writes := provisionedCapacity(intProperty("writeCapacityUnits"), writeScaling)
+		components = append(components,
+			component{"Read", classPrefix + "ReadCapacityUnit-Hrs", float64(reads) * models.HoursPerMonth, true},
+			component{"Write", classPrefix + "WriteCapacityUnit-Hrs", float64(writes) * models.HoursPerMonth, true},
+			component{"ReplicatedWrite", classPrefix + "ReplWriteCapacityUnit-Hrs", float64(writes*replicas) * models.HoursPerMonth, true},
+		)
+		details["provisionedReadCapacityUnits"] = fmt.Sprintf("%d", reads)
+		details["provisionedWriteCapacityUnits"] = fmt.Sprintf("%d", writes)
+		if readScaling != nil {
+			details["autoScaling"] = fmt.Sprintf("read %d-%d, write %d-%d, %d%% target utilization",
+				readScaling.min, readScaling.max, writeScaling.min, writeScaling.max, readScaling.targetUtilization)
+		}
+	}
+
+	components = append(components,
+		component{"Storage", classPrefix + "TimedStorage-ByteHrs", float64(storageGB), false},
+		component{"ReplicaStorage", classPrefix + "TimedStorage-ByteHrs", float64(storageGB * replicas), false},
+		component{"Backup", "TimedBackupStorage-ByteHrs", float64(backupStorageGB), false},
+	)
+	if pointInTimeRecovery {
+		components = append(components, component{"PITR", "TimedPITRStorage-ByteHrs", float64(storageGB), false})
+	}
+
+	// Get pricing data from AWS
+	products, err := e.pricingService.GetDynamoDBPricing(ctx, resource.Region)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve DynamoDB pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	productsByUsage := make(map[string]interfaces.PricingProduct)
+	for _, product := range products {
+		productsByUsage[usageName(product.Attributes["usageType"])] = product
+	}
+
+	var monthlyCost, monthlyHourlyBilled money.Amount
+	for _, c := range components {
+		if c.quantity == 0 {
+			continue
+		}
+		product, exists := productsByUsage[c.usageType]
+		if !exists {
+			return nil, errors.APIError("no DynamoDB pricing found for usage type").
+				WithContext("resourceName", resource.Name).
+				WithContext("usageType", c.usageType).
+				WithContext("region", resource.Region).
+				WithSuggestion("Check that the table class and capacity mode are available in the specified region")
+		}
+		cost, err := e.pricingService.CalculateTieredCost(product, c.quantity)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate DynamoDB costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("usageType", c.usageType)
+		}
+		monthlyCost = monthlyCost.Add(cost.Total)
+		if c.perHour {
+			monthlyHourlyBilled = monthlyHourlyBilled.Add(cost.Total)
+		}
+		details[fmt.Sprintf("monthly%sCost", c.name)] = fmt.Sprintf("USD %s", cost.Total.StringFixed(4))
+	}
+
+	// Create cost estimate
+	estimate := &models.CostEstimate{
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       monthlyCost.DivFloat(models.HoursPerMonth),
+		HourlyBilledCost: monthlyHourlyBilled.DivFloat(models.HoursPerMonth),
+		Currency:         "USD",
+		Timestamp:        time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	if capacityMode == CapacityModeOnDemand {
+		estimate.AddAssumption("On-demand capacity billed per read and write request unit")
+	} else {
+		estimate.AddAssumption("Provisioned capacity billed for every hour of the month")
+		if details["autoScaling"] != "" {
+			estimate.AddAssumption("Auto scaling keeps the average consumed capacity at the target utilization, within the minimum and maximum")
+		}
+	}
+	if replicas > 0 {
+		estimate.AddAssumption(fmt.Sprintf("Global table with %d replica regions priced at this region's rates; replication data transfer is billed separately", replicas))
+	}
+	estimate.AddAssumption("Free Tier capacity and storage are included where the price list has free tiers")
+
+	// Add details
+	estimate.SetDetail("capacityMode", capacityMode)
+	estimate.SetDetail("tableClass", tableClass)
+	estimate.SetDetail("storageGB", fmt.Sprintf("%d", storageGB))
+	estimate.SetDetail("globalTableReplicas", fmt.Sprintf("%d", replicas))
+	estimate.SetDetail("pointInTimeRecovery", fmt.Sprintf("%t", pointInTimeRecovery))
+	for key, value := range details {
+		estimate.SetDetail(key, value)
+	}
+
+	return estimate, nil
+}
+
+// provisionedCapacity returns the capacity units provisioned on average: the
+// configured units, or with auto scaling the units needed to serve them as
+// average consumed capacity
+func provisionedCapacity(units int, policy *autoScaling) int {
+	if policy == nil {
+		return units
+	}
+	return policy.provisioned(units)
+}
+
+// usageName strips the region prefix from a usage type
+func usageName(usageType string) string {
+	return regionPrefix.ReplaceAllString(usageType, "")
+}
+
+// GetSupportedCapacityModes returns the supported capacity modes
+func (e *Estimator) GetSupportedCapacityModes() []string {
+	return sortedKeys(capacityModeDescriptions)
+}
+
+// GetSupportedTableClasses returns the supported table classes
+func (e *Estimator) GetSupportedTableClasses() []string {
+	return sortedKeys(tableClassDescriptions)
+}
+
+// GetTableClassDescription returns description for table classes
+func (e *Estimator) GetTableClassDescription(tableClass string) string {
+	if description, exists := tableClassDescriptions[tableClass]; exists {
+		return description
+	}
+	return "Unknown table class"
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
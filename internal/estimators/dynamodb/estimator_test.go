@@ -0,0 +1,274 @@
+package dynamodb
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+// testProducts returns DynamoDB products as listed for us-east-1, whose
+// usage types have no region prefix
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.Product("RRU", "ReadRequestUnits", [3]string{"0", "Inf", "0.000000125"}),
+		awstest.Product("WRU", "WriteRequestUnits", [3]string{"0", "Inf", "0.000000625"}),
+		awstest.Product("REPLWRU", "ReplWriteRequestUnits", [3]string{"0", "Inf", "0.000000625"}),
+		awstest.Product("IARRU", "IA-ReadRequestUnits", [3]string{"0", "Inf", "0.000000155"}),
+		awstest.Product("IAWRU", "IA-WriteRequestUnits", [3]string{"0", "Inf", "0.00000078"}),
+		awstest.Product("RCU", "ReadCapacityUnit-Hrs", [3]string{"0", "18600", "0"}, [3]string{"18600", "Inf", "0.00013"}),
+		awstest.Product("WCU", "WriteCapacityUnit-Hrs", [3]string{"0", "18600", "0"}, [3]string{"18600", "Inf", "0.00065"}),
+		awstest.Product("REPLWCU", "ReplWriteCapacityUnit-Hrs", [3]string{"0", "Inf", "0.000975"}),
+		awstest.Product("STORAGE", "TimedStorage-ByteHrs", [3]string{"0", "25", "0"}, [3]string{"25", "Inf", "0.25"}),
+		awstest.Product("IASTORAGE", "IA-TimedStorage-ByteHrs", [3]string{"0", "25", "0"}, [3]string{"25", "Inf", "0.10"}),
+		awstest.Product("PITR", "TimedPITRStorage-ByteHrs", [3]string{"0", "Inf", "0.20"}),
+		awstest.Product("BACKUP", "TimedBackupStorage-ByteHrs", [3]string{"0", "Inf", "0.10"}),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "DynamoDB" {
+		t.Errorf("expected resource type 'DynamoDB', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	autoScaling := func(minRead, maxRead, target int) map[string]interface{} {
+		return map[string]interface{}{
+			"minReadCapacity": minRead, "maxReadCapacity": maxRead,
+			"minWriteCapacity": 5, "maxWriteCapacity": 50,
+			"targetUtilization": target,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid on-demand table", properties: map[string]interface{}{"readRequestUnits": 1000000, "storageGB": 10}},
+		{name: "defaults", properties: map[string]interface{}{}},
+		{name: "valid provisioned table", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5}},
+		{name: "valid auto scaling", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5, "autoScaling": autoScaling(5, 100, 70)}},
+		{name: "valid Standard-IA global table", properties: map[string]interface{}{"tableClass": "STANDARD_INFREQUENT_ACCESS", "globalTableReplicas": 2, "pointInTimeRecovery": true}},
+		{name: "wrong resource type", resourceType: "RDS", properties: map[string]interface{}{}, expectError: true},
+		{name: "invalid capacity mode", properties: map[string]interface{}{"capacityMode": "Serverless"}, expectError: true},
+		{name: "invalid table class", properties: map[string]interface{}{"tableClass": "GLACIER"}, expectError: true},
+		{name: "request units with provisioned capacity", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5, "readRequestUnits": 100}, expectError: true},
+		{name: "capacity units with on-demand", properties: map[string]interface{}{"readCapacityUnits": 10}, expectError: true},
+		{name: "provisioned without write capacity", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10}, expectError: true},
+		{name: "auto scaling not an object", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5, "autoScaling": true}, expectError: true},
+		{name: "auto scaling minimum above maximum", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5, "autoScaling": autoScaling(50, 10, 70)}, expectError: true},
+		{name: "auto scaling target too high", properties: map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 10, "writeCapacityUnits": 5, "autoScaling": autoScaling(5, 100, 95)}, expectError: true},
+		{name: "negative storage", properties: map[string]interface{}{"storageGB": -1}, expectError: true},
+		{name: "replicas not a number", properties: map[string]interface{}{"globalTableReplicas": "two"}, expectError: true},
+		{name: "pointInTimeRecovery not a boolean", properties: map[string]interface{}{"pointInTimeRecovery": "yes"}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "DynamoDB", Name: "table", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedBilled  string // Monthly cost of the capacity billed per hour
+		expectedDetails map[string]string
+	}{
+		{
+			// 100M reads and 20M writes, 100 GB above the free 25 GB, PITR on 125 GB and 50 GB of backups
+			name: "on-demand table with backups",
+			properties: map[string]interface{}{
+				"readRequestUnits": 100000000, "writeRequestUnits": 20000000,
+				"storageGB": 125, "pointInTimeRecovery": true, "backupStorageGB": 50,
+			},
+			expectedMonthly: "80.0000",
+			expectedBilled:  "0.0000",
+			expectedDetails: map[string]string{
+				"capacityMode":       "OnDemand",
+				"tableClass":         "STANDARD",
+				"monthlyReadCost":    "USD 12.5000",
+				"monthlyWriteCost":   "USD 12.5000",
+				"monthlyStorageCost": "USD 25.0000",
+				"monthlyPITRCost":    "USD 25.0000",
+				"monthlyBackupCost":  "USD 5.0000",
+			},
+		},
+		{
+			// 10M writes replicated to 2 regions, and 25 GB of storage in each
+			name:            "global table",
+			properties:      map[string]interface{}{"writeRequestUnits": 10000000, "storageGB": 25, "globalTableReplicas": 2},
+			expectedMonthly: "25.0000",
+			expectedBilled:  "0.0000",
+			expectedDetails: map[string]string{
+				"globalTableReplicas":        "2",
+				"monthlyWriteCost":           "USD 6.2500",
+				"monthlyReplicatedWriteCost": "USD 12.5000",
+				"monthlyStorageCost":         "USD 0.0000",
+				"monthlyReplicaStorageCost":  "USD 6.2500",
+			},
+		},
+		{
+			// 73000 read and 36500 write capacity unit hours, less 18600 free hours of each
+			name:            "provisioned capacity",
+			properties:      map[string]interface{}{"capacityMode": "Provisioned", "readCapacityUnits": 100, "writeCapacityUnits": 50},
+			expectedMonthly: "18.7070",
+			expectedBilled:  "18.7070",
+			expectedDetails: map[string]string{
+				"provisionedReadCapacityUnits":  "100",
+				"provisionedWriteCapacityUnits": "50",
+				"monthlyReadCost":               "USD 7.0720",
+				"monthlyWriteCost":              "USD 11.6350",
+			},
+		},
+		{
+			// 35 consumed RCU needs 50 at 70% utilization, capped at 40; 14 consumed WCU needs 20
+			name: "auto scaled capacity",
+			properties: map[string]interface{}{
+				"capacityMode": "Provisioned", "readCapacityUnits": 35, "writeCapacityUnits": 14,
+				"autoScaling": map[string]interface{}{
+					"minReadCapacity": 5, "maxReadCapacity": 40,
+					"minWriteCapacity": 5, "maxWriteCapacity": 100,
+				},
+			},
+			expectedMonthly: "1.3780",
+			expectedBilled:  "1.3780",
+			expectedDetails: map[string]string{
+				"provisionedReadCapacityUnits":  "40",
+				"provisionedWriteCapacityUnits": "20",
+				"autoScaling":                   "read 5-40, write 5-100, 70% target utilization",
+				"monthlyWriteCost":              "USD 0.0000",
+			},
+		},
+		{
+			name:            "Standard-IA table",
+			properties:      map[string]interface{}{"tableClass": "STANDARD_INFREQUENT_ACCESS", "readRequestUnits": 10000000, "storageGB": 125},
+			expectedMonthly: "11.5500",
+			expectedBilled:  "0.0000",
+			expectedDetails: map[string]string{
+				"tableClass":         "STANDARD_INFREQUENT_ACCESS",
+				"monthlyReadCost":    "USD 1.5500",
+				"monthlyStorageCost": "USD 10.0000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "DynamoDB", Name: "table", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			if got := estimate.HourlyBilledCost.MulFloat(models.HoursPerMonth).StringFixed(4); got != tt.expectedBilled {
+				t.Errorf("expected hour-billed monthly cost %s, got %s", tt.expectedBilled, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: map[string]interface{}{"storageGB": 50}},
+		{
+			name:       "no price for usage type",
+			client:     &MockAWSClient{products: testProducts()[:3]},
+			properties: map[string]interface{}{"tableClass": "STANDARD_INFREQUENT_ACCESS", "storageGB": 50},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "DynamoDB", Name: "table", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUsageName(t *testing.T) {
+	tests := map[string]string{
+		"ReadRequestUnits":              "ReadRequestUnits",
+		"EUW1-ReadCapacityUnit-Hrs":     "ReadCapacityUnit-Hrs",
+		"IA-TimedStorage-ByteHrs":       "IA-TimedStorage-ByteHrs",
+		"APN1-IA-WriteRequestUnits":     "IA-WriteRequestUnits",
+		"USE2-TimedPITRStorage-ByteHrs": "TimedPITRStorage-ByteHrs",
+	}
+
+	for usageType, expected := range tests {
+		if got := usageName(usageType); got != expected {
+			t.Errorf("usageName(%q) = %q, expected %q", usageType, got, expected)
+		}
+	}
+}
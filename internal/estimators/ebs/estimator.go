@@ -0,0 +1,496 @@
+package ebs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// volumeType describes an EBS volume type and the limits AWS places on it
+type volumeType struct {
+	description        string
+	minSizeGB          int
+	maxSizeGB          int
+	minIOPS            int // Lowest IOPS that can be provisioned; zero when IOPS cannot be provisioned
+	maxIOPS            int
+	includedIOPS       int // IOPS included in the storage price
+	includedThroughput int // MB/s included in the storage price; zero when throughput cannot be provisioned
+	maxThroughput      int
+}
+
+// volumeTypes lists the supported EBS volume types by their API name
+var volumeTypes = map[string]volumeType{
+	"gp2": {description: "General Purpose SSD (previous generation), IOPS scale with size", minSizeGB: 1, maxSizeGB: 16384},
+	"gp3": {description: "General Purpose SSD with 3,000 IOPS and 125 MB/s included", minSizeGB: 1, maxSizeGB: 16384,
+		minIOPS: 3000, maxIOPS: 16000, includedIOPS: 3000, includedThroughput: 125, maxThroughput: 1000},
+	"io1": {description: "Provisioned IOPS SSD", minSizeGB: 4, maxSizeGB: 16384, minIOPS: 100, maxIOPS: 64000},
+	"io2": {description: "Provisioned IOPS SSD with tiered IOPS pricing", minSizeGB: 4, maxSizeGB: 65536, minIOPS: 100, maxIOPS: 256000},
+	"st1": {description: "Throughput Optimized HDD", minSizeGB: 125, maxSizeGB: 16384},
+	"sc1": {description: "Cold HDD, the lowest cost per GB", minSizeGB: 125, maxSizeGB: 16384},
+}
+
+// Volume describes an EBS volume
+type Volume struct {
+	Type           string // API name, e.g. "gp3"
+	SizeGB         int
+	IOPS           int // Provisioned IOPS, including those the volume type includes
+	ThroughputMBps int // Provisioned throughput, including what the volume type includes
+}
+
+// String describes the volume, e.g. "gp3 100 GB, 6000 IOPS, 250 MB/s"
+func (v Volume) String() string {
+	parts := []string{fmt.Sprintf("%s %d GB", v.Type, v.SizeGB)}
+	if v.IOPS > 0 {
+		parts = append(parts, fmt.Sprintf("%d IOPS", v.IOPS))
+	}
+	if v.ThroughputMBps > 0 {
+		parts = append(parts, fmt.Sprintf("%d MB/s", v.ThroughputMBps))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// billableIOPS returns the provisioned IOPS charged on top of the storage price
+func (v Volume) billableIOPS() int {
+	return max(v.IOPS-volumeTypes[v.Type].includedIOPS, 0)
+}
+
+// billableThroughput returns the provisioned MB/s charged on top of the storage price
+func (v Volume) billableThroughput() int {
+	return max(v.ThroughputMBps-volumeTypes[v.Type].includedThroughput, 0)
+}
+
+// VolumeFromProperties reads and validates the volumeType, sizeGB, iops and
+// throughputMBps properties of a volume. IOPS and throughput default to what
+// the volume type includes; io1 and io2 volumes must provision IOPS.
+func VolumeFromProperties(properties map[string]interface{}) (Volume, error) {
+	volumeTypeName, ok := properties["volumeType"].(string)
+	if !ok {
+		return Volume{}, errors.ValidationError("volumeType must be a string").
+			WithContext("volumeType", properties["volumeType"]).
+			WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(supportedVolumeTypes(), ", ")))
+	}
+	limits, exists := volumeTypes[volumeTypeName]
+	if !exists {
+		return Volume{}, errors.ValidationError("invalid EBS volume type").
+			WithContext("volumeType", volumeTypeName).
+			WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(supportedVolumeTypes(), ", ")))
+	}
+
+	volume := Volume{Type: volumeTypeName, IOPS: limits.includedIOPS, ThroughputMBps: limits.includedThroughput}
+
+	sizeGB, exists, err := intProperty(properties, "sizeGB")
+	if err != nil {
+		return Volume{}, err
+	}
+	if !exists {
+		return Volume{}, errors.ValidationError("missing required property 'sizeGB'").
+			WithSuggestion("Set sizeGB to the provisioned size of the volume")
+	}
+	if sizeGB < limits.minSizeGB || sizeGB > limits.maxSizeGB {
+		return Volume{}, errors.ValidationError(fmt.Sprintf("%s volumes must be between %d and %d GB", volumeTypeName, limits.minSizeGB, limits.maxSizeGB)).
+			WithContext("sizeGB", sizeGB)
+	}
+	volume.SizeGB = sizeGB
+
+	iops, exists, err := intProperty(properties, "iops")
+	if err != nil {
+		return Volume{}, err
+	}
+	switch {
+	case exists && limits.maxIOPS == 0:
+		return Volume{}, errors.ValidationError(fmt.Sprintf("IOPS cannot be provisioned on %s volumes", volumeTypeName)).
+			WithSuggestion("Remove iops, or use a gp3, io1 or io2 volume")
+	case exists && (iops < limits.minIOPS || iops > limits.maxIOPS):
+		return Volume{}, errors.ValidationError(fmt.Sprintf("%s volumes support between %d and %d IOPS", volumeTypeName, limits.minIOPS, limits.maxIOPS)).
+			WithContext("iops", iops)
+	case exists:
+		volume.IOPS = iops
+	case limits.maxIOPS > 0 && limits.includedIOPS == 0:
+		return Volume{}, errors.ValidationError(fmt.Sprintf("missing required property 'iops' for %s volumes", volumeTypeName)).
+			WithSuggestion(fmt.Sprintf("Set iops between %d and %d", limits.minIOPS, limits.maxIOPS))
+	}
+
+	throughput, exists, err := intProperty(properties, "throughputMBps")
+	if err != nil {
+		return Volume{}, err
+	}
+	switch {
+	case exists && limits.maxThroughput == 0:
+		return Volume{}, errors.ValidationError(fmt.Sprintf("throughput cannot be provisioned on %s volumes", volumeTypeName)).
+			WithSuggestion("Remove throughputMBps, or use a gp3 volume")
+	case exists && (throughput < limits.includedThroughput || throughput > limits.maxThroughput):
+		return Volume{}, errors.ValidationError(fmt.Sprintf("%s volumes support between %d and %d MB/s", volumeTypeName, limits.includedThroughput, limits.maxThroughput)).
+			WithContext("throughputMBps", throughput)
+	case exists:
+		volume.ThroughputMBps = throughput
+	}
+
+	return volume, nil
+}
+
+// intProperty reads an optional whole number property
+func intProperty(properties map[string]interface{}, key string) (int, bool, error) {
+	value, exists := properties[key]
+	if !exists {
+		return 0, false, nil
+	}
+	switch v := value.(type) {
+	case int:
+		return v, true, nil
+	case float64:
+		return int(v), true, nil
+	default:
+		return 0, true, errors.ValidationError(fmt.Sprintf("%s must be a number", key)).
+			WithContext(key, value)
+	}
+}
+
+// VolumeCost is the monthly cost of an EBS volume, by component
+type VolumeCost struct {
+	Volume     Volume
+	StorageSKU string
+	Storage    *aws.TieredCost // GB-months
+	IOPS       *aws.TieredCost // IOPS-months above the included IOPS; nil when there are none
+	Throughput *aws.TieredCost // MB/s-months above the included throughput; nil when there are none
+}
+
+// Total returns the monthly cost of the volume
+func (c *VolumeCost) Total() money.Amount {
+	total := c.Storage.Total
+	if c.IOPS != nil {
+		total = total.Add(c.IOPS.Total)
+	}
+	if c.Throughput != nil {
+		total = total.Add(c.Throughput.Total)
+	}
+	return total
+}
+
+// Estimator implements the ResourceEstimator interface for EBS volumes
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new EBS cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "EBS"
+}
+
+// ValidateResource validates that the resource specification is valid for EBS
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "EBS" {
+		return errors.ValidationError("resource type must be 'EBS'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'EBS' as the resource type")
+	}
+
+	// Validate required properties
+	requiredProps := []string{"volumeType", "sizeGB"}
+	for _, prop := range requiredProps {
+		if _, exists := resource.GetProperty(prop); !exists {
+			return errors.ValidationError(fmt.Sprintf("missing required property '%s'", prop)).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(fmt.Sprintf("Add '%s' property to the resource configuration", prop))
+		}
+	}
+
+	if _, err := VolumeFromProperties(resource.Properties); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid EBS volume").
+			WithContext("resourceName", resource.Name)
+	}
+
+	// Validate optional count property
+	if _, exists := resource.GetProperty("count"); exists {
+		count, err := resource.GetIntProperty("count")
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid count property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure count is a positive integer")
+		}
+		if count <= 0 {
+			return errors.ValidationError("count must be greater than 0").
+				WithContext("resourceName", resource.Name).
+				WithContext("count", count).
+				WithSuggestion("Set count to the number of identical volumes")
+		}
+	}
+
+	// Validate optional snapshotGB property
+	if _, exists := resource.GetProperty("snapshotGB"); exists {
+		snapshotGB, err := resource.GetIntProperty("snapshotGB")
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid snapshotGB property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure snapshotGB is a non-negative integer")
+		}
+		if snapshotGB < 0 {
+			return errors.ValidationError("snapshotGB cannot be negative").
+				WithContext("resourceName", resource.Name).
+				WithContext("snapshotGB", snapshotGB).
+				WithSuggestion("Set snapshotGB to the snapshot storage of each volume")
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for EBS resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// EstimateCost calculates the cost for EBS volumes and their snapshots
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	volume, _ := VolumeFromProperties(resource.Properties)
+
+	// Get optional properties with defaults
+	count := 1
+	if _, exists := resource.GetProperty("count"); exists {
+		if c, err := resource.GetIntProperty("count"); err == nil {
+			count = c
+		}
+	}
+
+	snapshotGB := 0 // Default no snapshots
+	if _, exists := resource.GetProperty("snapshotGB"); exists {
+		if sg, err := resource.GetIntProperty("snapshotGB"); err == nil {
+			snapshotGB = sg
+		}
+	}
+
+	volumeCost, err := e.PriceVolume(ctx, resource.Region, volume)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate EBS volume costs").
+			WithContext("resourceName", resource.Name).
+			WithContext("volumeType", volume.Type).
+			WithContext("region", resource.Region)
+	}
+	monthlyCost := volumeCost.Total().MulFloat(float64(count))
+
+	var snapshotCost *aws.TieredCost
+	if snapshotGB > 0 {
+		snapshotCost, err = e.PriceSnapshots(ctx, resource.Region, float64(snapshotGB*count))
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate EBS snapshot costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+		monthlyCost = monthlyCost.Add(snapshotCost.Total)
+	}
+
+	// Create cost estimate
+	estimate := &models.CostEstimate{
+		ResourceName: resource.Name,
+		ResourceType: resource.Type,
+		Region:       resource.Region,
+		HourlyCost:   monthlyCost.DivFloat(models.HoursPerMonth), // EBS is priced per GB-month
+		Currency:     "USD",
+		Timestamp:    time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	estimate.AddAssumption("Volumes are billed for their provisioned size, whether or not they are attached or in use")
+	if limits := volumeTypes[volume.Type]; limits.includedIOPS > 0 {
+		estimate.AddAssumption(fmt.Sprintf("%d IOPS and %d MB/s are included in the storage price", limits.includedIOPS, limits.includedThroughput))
+	}
+	if snapshotGB > 0 {
+		estimate.AddAssumption(fmt.Sprintf("Snapshot storage of %d GB per volume, after incremental deduplication", snapshotGB))
+	} else {
+		estimate.AddAssumption("Snapshot costs not included (set snapshotGB for snapshot storage)")
+	}
+
+	// Add details
+	estimate.SetDetail("volumeType", volume.Type)
+	estimate.SetDetail("sizeGB", fmt.Sprintf("%d", volume.SizeGB))
+	if volume.IOPS > 0 {
+		estimate.SetDetail("iops", fmt.Sprintf("%d", volume.IOPS))
+	}
+	if volume.ThroughputMBps > 0 {
+		estimate.SetDetail("throughputMBps", fmt.Sprintf("%d", volume.ThroughputMBps))
+	}
+	estimate.SetDetail("count", fmt.Sprintf("%d", count))
+	estimate.SetDetail("snapshotGB", fmt.Sprintf("%d", snapshotGB))
+	estimate.SetDetail("storageSKU", volumeCost.StorageSKU)
+	estimate.SetDetail("storagePrice", fmt.Sprintf("USD %s/GB-month", volumeCost.Storage.Charges[0].Tier.PricePerUnit.StringFixed(4)))
+	estimate.SetDetail("storageTiers", volumeCost.Storage.String())
+
+	// Break down monthly costs for all volumes
+	components := []struct {
+		name string
+		cost *aws.TieredCost
+		each bool // Whether the cost is per volume
+	}{
+		{"Storage", volumeCost.Storage, true},
+		{"IOPS", volumeCost.IOPS, true},
+		{"Throughput", volumeCost.Throughput, true},
+		{"Snapshot", snapshotCost, false},
+	}
+	for _, component := range components {
+		if component.cost == nil {
+			continue
+		}
+		cost := component.cost.Total
+		if component.each {
+			cost = cost.MulFloat(float64(count))
+		}
+		estimate.SetDetail(fmt.Sprintf("monthly%sCost", component.name), fmt.Sprintf("USD %s", cost.StringFixed(4)))
+	}
+	if volumeCost.IOPS != nil {
+		estimate.SetDetail("iopsTiers", volumeCost.IOPS.String())
+	}
+
+	return estimate, nil
+}
+
+// PriceVolume prices the storage, provisioned IOPS and provisioned throughput
+// of a volume for a month. IOPS and throughput the volume type includes are
+// not charged, and IOPS are priced across tiers for io2.
+func (e *Estimator) PriceVolume(ctx context.Context, region string, volume Volume) (*VolumeCost, error) {
+	products, err := e.pricingService.GetEBSPricing(ctx, volume.Type, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var storageProduct, iopsProduct, throughputProduct *interfaces.PricingProduct
+	for i, product := range products {
+		// Skip other volume types, which share usage type patterns
+		if name, exists := product.Attributes["volumeApiName"]; exists && name != volume.Type {
+			continue
+		}
+		usageType := product.Attributes["usageType"]
+		switch {
+		case e.isStorageUsage(usageType):
+			storageProduct = &products[i]
+		case e.isIOPSUsage(usageType):
+			iopsProduct = &products[i]
+		case e.isThroughputUsage(usageType):
+			throughputProduct = &products[i]
+		}
+	}
+
+	if storageProduct == nil {
+		return nil, errors.APIError("no EBS storage pricing found").
+			WithContext("volumeType", volume.Type).
+			WithContext("region", region).
+			WithSuggestion("Check that the volume type is available in the specified region")
+	}
+
+	cost := &VolumeCost{Volume: volume, StorageSKU: storageProduct.SKU}
+	cost.Storage, err = e.pricingService.CalculateTieredCost(*storageProduct, float64(volume.SizeGB))
+	if err != nil {
+		return nil, err
+	}
+
+	provisioned := []struct {
+		name     string
+		product  *interfaces.PricingProduct
+		quantity int
+		target   **aws.TieredCost
+	}{
+		{"IOPS", iopsProduct, volume.billableIOPS(), &cost.IOPS},
+		{"throughput", throughputProduct, volume.billableThroughput(), &cost.Throughput},
+	}
+	for _, p := range provisioned {
+		if p.quantity == 0 {
+			continue
+		}
+		if p.product == nil {
+			return nil, errors.APIError(fmt.Sprintf("no EBS provisioned %s pricing found", p.name)).
+				WithContext("volumeType", volume.Type).
+				WithContext("region", region)
+		}
+		*p.target, err = e.pricingService.CalculateTieredCost(*p.product, float64(p.quantity))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cost, nil
+}
+
+// PriceSnapshots prices a month of EBS snapshot storage
+func (e *Estimator) PriceSnapshots(ctx context.Context, region string, snapshotGB float64) (*aws.TieredCost, error) {
+	products, err := e.pricingService.GetEBSSnapshotPricing(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, product := range products {
+		if e.isSnapshotUsage(product.Attributes["usageType"]) {
+			return e.pricingService.CalculateTieredCost(product, snapshotGB)
+		}
+	}
+
+	return nil, errors.APIError("no EBS snapshot storage pricing found").
+		WithContext("region", region).
+		WithSuggestion("Check that EBS snapshots are available in the specified region")
+}
+
+// Helper functions
+
+func (e *Estimator) isStorageUsage(usageType string) bool {
+	// EBS storage usage types look like "EBS:VolumeUsage.gp3"
+	return strings.Contains(usageType, "VolumeUsage")
+}
+
+func (e *Estimator) isIOPSUsage(usageType string) bool {
+	// Provisioned IOPS usage types look like "EBS:VolumeP-IOPS.io2"
+	return strings.Contains(usageType, "VolumeP-IOPS")
+}
+
+func (e *Estimator) isThroughputUsage(usageType string) bool {
+	// Provisioned throughput usage types look like "EBS:VolumeP-Throughput.gp3"
+	return strings.Contains(usageType, "VolumeP-Throughput")
+}
+
+func (e *Estimator) isSnapshotUsage(usageType string) bool {
+	// Standard snapshot usage types look like "EBS:SnapshotUsage"
+	return strings.Contains(usageType, "SnapshotUsage")
+}
+
+// supportedVolumeTypes returns the supported volume types in name order
+func supportedVolumeTypes() []string {
+	types := make([]string, 0, len(volumeTypes))
+	for name := range volumeTypes {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GetSupportedVolumeTypes returns supported EBS volume types
+func (e *Estimator) GetSupportedVolumeTypes() []string {
+	return supportedVolumeTypes()
+}
+
+// GetVolumeTypeDescription returns description for volume types
+func (e *Estimator) GetVolumeTypeDescription(volumeType string) string {
+	if limits, exists := volumeTypes[volumeType]; exists {
+		return limits.description
+	}
+	return "Unknown volume type"
+}
@@ -0,0 +1,307 @@
+package ebs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+// volumeProduct builds a product priced for a volume type
+func volumeProduct(sku, volumeType, usageType string, tiers ...[3]string) interfaces.PricingProduct {
+	return awstest.WithAttribute(awstest.Product(sku, usageType, tiers...), "volumeApiName", volumeType)
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		volumeProduct("GP3", "gp3", "EBS:VolumeUsage.gp3", [3]string{"0", "Inf", "0.08"}),
+		volumeProduct("GP3IOPS", "gp3", "EBS:VolumeP-IOPS.gp3", [3]string{"0", "Inf", "0.005"}),
+		volumeProduct("GP3TP", "gp3", "EBS:VolumeP-Throughput.gp3", [3]string{"0", "Inf", "0.04"}),
+		volumeProduct("IO2", "io2", "EBS:VolumeUsage.io2", [3]string{"0", "Inf", "0.125"}),
+		volumeProduct("IO2IOPS", "io2", "EBS:VolumeP-IOPS.io2",
+			[3]string{"0", "32000", "0.065"},
+			[3]string{"32000", "64000", "0.0455"},
+			[3]string{"64000", "Inf", "0.032"},
+		),
+		volumeProduct("IO1", "io1", "EBS:VolumeUsage.piops", [3]string{"0", "Inf", "0.125"}),
+		volumeProduct("ST1", "st1", "EBS:VolumeUsage.st1", [3]string{"0", "Inf", "0.045"}),
+		awstest.FlatProduct("SNAP", "EBS:SnapshotUsage", "0.05"),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "EBS" {
+		t.Errorf("expected resource type 'EBS', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestVolumeFromProperties(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expected    Volume
+		expectError bool
+	}{
+		{
+			name:       "gp3 defaults to the included IOPS and throughput",
+			properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100.0},
+			expected:   Volume{Type: "gp3", SizeGB: 100, IOPS: 3000, ThroughputMBps: 125},
+		},
+		{
+			name:       "gp3 with provisioned IOPS and throughput",
+			properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "iops": 6000, "throughputMBps": 250},
+			expected:   Volume{Type: "gp3", SizeGB: 100, IOPS: 6000, ThroughputMBps: 250},
+		},
+		{
+			name:       "io2 with provisioned IOPS",
+			properties: map[string]interface{}{"volumeType": "io2", "sizeGB": 500, "iops": 40000},
+			expected:   Volume{Type: "io2", SizeGB: 500, IOPS: 40000},
+		},
+		{
+			name:       "st1 has no IOPS",
+			properties: map[string]interface{}{"volumeType": "st1", "sizeGB": 500},
+			expected:   Volume{Type: "st1", SizeGB: 500},
+		},
+		{name: "invalid volume type", properties: map[string]interface{}{"volumeType": "gp4", "sizeGB": 100}, expectError: true},
+		{name: "volume type not a string", properties: map[string]interface{}{"volumeType": 3, "sizeGB": 100}, expectError: true},
+		{name: "missing size", properties: map[string]interface{}{"volumeType": "gp3"}, expectError: true},
+		{name: "size not a number", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": "100"}, expectError: true},
+		{name: "st1 below minimum size", properties: map[string]interface{}{"volumeType": "st1", "sizeGB": 100}, expectError: true},
+		{name: "io2 without IOPS", properties: map[string]interface{}{"volumeType": "io2", "sizeGB": 500}, expectError: true},
+		{name: "gp3 below included IOPS", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "iops": 2000}, expectError: true},
+		{name: "io1 above maximum IOPS", properties: map[string]interface{}{"volumeType": "io1", "sizeGB": 500, "iops": 70000}, expectError: true},
+		{name: "IOPS on gp2", properties: map[string]interface{}{"volumeType": "gp2", "sizeGB": 100, "iops": 3000}, expectError: true},
+		{name: "throughput on io2", properties: map[string]interface{}{"volumeType": "io2", "sizeGB": 500, "iops": 1000, "throughputMBps": 500}, expectError: true},
+		{name: "gp3 above maximum throughput", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "throughputMBps": 2000}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volume, err := VolumeFromProperties(tt.properties)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if volume != tt.expected {
+				t.Errorf("expected volume %+v, got %+v", tt.expected, volume)
+			}
+		})
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid EBS resource", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "count": 3, "snapshotGB": 20}},
+		{name: "wrong resource type", resourceType: "EC2", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100}, expectError: true},
+		{name: "missing volumeType", properties: map[string]interface{}{"sizeGB": 100}, expectError: true},
+		{name: "missing sizeGB", properties: map[string]interface{}{"volumeType": "gp3"}, expectError: true},
+		{name: "invalid volume", properties: map[string]interface{}{"volumeType": "io1", "sizeGB": 100}, expectError: true},
+		{name: "zero count", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "count": 0}, expectError: true},
+		{name: "negative snapshotGB", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "snapshotGB": -1}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "EBS", Name: "data", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedDetails map[string]string
+		missingDetails  []string
+	}{
+		{
+			// Per volume: 100 GB × 0.08 + 3000 IOPS × 0.005 + 125 MB/s × 0.04 = 28
+			name:            "gp3 with provisioned IOPS, throughput and snapshots",
+			properties:      map[string]interface{}{"volumeType": "gp3", "sizeGB": 100, "iops": 6000, "throughputMBps": 250, "count": 2, "snapshotGB": 50},
+			expectedMonthly: "61.0000",
+			expectedDetails: map[string]string{
+				"monthlyStorageCost":    "USD 16.0000",
+				"monthlyIOPSCost":       "USD 30.0000",
+				"monthlyThroughputCost": "USD 10.0000",
+				"monthlySnapshotCost":   "USD 5.0000",
+				"storagePrice":          "USD 0.0800/GB-month",
+				"storageSKU":            "GP3",
+				"count":                 "2",
+			},
+		},
+		{
+			name:            "gp3 at the included IOPS and throughput",
+			properties:      map[string]interface{}{"volumeType": "gp3", "sizeGB": 100},
+			expectedMonthly: "8.0000",
+			expectedDetails: map[string]string{"iops": "3000", "throughputMBps": "125"},
+			missingDetails:  []string{"monthlyIOPSCost", "monthlyThroughputCost", "monthlySnapshotCost"},
+		},
+		{
+			// 32000 IOPS at 0.065 and 8000 at 0.0455
+			name:            "io2 IOPS priced across tiers",
+			properties:      map[string]interface{}{"volumeType": "io2", "sizeGB": 500, "iops": 40000},
+			expectedMonthly: "2506.5000",
+			expectedDetails: map[string]string{
+				"monthlyStorageCost": "USD 62.5000",
+				"monthlyIOPSCost":    "USD 2444.0000",
+				"iopsTiers":          "32000 @ USD 0.065 (0-32000) = USD 2080.0000; 8000 @ USD 0.0455 (32000-64000) = USD 364.0000",
+			},
+		},
+		{
+			name:            "st1 storage only",
+			properties:      map[string]interface{}{"volumeType": "st1", "sizeGB": 1000},
+			expectedMonthly: "45.0000",
+			missingDetails:  []string{"iops", "throughputMBps", "monthlyIOPSCost"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "EBS", Name: "data", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			if !estimate.SavingsPlanEligibleCost.IsZero() {
+				t.Errorf("expected EBS not to be Savings Plan eligible, got %s", estimate.SavingsPlanEligibleCost)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+			for _, key := range tt.missingDetails {
+				if value, exists := estimate.Details[key]; exists {
+					t.Errorf("expected no detail %s, got %q", key, value)
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+		errorType  errors.ErrorType
+	}{
+		{
+			name:       "invalid resource",
+			client:     &MockAWSClient{products: testProducts()},
+			properties: map[string]interface{}{"volumeType": "gp3"},
+			errorType:  errors.ValidationErrorType,
+		},
+		{
+			name:       "API failure",
+			client:     &MockAWSClient{shouldFailGet: true},
+			properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100},
+			errorType:  errors.APIErrorType,
+		},
+		{
+			name:       "no storage pricing",
+			client:     &MockAWSClient{products: testProducts()[1:3]},
+			properties: map[string]interface{}{"volumeType": "gp3", "sizeGB": 100},
+			errorType:  errors.APIErrorType,
+		},
+		{
+			name:       "no provisioned IOPS pricing",
+			client:     &MockAWSClient{products: testProducts()},
+			properties: map[string]interface{}{"volumeType": "io1", "sizeGB": 100, "iops": 1000},
+			errorType:  errors.APIErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "EBS", Name: "data", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, tt.errorType) {
+				t.Errorf("expected %s error, got %v", tt.errorType, err)
+			}
+		})
+	}
+}
+
+func TestGetSupportedVolumeTypes(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{}).(*Estimator)
+
+	types := estimator.GetSupportedVolumeTypes()
+	if strings.Join(types, ",") != "gp2,gp3,io1,io2,sc1,st1" {
+		t.Errorf("unexpected volume types %v", types)
+	}
+	for _, volumeType := range types {
+		if estimator.GetVolumeTypeDescription(volumeType) == "Unknown volume type" {
+			t.Errorf("expected a description for %s", volumeType)
+		}
+	}
+	if estimator.GetVolumeTypeDescription("gp4") != "Unknown volume type" {
+		t.Error("expected unknown volume type description")
+	}
+}
@@ -0,0 +1,229 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/estimators/ebs"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// detailedMonitoringMetrics is the number of metrics detailed monitoring
+// publishes for each instance at one-minute resolution
+const detailedMonitoringMetrics = 7
+
+// attachments describes the volumes, monitoring and addresses of each
+// instance of an EC2 resource
+type attachments struct {
+	rootVolume         *ebs.Volume
+	volumes            []ebs.Volume
+	detailedMonitoring bool
+	elasticIPs         int
+}
+
+// isEmpty reports whether the instances have nothing attached that is billed
+func (a attachments) isEmpty() bool {
+	return a.rootVolume == nil && len(a.volumes) == 0 && !a.detailedMonitoring && a.elasticIPs == 0
+}
+
+// attachmentsFromResource reads and validates the rootVolume, volumes,
+// detailedMonitoring and elasticIPs properties of an EC2 resource
+func attachmentsFromResource(resource models.ResourceSpec) (attachments, error) {
+	var result attachments
+
+	if value, exists := resource.GetProperty("rootVolume"); exists {
+		properties, ok := value.(map[string]interface{})
+		if !ok {
+			return attachments{}, errors.ValidationError("rootVolume must be an object").
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(`Use {"volumeType": "gp3", "sizeGB": 30}`)
+		}
+		volume, err := ebs.VolumeFromProperties(properties)
+		if err != nil {
+			return attachments{}, errors.WrapError(err, errors.ValidationErrorType, "invalid rootVolume").
+				WithContext("resourceName", resource.Name)
+		}
+		result.rootVolume = &volume
+	}
+
+	if value, exists := resource.GetProperty("volumes"); exists {
+		list, ok := value.([]interface{})
+		if !ok {
+			return attachments{}, errors.ValidationError("volumes must be a list").
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(`Use [{"volumeType": "gp3", "sizeGB": 500}]`)
+		}
+		for i, item := range list {
+			properties, ok := item.(map[string]interface{})
+			if !ok {
+				return attachments{}, errors.ValidationError(fmt.Sprintf("volumes[%d] must be an object", i)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(`Use {"volumeType": "gp3", "sizeGB": 500}`)
+			}
+			volume, err := ebs.VolumeFromProperties(properties)
+			if err != nil {
+				return attachments{}, errors.WrapError(err, errors.ValidationErrorType, fmt.Sprintf("invalid volumes[%d]", i)).
+					WithContext("resourceName", resource.Name)
+			}
+			result.volumes = append(result.volumes, volume)
+		}
+	}
+
+	if value, exists := resource.GetProperty("detailedMonitoring"); exists {
+		enabled, ok := value.(bool)
+		if !ok {
+			return attachments{}, errors.ValidationError("detailedMonitoring must be a boolean").
+				WithContext("resourceName", resource.Name).
+				WithContext("detailedMonitoring", value).
+				WithSuggestion("Set detailedMonitoring to true or false")
+		}
+		result.detailedMonitoring = enabled
+	}
+
+	if _, exists := resource.GetProperty("elasticIPs"); exists {
+		elasticIPs, err := resource.GetIntProperty("elasticIPs")
+		if err != nil {
+			return attachments{}, errors.ValidationErrorWithCause("invalid elasticIPs property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure elasticIPs is a non-negative integer")
+		}
+		if elasticIPs < 0 {
+			return attachments{}, errors.ValidationError("elasticIPs cannot be negative").
+				WithContext("resourceName", resource.Name).
+				WithContext("elasticIPs", elasticIPs).
+				WithSuggestion("Set elasticIPs to the number of Elastic IP addresses of each instance")
+		}
+		result.elasticIPs = elasticIPs
+	}
+
+	return result, nil
+}
+
+// addAttachmentCosts adds the monthly cost of the volumes, detailed
+// monitoring and Elastic IP addresses of all instances to the estimate, and
+// breaks the monthly cost down by component. Volumes and addresses are billed
+// for the whole month, even while instances are stopped on a schedule.
+func (e *Estimator) addAttachmentCosts(ctx context.Context, resource models.ResourceSpec, estimate *models.CostEstimate, count int, schedule *models.Schedule) error {
+	attached, _ := attachmentsFromResource(resource)
+	if attached.isEmpty() {
+		return nil
+	}
+
+	instances := float64(count)
+	instanceCost := estimate.MonthlyCost
+	var components []money.Amount
+
+	if attached.rootVolume != nil {
+		cost, err := e.volumes.PriceVolume(ctx, resource.Region, *attached.rootVolume)
+		if err != nil {
+			return errors.WrapError(err, errors.APIErrorType, "failed to calculate EC2 root volume costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+		monthly := cost.Total().MulFloat(instances)
+		components = append(components, monthly)
+		estimate.SetDetail("rootVolume", attached.rootVolume.String())
+		estimate.SetDetail("monthlyRootVolumeCost", fmt.Sprintf("USD %s", monthly.StringFixed(4)))
+	}
+
+	if len(attached.volumes) > 0 {
+		var monthly money.Amount
+		descriptions := make([]string, 0, len(attached.volumes))
+		for _, volume := range attached.volumes {
+			cost, err := e.volumes.PriceVolume(ctx, resource.Region, volume)
+			if err != nil {
+				return errors.WrapError(err, errors.APIErrorType, "failed to calculate EC2 data volume costs").
+					WithContext("resourceName", resource.Name).
+					WithContext("volume", volume.String()).
+					WithContext("region", resource.Region)
+			}
+			monthly = monthly.Add(cost.Total().MulFloat(instances))
+			descriptions = append(descriptions, volume.String())
+		}
+		components = append(components, monthly)
+		estimate.SetDetail("volumes", strings.Join(descriptions, "; "))
+		estimate.SetDetail("monthlyVolumeCost", fmt.Sprintf("USD %s", monthly.StringFixed(4)))
+	}
+
+	if attached.detailedMonitoring {
+		// Metrics are prorated by the hours instances publish them
+		metrics := float64(detailedMonitoringMetrics) * instances * usageFraction(schedule)
+		cost, err := e.priceMetrics(ctx, resource.Region, metrics)
+		if err != nil {
+			return errors.WrapError(err, errors.APIErrorType, "failed to calculate EC2 detailed monitoring costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+		components = append(components, cost.Total)
+		estimate.AddAssumption(fmt.Sprintf("Detailed monitoring publishes %d metrics per instance", detailedMonitoringMetrics))
+		estimate.SetDetail("detailedMonitoring", "true")
+		estimate.SetDetail("monthlyMonitoringCost", fmt.Sprintf("USD %s", cost.Total.StringFixed(4)))
+	}
+
+	if attached.elasticIPs > 0 {
+		hourlyPrice, err := e.priceElasticIP(ctx, resource.Region)
+		if err != nil {
+			return errors.WrapError(err, errors.APIErrorType, "failed to calculate EC2 Elastic IP address costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+		monthly := hourlyPrice.MulFloat(float64(attached.elasticIPs) * instances * models.HoursPerMonth)
+		components = append(components, monthly)
+		estimate.HourlyBilledCost = estimate.HourlyBilledCost.Add(monthly.DivFloat(models.HoursPerMonth))
+		estimate.AddAssumption("Elastic IP addresses are billed as public IPv4 addresses, attached or not")
+		estimate.SetDetail("elasticIPs", fmt.Sprintf("%d", attached.elasticIPs))
+		estimate.SetDetail("elasticIPPrice", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
+		estimate.SetDetail("monthlyElasticIPCost", fmt.Sprintf("USD %s", monthly.StringFixed(4)))
+	}
+
+	if schedule != nil && (attached.rootVolume != nil || len(attached.volumes) > 0) {
+		estimate.AddAssumption("EBS volumes are billed for the whole month, even while instances are stopped")
+	}
+
+	attachedCost := money.Sum(components...)
+	estimate.HourlyCost = estimate.HourlyCost.Add(attachedCost.DivFloat(models.HoursPerMonth))
+	estimate.CalculateCosts()
+	estimate.SetDetail("monthlyInstanceCost", fmt.Sprintf("USD %s", instanceCost.StringFixed(4)))
+
+	return nil
+}
+
+// priceMetrics prices a month of CloudWatch metrics
+func (e *Estimator) priceMetrics(ctx context.Context, region string, metrics float64) (*aws.TieredCost, error) {
+	products, err := e.pricingService.GetCloudWatchMetricPricing(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, product := range products {
+		// Metric usage types look like "CW:MetricMonitorUsage"
+		if strings.Contains(product.Attributes["usageType"], "MetricMonitorUsage") {
+			return e.pricingService.CalculateTieredCost(product, metrics)
+		}
+	}
+
+	return nil, errors.APIError("no CloudWatch metric pricing found").
+		WithContext("region", region)
+}
+
+// priceElasticIP returns the hourly price of an in-use public IPv4 address
+func (e *Estimator) priceElasticIP(ctx context.Context, region string) (money.Amount, error) {
+	products, err := e.pricingService.GetPublicIPv4Pricing(ctx, region)
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	for _, product := range products {
+		// In-use address usage types look like "USE1-PublicIPv4:InUseAddress"
+		if strings.Contains(product.Attributes["usageType"], "PublicIPv4:InUseAddress") {
+			return e.pricingService.ExtractHourlyPrice(product)
+		}
+	}
+
+	return money.Amount{}, errors.APIError("no public IPv4 address pricing found").
+		WithContext("region", region)
+}
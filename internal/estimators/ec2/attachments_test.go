@@ -0,0 +1,247 @@
+package ec2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// priceProduct builds an on-demand product with a single price
+func priceProduct(sku string, attributes map[string]string, price string) interfaces.PricingProduct {
+	return interfaces.PricingProduct{
+		SKU:        sku,
+		Attributes: attributes,
+		Terms: map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				sku + ".JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						sku + ".JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"beginRange":   "0",
+							"endRange":     "Inf",
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func attachmentsClient() *MockAWSClient {
+	return &MockAWSClient{
+		products: []interfaces.PricingProduct{
+			priceProduct("M5LARGE", map[string]string{"instanceType": "m5.large", "tenancy": "Shared", "usageType": "BoxUsage:m5.large"}, "0.096"),
+			priceProduct("GP3", map[string]string{"volumeApiName": "gp3", "usageType": "EBS:VolumeUsage.gp3"}, "0.08"),
+			priceProduct("GP3IOPS", map[string]string{"volumeApiName": "gp3", "usageType": "EBS:VolumeP-IOPS.gp3"}, "0.005"),
+			priceProduct("ST1", map[string]string{"volumeApiName": "st1", "usageType": "EBS:VolumeUsage.st1"}, "0.045"),
+		},
+		serviceProducts: map[string][]interfaces.PricingProduct{
+			"AmazonCloudWatch": {
+				priceProduct("METRIC", map[string]string{"usageType": "CW:MetricMonitorUsage"}, "0.30"),
+			},
+			"AmazonVPC": {
+				priceProduct("IDLEIP", map[string]string{"usageType": "USE1-PublicIPv4:IdleAddress"}, "0.005"),
+				priceProduct("INUSEIP", map[string]string{"usageType": "USE1-PublicIPv4:InUseAddress"}, "0.005"),
+			},
+		},
+	}
+}
+
+func TestValidateResourceAttachments(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name        string
+		properties  map[string]interface{}
+		expectError bool
+	}{
+		{
+			name: "valid attachments",
+			properties: map[string]interface{}{
+				"rootVolume":         map[string]interface{}{"volumeType": "gp3", "sizeGB": 30},
+				"volumes":            []interface{}{map[string]interface{}{"volumeType": "io2", "sizeGB": 500, "iops": 10000}},
+				"detailedMonitoring": true,
+				"elasticIPs":         1,
+			},
+		},
+		{name: "rootVolume not an object", properties: map[string]interface{}{"rootVolume": "gp3"}, expectError: true},
+		{name: "invalid rootVolume type", properties: map[string]interface{}{"rootVolume": map[string]interface{}{"volumeType": "gp4", "sizeGB": 30}}, expectError: true},
+		{name: "volumes not a list", properties: map[string]interface{}{"volumes": map[string]interface{}{"volumeType": "gp3", "sizeGB": 30}}, expectError: true},
+		{name: "volume not an object", properties: map[string]interface{}{"volumes": []interface{}{"gp3"}}, expectError: true},
+		{name: "io2 volume without IOPS", properties: map[string]interface{}{"volumes": []interface{}{map[string]interface{}{"volumeType": "io2", "sizeGB": 500}}}, expectError: true},
+		{name: "detailedMonitoring not a boolean", properties: map[string]interface{}{"detailedMonitoring": "yes"}, expectError: true},
+		{name: "negative elasticIPs", properties: map[string]interface{}{"elasticIPs": -1}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.properties["instanceType"] = "m5.large"
+			resource := models.ResourceSpec{Type: "EC2", Name: "app-server", Region: "us-east-1", Properties: tt.properties}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCostAttachments(t *testing.T) {
+	tests := []struct {
+		name               string
+		properties         map[string]interface{}
+		expectedMonthly    string
+		expectedBilled     string // Monthly cost of instance and address hours
+		expectedDetails    map[string]string
+		expectedAssumption string
+	}{
+		{
+			// 2 instances: 140.16 instance hours, 4.80 root volumes, 155.00 data
+			// volumes, 14 metrics at 0.30 and 2 addresses for 730 hours at 0.005
+			name: "volumes, monitoring and Elastic IPs",
+			properties: map[string]interface{}{
+				"rootVolume": map[string]interface{}{"volumeType": "gp3", "sizeGB": 30},
+				"volumes": []interface{}{
+					map[string]interface{}{"volumeType": "gp3", "sizeGB": 500, "iops": 6000},
+					map[string]interface{}{"volumeType": "st1", "sizeGB": 500},
+				},
+				"detailedMonitoring": true,
+				"elasticIPs":         1,
+			},
+			expectedMonthly: "311.4600",
+			expectedBilled:  "147.4600",
+			expectedDetails: map[string]string{
+				"monthlyInstanceCost":   "USD 140.1600",
+				"rootVolume":            "gp3 30 GB, 3000 IOPS, 125 MB/s",
+				"monthlyRootVolumeCost": "USD 4.8000",
+				"volumes":               "gp3 500 GB, 6000 IOPS, 125 MB/s; st1 500 GB",
+				"monthlyVolumeCost":     "USD 155.0000",
+				"monthlyMonitoringCost": "USD 4.2000",
+				"monthlyElasticIPCost":  "USD 7.3000",
+				"elasticIPPrice":        "USD 0.0050/hour",
+			},
+			expectedAssumption: "Elastic IP addresses are billed as public IPv4 addresses, attached or not",
+		},
+		{
+			// Instances and metrics run half the month; volumes are billed for all of it
+			name: "scheduled instances",
+			properties: map[string]interface{}{
+				"rootVolume":         map[string]interface{}{"volumeType": "gp3", "sizeGB": 30},
+				"detailedMonitoring": true,
+				"schedule":           map[string]interface{}{"hoursPerMonth": 365},
+			},
+			expectedMonthly: "76.9800",
+			expectedBilled:  "70.0800",
+			expectedDetails: map[string]string{
+				"monthlyInstanceCost":   "USD 70.0800",
+				"monthlyRootVolumeCost": "USD 4.8000",
+				"monthlyMonitoringCost": "USD 2.1000",
+			},
+			expectedAssumption: "EBS volumes are billed for the whole month, even while instances are stopped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(attachmentsClient())
+			tt.properties["instanceType"] = "m5.large"
+			tt.properties["count"] = 2
+			resource := models.ResourceSpec{Type: "EC2", Name: "app-server", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			if got := estimate.HourlyBilledCost.MulFloat(models.HoursPerMonth).StringFixed(4); got != tt.expectedBilled {
+				t.Errorf("expected hour-billed monthly cost %s, got %s", tt.expectedBilled, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+			if !containsAssumption(estimate.Assumptions, tt.expectedAssumption) {
+				t.Errorf("expected assumption %q, got %v", tt.expectedAssumption, estimate.Assumptions)
+			}
+
+			// Only instance hours are covered by Savings Plans
+			instanceHourly := money.NewAmount(0.096).MulFloat(2 * estimateUsageFraction(tt.properties))
+			if !estimate.SavingsPlanEligibleCost.Equal(instanceHourly) {
+				t.Errorf("expected Savings Plan eligible cost %s, got %s", instanceHourly, estimate.SavingsPlanEligibleCost)
+			}
+		})
+	}
+}
+
+func TestEstimateCostWithoutAttachments(t *testing.T) {
+	estimator := NewEstimator(attachmentsClient())
+	resource := models.ResourceSpec{
+		Type:       "EC2",
+		Name:       "app-server",
+		Region:     "us-east-1",
+		Properties: map[string]interface{}{"instanceType": "m5.large", "detailedMonitoring": false, "elasticIPs": 0},
+	}
+
+	estimate, err := estimator.EstimateCost(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !estimate.HourlyCost.Equal(money.NewAmount(0.096)) {
+		t.Errorf("expected hourly cost 0.096, got %s", estimate.HourlyCost)
+	}
+	for key := range estimate.Details {
+		if strings.HasPrefix(key, "monthly") {
+			t.Errorf("expected no cost breakdown without attachments, got %s", key)
+		}
+	}
+}
+
+func TestEstimateCostAttachmentsMissingPricing(t *testing.T) {
+	client := attachmentsClient()
+	client.serviceProducts["AmazonCloudWatch"] = []interfaces.PricingProduct{}
+	estimator := NewEstimator(client)
+
+	resource := models.ResourceSpec{
+		Type:       "EC2",
+		Name:       "app-server",
+		Region:     "us-east-1",
+		Properties: map[string]interface{}{"instanceType": "m5.large", "detailedMonitoring": true},
+	}
+
+	_, err := estimator.EstimateCost(context.Background(), resource)
+	if !errors.IsErrorType(err, errors.APIErrorType) {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
+
+// estimateUsageFraction returns the share of the month a test resource's schedule runs
+func estimateUsageFraction(properties map[string]interface{}) float64 {
+	if schedule, ok := properties["schedule"].(map[string]interface{}); ok {
+		return float64(schedule["hoursPerMonth"].(int)) / models.HoursPerMonth
+	}
+	return 1
+}
+
+func containsAssumption(assumptions []string, expected string) bool {
+	for _, assumption := range assumptions {
+		if assumption == expected {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,9 @@
 //   - Multiple operating systems (Linux, Windows, RHEL, SUSE)
 //   - Tenancy options (Shared, Dedicated, Host)
 //   - Multiple instance counts
+//   - On-demand and reserved pricing (1yr/3yr, standard/convertible, all payment options)
+//   - Spot pricing from a spot price history file (p50/p90/max per availability zone)
+//   - Root and data EBS volumes, detailed monitoring and Elastic IP addresses
 //   - Regional pricing variations
 //
 // Usage:
@@ -21,19 +24,25 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"shylock/internal/aws"
 	"shylock/internal/errors"
+	"shylock/internal/estimators/ebs"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Estimator implements the ResourceEstimator interface for EC2 instances.
 // It provides cost estimation capabilities for various EC2 instance types,
 // operating systems, and configurations using AWS Pricing API data.
 type Estimator struct {
-	pricingService *aws.PricingService // AWS Pricing API service client
+	pricingService *aws.PricingService        // AWS Pricing API service client
+	spotPrices     interfaces.SpotPriceSource // Spot price history; nil when spot pricing is unavailable
+	volumes        *ebs.Estimator             // Prices attached EBS volumes
 }
 
 // NewEstimator creates a new EC2 cost estimator with the provided AWS client.
@@ -46,9 +55,15 @@ type Estimator struct {
 func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
 	return &Estimator{
 		pricingService: aws.NewPricingService(awsClient),
+		volumes:        ebs.NewEstimator(awsClient).(*ebs.Estimator),
 	}
 }
 
+// SetSpotPriceSource enables spot pricing using the given spot price history
+func (e *Estimator) SetSpotPriceSource(source interfaces.SpotPriceSource) {
+	e.spotPrices = source
+}
+
 // SupportedResourceType returns the AWS resource type this estimator supports
 func (e *Estimator) SupportedResourceType() string {
 	return "EC2"
@@ -104,6 +119,35 @@ func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
 		}
 	}
 
+	// Validate purchase option and reserved term
+	if _, err := aws.ReservedTermFromProperties(resource.Properties); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid purchase option for EC2 resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	// Validate spot pricing properties
+	if err := e.validateSpotProperties(resource); err != nil {
+		return err
+	}
+
+	// Validate usage schedule
+	schedule, err := resource.GetSchedule()
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid schedule property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(models.ScheduleSuggestion)
+	}
+	if purchaseOption, _ := resource.GetStringProperty("purchaseOption"); schedule != nil && purchaseOption == aws.PurchaseOptionReserved {
+		return errors.ValidationError("schedule cannot be combined with reserved pricing").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Reserved instances are billed for every hour of the term; remove the schedule or use on-demand pricing")
+	}
+
+	// Validate attached volumes, monitoring and addresses
+	if _, err := attachmentsFromResource(resource); err != nil {
+		return err
+	}
+
 	// Validate region
 	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
 		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for EC2 resource").
@@ -145,6 +189,13 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 		}
 	}
 
+	// Spot prices come from the spot price history rather than the Pricing API
+	if purchaseOption, _ := resource.GetStringProperty("purchaseOption"); purchaseOption == aws.PurchaseOptionSpot {
+		return e.estimateSpotCost(ctx, resource, instanceType, count, operatingSystem, tenancy)
+	}
+
+	reservedTerm, _ := aws.ReservedTermFromProperties(resource.Properties)
+
 	// Get pricing data from AWS
 	products, err := e.pricingService.GetEC2Pricing(ctx, instanceType, resource.Region, operatingSystem)
 	if err != nil {
@@ -180,33 +231,65 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 			WithSuggestion("Verify the operating system is supported")
 	}
 
-	// Extract hourly price
-	hourlyPrice, err := e.pricingService.ExtractHourlyPrice(*selectedProduct)
-	if err != nil {
-		return nil, errors.WrapError(err, errors.APIErrorType, "failed to extract pricing information").
-			WithContext("resourceName", resource.Name).
-			WithContext("sku", selectedProduct.SKU)
+	// Extract hourly price, amortizing any reserved upfront fee over the term
+	var hourlyPrice money.Amount
+	var reservedPrice *aws.ReservedPrice
+	if reservedTerm != nil {
+		reservedPrice, err = e.pricingService.ExtractReservedPrice(*selectedProduct, *reservedTerm)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to extract reserved pricing information").
+				WithContext("resourceName", resource.Name).
+				WithContext("sku", selectedProduct.SKU)
+		}
+		hourlyPrice = reservedPrice.EffectiveHourlyPrice()
+	} else {
+		hourlyPrice, err = e.pricingService.ExtractHourlyPrice(*selectedProduct)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to extract pricing information").
+				WithContext("resourceName", resource.Name).
+				WithContext("sku", selectedProduct.SKU)
+		}
 	}
 
-	// Calculate total cost for all instances
-	totalHourlyPrice := hourlyPrice * float64(count)
+	// Calculate total cost for all instances, averaged over the hours they are stopped
+	schedule, _ := resource.GetSchedule()
+	totalHourlyPrice := hourlyPrice.MulFloat(float64(count) * usageFraction(schedule))
 
 	// Create cost estimate
 	estimate := &models.CostEstimate{
-		ResourceName: resource.Name,
-		ResourceType: resource.Type,
-		Region:       resource.Region,
-		HourlyCost:   totalHourlyPrice,
-		Currency:     "USD",
-		Timestamp:    time.Now(),
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       totalHourlyPrice,
+		HourlyBilledCost: totalHourlyPrice,
+		Currency:         "USD",
+		Timestamp:        time.Now(),
 	}
 
-	// Calculate daily and monthly costs
+	// Calculate daily, monthly and annual costs
 	estimate.CalculateCosts()
+	setSchedule(estimate, schedule)
 
 	// Add assumptions
-	estimate.AddAssumption("24/7 usage assumed (8760 hours per year)")
-	estimate.AddAssumption("On-demand pricing (no reserved instances or savings plans)")
+	addUsageAssumption(estimate, schedule)
+	if reservedPrice != nil {
+		estimate.UpfrontCost = reservedPrice.UpfrontFee.MulFloat(float64(count))
+		estimate.AddAssumption(fmt.Sprintf("Reserved pricing (%s)", reservedTerm))
+		if reservedPrice.UpfrontFee.Sign() > 0 {
+			estimate.AddAssumption(fmt.Sprintf("Upfront fee amortized over %.0f hours", reservedTerm.Hours()))
+		}
+	} else {
+		estimate.SavingsPlanEligibleCost = totalHourlyPrice
+		estimate.AddAssumption("On-demand pricing (no reserved instances or savings plans)")
+		if isFreeTierInstanceType(instanceType) && tenancy == "Shared" {
+			estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+				Allowance:           freeTierAllowance(operatingSystem),
+				Quantity:            float64(count) * monthlyHours(schedule),
+				UnitPrice:           hourlyPrice,
+				SavingsPlanEligible: true,
+			})
+		}
+	}
 	if count > 1 {
 		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d instances", count))
 	}
@@ -222,17 +305,224 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	estimate.SetDetail("operatingSystem", operatingSystem)
 	estimate.SetDetail("tenancy", tenancy)
 	estimate.SetDetail("count", fmt.Sprintf("%d", count))
-	estimate.SetDetail("pricePerInstance", fmt.Sprintf("$%.4f/hour", hourlyPrice))
+	estimate.SetDetail("pricePerInstance", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
 	estimate.SetDetail("sku", selectedProduct.SKU)
+	if reservedPrice != nil {
+		setReservedDetails(estimate, reservedPrice)
+	} else {
+		estimate.SetDetail("purchaseOption", aws.PurchaseOptionOnDemand)
+	}
 
 	// Add product family and service info
 	if selectedProduct.ProductFamily != "" {
 		estimate.SetDetail("productFamily", selectedProduct.ProductFamily)
 	}
 
+	if err := e.addAttachmentCosts(ctx, resource, estimate, count, schedule); err != nil {
+		return nil, err
+	}
+
 	return estimate, nil
 }
 
+// validateSpotProperties validates spotPercentile and availabilityZone, which
+// only apply to spot pricing, and checks that a spot price history is available
+func (e *Estimator) validateSpotProperties(resource models.ResourceSpec) error {
+	purchaseOption, _ := resource.GetStringProperty("purchaseOption")
+	if purchaseOption != aws.PurchaseOptionSpot {
+		for _, prop := range []string{"spotPercentile", "availabilityZone"} {
+			if _, exists := resource.GetProperty(prop); exists {
+				return errors.ValidationError(fmt.Sprintf("%s only applies to spot pricing", prop)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion("Set purchaseOption to 'Spot' or remove the property")
+			}
+		}
+		return nil
+	}
+
+	if _, exists := resource.GetProperty("spotPercentile"); exists {
+		percentile, err := resource.GetStringProperty("spotPercentile")
+		if err != nil || !slices.Contains(aws.SpotPercentiles, percentile) {
+			return errors.ValidationError("invalid spotPercentile").
+				WithContext("resourceName", resource.Name).
+				WithContext("spotPercentile", resource.Properties["spotPercentile"]).
+				WithSuggestion(fmt.Sprintf("Use one of: %s", strings.Join(aws.SpotPercentiles, ", ")))
+		}
+	}
+
+	if _, exists := resource.GetProperty("availabilityZone"); exists {
+		zone, err := resource.GetStringProperty("availabilityZone")
+		if err != nil || !strings.HasPrefix(zone, resource.Region) {
+			return errors.ValidationError("availabilityZone must be in the resource's region").
+				WithContext("resourceName", resource.Name).
+				WithContext("availabilityZone", resource.Properties["availabilityZone"]).
+				WithContext("region", resource.Region).
+				WithSuggestion(fmt.Sprintf("Use a zone such as '%sa'", resource.Region))
+		}
+	}
+
+	if e.spotPrices == nil {
+		return errors.ValidationError("spot pricing requires a spot price history").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Spot prices are not available from the AWS Pricing API").
+			WithSuggestion("Export them with 'aws ec2 describe-spot-price-history' and pass the file with --spot-price-history")
+	}
+
+	return nil
+}
+
+// estimateSpotCost prices EC2 instances from the spot price history. The price
+// is the chosen percentile of the requested availability zone or, when no zone
+// is given, of the zone where that percentile is cheapest.
+func (e *Estimator) estimateSpotCost(ctx context.Context, resource models.ResourceSpec, instanceType string, count int, operatingSystem, tenancy string) (*models.CostEstimate, error) {
+	percentile := aws.SpotPercentileP50 // Default
+	if p, err := resource.GetStringProperty("spotPercentile"); err == nil {
+		percentile = p
+	}
+	zone, _ := resource.GetStringProperty("availabilityZone")
+
+	productDescription := aws.SpotProductDescription(operatingSystem)
+	prices, err := e.spotPrices.GetSpotPrices(ctx, instanceType, resource.Region, productDescription)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve EC2 spot price history").
+			WithContext("resourceName", resource.Name).
+			WithContext("instanceType", instanceType).
+			WithContext("region", resource.Region)
+	}
+
+	zoneStats := aws.SummarizeSpotPrices(prices)
+	var selected *aws.SpotPriceStats
+	for i, stats := range zoneStats {
+		if zone != "" {
+			if stats.AvailabilityZone == zone {
+				selected = &zoneStats[i]
+				break
+			}
+			continue
+		}
+		if selected == nil || stats.Price(percentile).Cmp(selected.Price(percentile)) < 0 {
+			selected = &zoneStats[i]
+		}
+	}
+
+	if selected == nil {
+		return nil, errors.APIError("no spot price history found for availability zone").
+			WithContext("resourceName", resource.Name).
+			WithContext("instanceType", instanceType).
+			WithContext("availabilityZone", zone).
+			WithSuggestion("Remove availabilityZone to use the cheapest zone in the history").
+			WithSuggestion("Check that the history was exported for this availability zone")
+	}
+
+	hourlyPrice := selected.Price(percentile)
+	schedule, _ := resource.GetSchedule()
+	totalHourlyPrice := hourlyPrice.MulFloat(float64(count) * usageFraction(schedule))
+
+	estimate := &models.CostEstimate{
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       totalHourlyPrice,
+		HourlyBilledCost: totalHourlyPrice,
+		Currency:         "USD",
+		Timestamp:        time.Now(),
+	}
+	estimate.CalculateCosts()
+	setSchedule(estimate, schedule)
+
+	addUsageAssumption(estimate, schedule)
+	estimate.AddAssumption(fmt.Sprintf("Spot pricing at the %s of %d historical prices in %s", percentile, selected.Samples, selected.AvailabilityZone))
+	if zone == "" {
+		estimate.AddAssumption(fmt.Sprintf("%s is the cheapest availability zone at the %s", selected.AvailabilityZone, percentile))
+	}
+	estimate.AddAssumption("Spot instances can be interrupted and future prices may differ from the history")
+	if count > 1 {
+		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d instances", count))
+	}
+	if operatingSystem != "Linux" {
+		estimate.AddAssumption(fmt.Sprintf("Operating system: %s", operatingSystem))
+	}
+
+	zonePrices := make([]string, 0, len(zoneStats))
+	for _, stats := range zoneStats {
+		zonePrices = append(zonePrices, fmt.Sprintf("%s USD %s", stats.AvailabilityZone, stats.Price(percentile).StringFixed(4)))
+	}
+
+	estimate.SetDetail("instanceType", instanceType)
+	estimate.SetDetail("operatingSystem", operatingSystem)
+	estimate.SetDetail("tenancy", tenancy)
+	estimate.SetDetail("count", fmt.Sprintf("%d", count))
+	estimate.SetDetail("pricePerInstance", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
+	estimate.SetDetail("purchaseOption", aws.PurchaseOptionSpot)
+	estimate.SetDetail("spotPercentile", percentile)
+	estimate.SetDetail("availabilityZone", selected.AvailabilityZone)
+	estimate.SetDetail("spotPriceByZone", strings.Join(zonePrices, ", "))
+
+	if err := e.addAttachmentCosts(ctx, resource, estimate, count, schedule); err != nil {
+		return nil, err
+	}
+
+	return estimate, nil
+}
+
+// usageFraction returns the share of the month instances run on a schedule,
+// or 1 when they run 24/7
+func usageFraction(schedule *models.Schedule) float64 {
+	if schedule == nil {
+		return 1
+	}
+	return schedule.UsageFraction()
+}
+
+// setSchedule records that the instance hours only run on the schedule.
+// Elastic IP addresses, added to the hour-billed cost later, are billed
+// around the clock.
+func setSchedule(estimate *models.CostEstimate, schedule *models.Schedule) {
+	if schedule == nil {
+		return
+	}
+	estimate.Schedule = schedule
+	estimate.ScheduledCost = estimate.HourlyBilledCost
+}
+
+// monthlyHours returns the hours per month instances run
+func monthlyHours(schedule *models.Schedule) float64 {
+	return usageFraction(schedule) * models.HoursPerMonth
+}
+
+// addUsageAssumption records when the instances run and the schedule detail
+func addUsageAssumption(estimate *models.CostEstimate, schedule *models.Schedule) {
+	if schedule == nil {
+		estimate.AddAssumption("24/7 usage assumed (8760 hours per year)")
+		return
+	}
+	estimate.AddAssumption(fmt.Sprintf("Runs on a schedule: %s", schedule))
+	estimate.SetDetail("schedule", schedule.String())
+}
+
+// isFreeTierInstanceType reports whether the Free Tier covers hours of an instance type
+func isFreeTierInstanceType(instanceType string) bool {
+	return instanceType == "t2.micro" || instanceType == "t3.micro"
+}
+
+// freeTierAllowance returns the Free Tier allowance for micro instance hours of
+// an operating system. Linux and Windows hours have separate allowances.
+func freeTierAllowance(operatingSystem string) string {
+	if operatingSystem == "Windows" {
+		return models.FreeTierEC2MicroWindows
+	}
+	return models.FreeTierEC2MicroLinux
+}
+
+// setReservedDetails records the upfront and recurring components of a reserved price
+func setReservedDetails(estimate *models.CostEstimate, price *aws.ReservedPrice) {
+	estimate.SetDetail("purchaseOption", aws.PurchaseOptionReserved)
+	estimate.SetDetail("reservedTerm", price.Term.String())
+	estimate.SetDetail("upfrontFee", fmt.Sprintf("USD %s per instance", price.UpfrontFee.StringFixed(2)))
+	estimate.SetDetail("recurringPrice", fmt.Sprintf("USD %s/hour per instance", price.HourlyPrice.StringFixed(4)))
+	estimate.SetDetail("amortizedUpfront", fmt.Sprintf("USD %s/hour per instance", price.AmortizedUpfrontPrice().StringFixed(4)))
+}
+
 // isValidInstanceType validates EC2 instance type format
 func (e *Estimator) isValidInstanceType(instanceType string) bool {
 	if instanceType == "" {
@@ -2,24 +2,30 @@ package ec2
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // MockAWSClient for testing
 type MockAWSClient struct {
-	products       []interfaces.PricingProduct
-	shouldFailGet  bool
-	shouldFailDesc bool
+	products        []interfaces.PricingProduct
+	serviceProducts map[string][]interfaces.PricingProduct // Products of service codes other than AmazonEC2
+	shouldFailGet   bool
+	shouldFailDesc  bool
 }
 
 func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
 	if m.shouldFailGet {
 		return nil, errors.APIError("mock API failure")
 	}
+	if products, exists := m.serviceProducts[serviceCode]; exists {
+		return products, nil
+	}
 	return m.products, nil
 }
 
@@ -70,6 +76,65 @@ func TestValidateResource(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid reserved EC2 resource",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Reserved",
+					"term":           "3yr",
+					"offeringClass":  "convertible",
+					"paymentOption":  "Partial Upfront",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid purchaseOption",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Dedicated",
+				},
+			},
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+		{
+			name: "invalid reserved term",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":   "t3.micro",
+					"purchaseOption": "Reserved",
+					"term":           "2yr",
+				},
+			},
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+		{
+			name: "reserved property without reserved purchase option",
+			resource: models.ResourceSpec{
+				Type:   "EC2",
+				Name:   "web-server",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceType":  "t3.micro",
+					"paymentOption": "All Upfront",
+				},
+			},
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
 		{
 			name: "wrong resource type",
 			resource: models.ResourceSpec{
@@ -209,7 +274,7 @@ func TestEstimateCost(t *testing.T) {
 			expectError:     false,
 			expectedHourly:  0.0116,
 			expectedDaily:   0.0116 * 24,
-			expectedMonthly: 0.0116 * 24 * 30,
+			expectedMonthly: 0.0116 * models.HoursPerMonth,
 		},
 		{
 			name: "multiple instances",
@@ -226,7 +291,7 @@ func TestEstimateCost(t *testing.T) {
 			expectError:     false,
 			expectedHourly:  0.0116 * 3,
 			expectedDaily:   0.0116 * 3 * 24,
-			expectedMonthly: 0.0116 * 3 * 24 * 30,
+			expectedMonthly: 0.0116 * 3 * models.HoursPerMonth,
 		},
 		{
 			name: "with operating system",
@@ -314,14 +379,14 @@ func TestEstimateCost(t *testing.T) {
 
 				// Validate cost calculations (with small tolerance for floating point)
 				tolerance := 0.0001
-				if abs(estimate.HourlyCost-tt.expectedHourly) > tolerance {
-					t.Errorf("expected hourly cost %.4f, got %.4f", tt.expectedHourly, estimate.HourlyCost)
+				if abs(estimate.HourlyCost.Float64()-tt.expectedHourly) > tolerance {
+					t.Errorf("expected hourly cost %.4f, got %s", tt.expectedHourly, estimate.HourlyCost)
 				}
-				if tt.expectedDaily > 0 && abs(estimate.DailyCost-tt.expectedDaily) > tolerance {
-					t.Errorf("expected daily cost %.4f, got %.4f", tt.expectedDaily, estimate.DailyCost)
+				if tt.expectedDaily > 0 && abs(estimate.DailyCost.Float64()-tt.expectedDaily) > tolerance {
+					t.Errorf("expected daily cost %.4f, got %s", tt.expectedDaily, estimate.DailyCost)
 				}
-				if tt.expectedMonthly > 0 && abs(estimate.MonthlyCost-tt.expectedMonthly) > tolerance {
-					t.Errorf("expected monthly cost %.4f, got %.4f", tt.expectedMonthly, estimate.MonthlyCost)
+				if tt.expectedMonthly > 0 && abs(estimate.MonthlyCost.Float64()-tt.expectedMonthly) > tolerance {
+					t.Errorf("expected monthly cost %.4f, got %s", tt.expectedMonthly, estimate.MonthlyCost)
 				}
 
 				// Validate metadata
@@ -536,8 +601,8 @@ func TestCostEstimateDetails(t *testing.T) {
 	// Check that pricePerInstance detail exists and is reasonable
 	if pricePerInstance, exists := estimate.Details["pricePerInstance"]; !exists {
 		t.Error("expected pricePerInstance detail but not found")
-	} else if pricePerInstance != "$0.0116/hour" {
-		t.Errorf("expected pricePerInstance '$0.0116/hour', got '%s'", pricePerInstance)
+	} else if pricePerInstance != "USD 0.0116/hour" {
+		t.Errorf("expected pricePerInstance 'USD 0.0116/hour', got '%s'", pricePerInstance)
 	}
 }
 
@@ -619,3 +684,480 @@ func containsString(s, substr string) bool {
 			return false
 		}())
 }
+
+// reservedTerm builds a reserved term entry in the pricing API structure
+func reservedTerm(length, offeringClass, paymentOption, upfront, hourly string) map[string]interface{} {
+	return map[string]interface{}{
+		"termAttributes": map[string]interface{}{
+			"LeaseContractLength": length,
+			"OfferingClass":       offeringClass,
+			"PurchaseOption":      paymentOption,
+		},
+		"priceDimensions": map[string]interface{}{
+			"upfront": map[string]interface{}{
+				"unit":         "Quantity",
+				"pricePerUnit": map[string]interface{}{"USD": upfront},
+			},
+			"recurring": map[string]interface{}{
+				"unit":         "Hrs",
+				"pricePerUnit": map[string]interface{}{"USD": hourly},
+			},
+		},
+	}
+}
+
+func TestEstimateCostReserved(t *testing.T) {
+	mockProduct := interfaces.PricingProduct{
+		SKU:           "TEST123",
+		ProductFamily: "Compute Instance",
+		ServiceCode:   "AmazonEC2",
+		Attributes: map[string]string{
+			"instanceType": "m5.large",
+			"tenancy":      "Shared",
+		},
+		Terms: map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"TEST123.JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"TEST123.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"pricePerUnit": map[string]interface{}{"USD": "0.096"},
+						},
+					},
+				},
+			},
+			"Reserved": map[string]interface{}{
+				"TEST123.NOUPFRONT":   reservedTerm("1yr", "standard", "No Upfront", "0", "0.060"),
+				"TEST123.ALLUPFRONT":  reservedTerm("1yr", "standard", "All Upfront", "499", "0.0"),
+				"TEST123.CONVERTIBLE": reservedTerm("3yr", "convertible", "Partial Upfront", "788", "0.030"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectError     bool
+		expectedHourly  float64
+		expectedUpfront float64
+		expectedTerm    string
+	}{
+		{
+			name: "defaults to 1yr standard no upfront",
+			properties: map[string]interface{}{
+				"purchaseOption": "Reserved",
+			},
+			expectedHourly:  0.060,
+			expectedUpfront: 0,
+			expectedTerm:    "1yr standard, No Upfront",
+		},
+		{
+			name: "all upfront amortized over the term",
+			properties: map[string]interface{}{
+				"purchaseOption": "Reserved",
+				"paymentOption":  "All Upfront",
+				"count":          2,
+			},
+			expectedHourly:  499.0 / 8760 * 2,
+			expectedUpfront: 499 * 2,
+			expectedTerm:    "1yr standard, All Upfront",
+		},
+		{
+			name: "3yr convertible partial upfront",
+			properties: map[string]interface{}{
+				"purchaseOption": "Reserved",
+				"term":           "3yr",
+				"offeringClass":  "convertible",
+				"paymentOption":  "Partial Upfront",
+			},
+			expectedHourly:  0.030 + 788.0/(3*8760),
+			expectedUpfront: 788,
+			expectedTerm:    "3yr convertible, Partial Upfront",
+		},
+		{
+			name: "term not offered",
+			properties: map[string]interface{}{
+				"purchaseOption": "Reserved",
+				"term":           "3yr",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.properties["instanceType"] = "m5.large"
+			resource := models.ResourceSpec{
+				Type:       "EC2",
+				Name:       "reserved-server",
+				Region:     "us-east-1",
+				Properties: tt.properties,
+			}
+
+			estimator := NewEstimator(&MockAWSClient{products: []interfaces.PricingProduct{mockProduct}})
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if !errors.IsErrorType(err, errors.APIErrorType) {
+					t.Errorf("expected API error, got %s", errors.GetErrorType(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if abs(estimate.HourlyCost.Float64()-tt.expectedHourly) > 0.000001 {
+				t.Errorf("expected hourly cost %.6f, got %s", tt.expectedHourly, estimate.HourlyCost)
+			}
+			if abs(estimate.UpfrontCost.Float64()-tt.expectedUpfront) > 0.000001 {
+				t.Errorf("expected upfront cost %.2f, got %s", tt.expectedUpfront, estimate.UpfrontCost)
+			}
+			if estimate.Details["purchaseOption"] != "Reserved" {
+				t.Errorf("expected purchaseOption detail 'Reserved', got '%s'", estimate.Details["purchaseOption"])
+			}
+			if estimate.Details["reservedTerm"] != tt.expectedTerm {
+				t.Errorf("expected reservedTerm detail '%s', got '%s'", tt.expectedTerm, estimate.Details["reservedTerm"])
+			}
+			for _, detail := range []string{"upfrontFee", "recurringPrice", "amortizedUpfront"} {
+				if _, exists := estimate.Details[detail]; !exists {
+					t.Errorf("expected detail '%s' but not found", detail)
+				}
+			}
+		})
+	}
+}
+
+// MockSpotPriceSource for testing
+type MockSpotPriceSource struct {
+	prices []interfaces.SpotPrice
+}
+
+func (m *MockSpotPriceSource) GetSpotPrices(ctx context.Context, instanceType, region, productDescription string) ([]interfaces.SpotPrice, error) {
+	var prices []interfaces.SpotPrice
+	for _, price := range m.prices {
+		if price.InstanceType == instanceType && price.ProductDescription == productDescription {
+			prices = append(prices, price)
+		}
+	}
+	if len(prices) == 0 {
+		return nil, errors.APIError("no spot price history found")
+	}
+	return prices, nil
+}
+
+func TestEstimateCostSpot(t *testing.T) {
+	spotPrices := &MockSpotPriceSource{}
+	for zone, zonePrices := range map[string][]float64{
+		"us-east-1a": {0.040, 0.050, 0.060, 0.070, 0.080, 0.090, 0.100, 0.110, 0.120, 0.130},
+		"us-east-1b": {0.045, 0.045, 0.045, 0.045, 0.045, 0.045, 0.045, 0.045, 0.200, 0.200},
+	} {
+		for _, price := range zonePrices {
+			spotPrices.prices = append(spotPrices.prices, interfaces.SpotPrice{
+				AvailabilityZone:   zone,
+				InstanceType:       "c5.xlarge",
+				ProductDescription: "Linux/UNIX",
+				Price:              money.NewAmount(price),
+			})
+		}
+	}
+
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		withoutSource   bool
+		expectError     bool
+		errorType       errors.ErrorType
+		expectedHourly  float64
+		expectedZone    string
+		expectedPercent string
+	}{
+		{
+			name:            "p50 in the cheapest zone by default",
+			properties:      map[string]interface{}{"purchaseOption": "Spot"},
+			expectedHourly:  0.045,
+			expectedZone:    "us-east-1b",
+			expectedPercent: "p50",
+		},
+		{
+			name:            "p90 picks the zone cheapest at that percentile",
+			properties:      map[string]interface{}{"purchaseOption": "Spot", "spotPercentile": "p90", "count": 4},
+			expectedHourly:  0.120 * 4,
+			expectedZone:    "us-east-1a",
+			expectedPercent: "p90",
+		},
+		{
+			name:            "max in an explicit zone",
+			properties:      map[string]interface{}{"purchaseOption": "Spot", "spotPercentile": "max", "availabilityZone": "us-east-1b"},
+			expectedHourly:  0.200,
+			expectedZone:    "us-east-1b",
+			expectedPercent: "max",
+		},
+		{
+			name:        "zone missing from the history",
+			properties:  map[string]interface{}{"purchaseOption": "Spot", "availabilityZone": "us-east-1c"},
+			expectError: true,
+			errorType:   errors.APIErrorType,
+		},
+		{
+			name:        "operating system missing from the history",
+			properties:  map[string]interface{}{"purchaseOption": "Spot", "operatingSystem": "Windows"},
+			expectError: true,
+			errorType:   errors.APIErrorType,
+		},
+		{
+			name:          "no spot price history",
+			properties:    map[string]interface{}{"purchaseOption": "Spot"},
+			withoutSource: true,
+			expectError:   true,
+			errorType:     errors.ValidationErrorType,
+		},
+		{
+			name:        "spot property without spot purchase option",
+			properties:  map[string]interface{}{"spotPercentile": "p90"},
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.properties["instanceType"] = "c5.xlarge"
+			resource := models.ResourceSpec{
+				Type:       "EC2",
+				Name:       "batch-worker",
+				Region:     "us-east-1",
+				Properties: tt.properties,
+			}
+
+			estimator := NewEstimator(&MockAWSClient{}).(*Estimator)
+			if !tt.withoutSource {
+				estimator.SetSpotPriceSource(spotPrices)
+			}
+
+			err := estimator.ValidateResource(resource)
+			var estimate *models.CostEstimate
+			if err == nil {
+				estimate, err = estimator.EstimateCost(context.Background(), resource)
+			}
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if !errors.IsErrorType(err, tt.errorType) {
+					t.Errorf("expected %s error, got %s", tt.errorType, errors.GetErrorType(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if abs(estimate.HourlyCost.Float64()-tt.expectedHourly) > 0.000001 {
+				t.Errorf("expected hourly cost %.6f, got %s", tt.expectedHourly, estimate.HourlyCost)
+			}
+			if !estimate.SavingsPlanEligibleCost.IsZero() {
+				t.Errorf("expected spot usage to be ineligible for Savings Plans, got %s", estimate.SavingsPlanEligibleCost)
+			}
+			expectedDetails := map[string]string{
+				"purchaseOption":   "Spot",
+				"spotPercentile":   tt.expectedPercent,
+				"availabilityZone": tt.expectedZone,
+			}
+			for key, expected := range expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected %s detail '%s', got '%s'", key, expected, estimate.Details[key])
+				}
+			}
+			if _, exists := estimate.Details["spotPriceByZone"]; !exists {
+				t.Error("expected detail 'spotPriceByZone' but not found")
+			}
+		})
+	}
+}
+
+func TestEstimateCostFreeTierUsage(t *testing.T) {
+	tests := []struct {
+		name              string
+		properties        map[string]interface{}
+		expectedQuantity  float64 // Zero when the Free Tier does not apply
+		expectedAllowance string
+	}{
+		{
+			name:              "t3.micro instances",
+			properties:        map[string]interface{}{"instanceType": "t3.micro", "count": 2},
+			expectedQuantity:  2 * models.HoursPerMonth,
+			expectedAllowance: models.FreeTierEC2MicroLinux,
+		},
+		{
+			name:              "Windows t3.micro instance",
+			properties:        map[string]interface{}{"instanceType": "t3.micro", "operatingSystem": "Windows"},
+			expectedQuantity:  models.HoursPerMonth,
+			expectedAllowance: models.FreeTierEC2MicroWindows,
+		},
+		{
+			name:       "dedicated tenancy",
+			properties: map[string]interface{}{"instanceType": "t3.micro", "tenancy": "Dedicated"},
+		},
+		{
+			name:       "larger instance type",
+			properties: map[string]interface{}{"instanceType": "t3.small"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instanceType := tt.properties["instanceType"].(string)
+			product := interfaces.PricingProduct{
+				SKU:        "TEST123",
+				Attributes: map[string]string{"instanceType": instanceType, "tenancy": "Shared"},
+				Terms: map[string]interface{}{
+					"OnDemand": map[string]interface{}{
+						"TEST123.JRTCKXETXF": map[string]interface{}{
+							"priceDimensions": map[string]interface{}{
+								"TEST123.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+									"pricePerUnit": map[string]interface{}{"USD": "0.0104"},
+								},
+							},
+						},
+					},
+				},
+			}
+			resource := models.ResourceSpec{
+				Type:       "EC2",
+				Name:       "free-tier-server",
+				Region:     "us-east-1",
+				Properties: tt.properties,
+			}
+
+			estimator := NewEstimator(&MockAWSClient{products: []interfaces.PricingProduct{product}})
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.expectedQuantity == 0 {
+				if len(estimate.FreeTierUsage) != 0 {
+					t.Errorf("expected no Free Tier usage, got %+v", estimate.FreeTierUsage)
+				}
+				return
+			}
+			if len(estimate.FreeTierUsage) != 1 {
+				t.Fatalf("expected one Free Tier usage, got %+v", estimate.FreeTierUsage)
+			}
+			usage := estimate.FreeTierUsage[0]
+			if usage.Allowance != tt.expectedAllowance || usage.Quantity != tt.expectedQuantity || !usage.UnitPrice.Equal(money.NewAmount(0.0104)) {
+				t.Errorf("unexpected Free Tier usage: %+v", usage)
+			}
+		})
+	}
+}
+
+func TestEstimateCostScheduled(t *testing.T) {
+	product := interfaces.PricingProduct{
+		SKU:        "TEST123",
+		Attributes: map[string]string{"instanceType": "m5.large", "tenancy": "Shared"},
+		Terms: map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"TEST123.JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"TEST123.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"pricePerUnit": map[string]interface{}{"USD": "0.096"},
+						},
+					},
+				},
+			},
+		},
+	}
+	estimator := NewEstimator(&MockAWSClient{products: []interfaces.PricingProduct{product}})
+
+	tests := []struct {
+		name               string
+		schedule           map[string]interface{}
+		purchaseOption     string
+		expectError        bool
+		expectedHourly     float64
+		expectedAssumption string
+	}{
+		{
+			name:               "weekday working hours",
+			schedule:           map[string]interface{}{"days": "weekdays", "start": "08:00", "end": "20:00", "timeZone": "America/New_York"},
+			expectedHourly:     2 * 0.096 * 60 / 168,
+			expectedAssumption: "Runs on a schedule: weekdays 08:00-20:00 America/New_York, 261 hours per month",
+		},
+		{
+			name:               "explicit hours per month",
+			schedule:           map[string]interface{}{"hoursPerMonth": 365},
+			expectedHourly:     2 * 0.096 * 0.5,
+			expectedAssumption: "Runs on a schedule: 365 hours per month",
+		},
+		{
+			name:           "reserved instances cannot be scheduled",
+			schedule:       map[string]interface{}{"hoursPerMonth": 365},
+			purchaseOption: "Reserved",
+			expectError:    true,
+		},
+		{
+			name:        "invalid schedule",
+			schedule:    map[string]interface{}{"days": "sometimes"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := map[string]interface{}{
+				"instanceType": "m5.large",
+				"count":        2,
+				"schedule":     tt.schedule,
+			}
+			if tt.purchaseOption != "" {
+				properties["purchaseOption"] = tt.purchaseOption
+			}
+			resource := models.ResourceSpec{
+				Type:       "EC2",
+				Name:       "dev-server",
+				Region:     "us-east-1",
+				Properties: properties,
+			}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := estimate.HourlyCost.Float64() - tt.expectedHourly; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("expected hourly cost %.6f, got %s", tt.expectedHourly, estimate.HourlyCost)
+			}
+			if !estimate.SavingsPlanEligibleCost.Equal(estimate.HourlyCost) {
+				t.Errorf("expected the scheduled cost to be Savings Plan eligible, got %s", estimate.SavingsPlanEligibleCost)
+			}
+			if estimate.Schedule == nil || !estimate.ScheduledCost.Equal(estimate.HourlyCost) {
+				t.Errorf("expected instance hours to follow the schedule, got %s on %v", estimate.ScheduledCost, estimate.Schedule)
+			}
+
+			found := false
+			for _, assumption := range estimate.Assumptions {
+				found = found || assumption == tt.expectedAssumption
+				if strings.HasPrefix(assumption, "24/7") {
+					t.Errorf("unexpected 24/7 assumption for a scheduled instance")
+				}
+			}
+			if !found {
+				t.Errorf("expected assumption %q, got %v", tt.expectedAssumption, estimate.Assumptions)
+			}
+			if estimate.Details["schedule"] == "" {
+				t.Error("expected schedule detail")
+			}
+		})
+	}
+}
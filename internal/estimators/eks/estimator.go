@@ -0,0 +1,222 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Kubernetes version support types
+const (
+	SupportStandard = "Standard"
+	SupportExtended = "Extended"
+)
+
+// Estimator implements the ResourceEstimator interface for Amazon EKS clusters
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new EKS cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "EKS"
+}
+
+// ValidateResource validates that the resource specification is valid for EKS
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "EKS" {
+		return errors.ValidationError("resource type must be 'EKS'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'EKS' as the resource type")
+	}
+
+	// Validate cluster count
+	if _, exists := resource.GetProperty("clusters"); exists {
+		clusters, err := resource.GetIntProperty("clusters")
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid clusters property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure clusters is a positive integer")
+		}
+		if clusters < 1 {
+			return errors.ValidationError("clusters must be at least 1").
+				WithContext("resourceName", resource.Name).
+				WithContext("clusters", clusters)
+		}
+	}
+
+	// Validate support type
+	if _, exists := resource.GetProperty("supportType"); exists {
+		supportType, err := resource.GetStringProperty("supportType")
+		if err != nil || (supportType != SupportStandard && supportType != SupportExtended) {
+			return errors.ValidationError("invalid EKS support type").
+				WithContext("resourceName", resource.Name).
+				WithContext("supportType", resource.Properties["supportType"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s'", SupportStandard, SupportExtended))
+		}
+	}
+
+	// Validate usage schedule
+	if _, err := resource.GetSchedule(); err != nil {
+		return errors.ValidationErrorWithCause("invalid schedule property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(models.ScheduleSuggestion)
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for EKS resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// EstimateCost calculates the control plane cost of a set of EKS clusters
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	clusters := 1 // Default single cluster
+	if c, err := resource.GetIntProperty("clusters"); err == nil {
+		clusters = c
+	}
+	supportType := SupportStandard
+	if s, err := resource.GetStringProperty("supportType"); err == nil {
+		supportType = s
+	}
+
+	schedule, _ := resource.GetSchedule()
+	monthlyHours := float64(models.HoursPerMonth)
+	if schedule != nil {
+		monthlyHours = schedule.MonthlyHours()
+	}
+	clusterHours := float64(clusters) * monthlyHours
+
+	products, err := e.pricingService.GetEKSPricing(ctx, resource.Region)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve EKS pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	controlPlanePrice, err := e.findHourlyPrice(products, e.isControlPlaneUsage)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to find EKS control plane price").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	// Clusters on a Kubernetes version in extended support pay a surcharge on
+	// top of the standard control plane price
+	var extendedSupportPrice money.Amount
+	if supportType == SupportExtended {
+		extendedSupportPrice, err = e.findHourlyPrice(products, e.isExtendedSupportUsage)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to find EKS extended support price").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+	}
+
+	controlPlaneCost := controlPlanePrice.MulFloat(clusterHours)
+	extendedSupportCost := extendedSupportPrice.MulFloat(clusterHours)
+	monthlyCost := controlPlaneCost.Add(extendedSupportCost)
+
+	estimate := &models.CostEstimate{
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       monthlyCost.DivFloat(models.HoursPerMonth),
+		HourlyBilledCost: monthlyCost.DivFloat(models.HoursPerMonth), // Clusters are billed per hour
+		Currency:         "USD",
+		Timestamp:        time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	if schedule != nil {
+		estimate.Schedule, estimate.ScheduledCost = schedule, estimate.HourlyBilledCost
+		estimate.AddAssumption(fmt.Sprintf("Clusters run on a schedule: %s", schedule))
+		estimate.SetDetail("schedule", schedule.String())
+	} else {
+		estimate.AddAssumption("24/7 cluster operation assumed")
+	}
+	if clusters > 1 {
+		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d clusters", clusters))
+	}
+	if supportType == SupportExtended {
+		estimate.AddAssumption("Kubernetes version is in extended support")
+	}
+	estimate.AddAssumption("Worker nodes are billed separately (use EC2 or Fargate resources)")
+
+	// Add details
+	estimate.SetDetail("clusters", fmt.Sprintf("%d", clusters))
+	estimate.SetDetail("supportType", supportType)
+	estimate.SetDetail("controlPlanePrice", fmt.Sprintf("USD %s/hour", controlPlanePrice.StringFixed(4)))
+	estimate.SetDetail("monthlyControlPlaneCost", fmt.Sprintf("USD %s", controlPlaneCost.StringFixed(4)))
+	if supportType == SupportExtended {
+		estimate.SetDetail("extendedSupportPrice", fmt.Sprintf("USD %s/hour", extendedSupportPrice.StringFixed(4)))
+		estimate.SetDetail("monthlyExtendedSupportCost", fmt.Sprintf("USD %s", extendedSupportCost.StringFixed(4)))
+	}
+
+	return estimate, nil
+}
+
+// findHourlyPrice returns the hourly price of the first product whose usage type matches
+func (e *Estimator) findHourlyPrice(products []interfaces.PricingProduct, matches func(string) bool) (money.Amount, error) {
+	for _, product := range products {
+		if matches(product.Attributes["usageType"]) {
+			return e.pricingService.ExtractHourlyPrice(product)
+		}
+	}
+	return money.Amount{}, errors.APIError("no matching EKS pricing found").
+		WithSuggestion("Check that EKS is available in the specified region")
+}
+
+// Helper functions
+
+func (e *Estimator) isControlPlaneUsage(usageType string) bool {
+	// Control plane usage types look like "USE1-AmazonEKS-Hours:perCluster"
+	return strings.Contains(usageType, "AmazonEKS-Hours:perCluster")
+}
+
+func (e *Estimator) isExtendedSupportUsage(usageType string) bool {
+	// Extended support usage types look like "USE1-AmazonEKS-Hours:extendedSupport"
+	return strings.Contains(usageType, "AmazonEKS-Hours:extendedSupport")
+}
+
+// GetSupportedSupportTypes returns supported Kubernetes version support types
+func (e *Estimator) GetSupportedSupportTypes() []string {
+	return []string{SupportStandard, SupportExtended}
+}
+
+// GetSupportTypeDescription returns description for support types
+func (e *Estimator) GetSupportTypeDescription(supportType string) string {
+	descriptions := map[string]string{
+		SupportStandard: "Kubernetes versions in the first 14 months after their EKS release",
+		SupportExtended: "Kubernetes versions in the 12 months after standard support, billed with an hourly surcharge",
+	}
+	if desc, exists := descriptions[supportType]; exists {
+		return desc
+	}
+	return "Unknown support type"
+}
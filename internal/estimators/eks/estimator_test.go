@@ -0,0 +1,183 @@
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.FlatProduct("CLUSTER", "USE1-AmazonEKS-Hours:perCluster", "0.10"),
+		awstest.FlatProduct("EXTENDED", "USE1-AmazonEKS-Hours:extendedSupport", "0.50"),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "EKS" {
+		t.Errorf("expected resource type 'EKS', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "defaults", properties: map[string]interface{}{}},
+		{name: "extended support clusters", properties: map[string]interface{}{"clusters": 3, "supportType": "Extended"}},
+		{name: "cluster on a schedule", properties: map[string]interface{}{"schedule": map[string]interface{}{"hoursPerMonth": 300}}},
+		{name: "wrong resource type", resourceType: "ECS", properties: map[string]interface{}{}, expectError: true},
+		{name: "zero clusters", properties: map[string]interface{}{"clusters": 0}, expectError: true},
+		{name: "clusters not a number", properties: map[string]interface{}{"clusters": "two"}, expectError: true},
+		{name: "invalid support type", properties: map[string]interface{}{"supportType": "Premium"}, expectError: true},
+		{name: "invalid schedule", properties: map[string]interface{}{"schedule": map[string]interface{}{"hoursPerMonth": 1000}}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "EKS", Name: "cluster", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedDetails map[string]string
+	}{
+		{
+			name:            "single cluster",
+			properties:      map[string]interface{}{},
+			expectedMonthly: "73.0000",
+			expectedDetails: map[string]string{
+				"clusters":                "1",
+				"supportType":             "Standard",
+				"controlPlanePrice":       "USD 0.1000/hour",
+				"monthlyControlPlaneCost": "USD 73.0000",
+			},
+		},
+		{
+			// 2 clusters × 730 hours × (0.10 + 0.50)
+			name:            "extended support",
+			properties:      map[string]interface{}{"clusters": 2, "supportType": "Extended"},
+			expectedMonthly: "876.0000",
+			expectedDetails: map[string]string{
+				"monthlyControlPlaneCost":    "USD 146.0000",
+				"extendedSupportPrice":       "USD 0.5000/hour",
+				"monthlyExtendedSupportCost": "USD 730.0000",
+			},
+		},
+		{
+			name:            "cluster on a schedule",
+			properties:      map[string]interface{}{"schedule": map[string]interface{}{"hoursPerMonth": 200}},
+			expectedMonthly: "20.0000",
+			expectedDetails: map[string]string{
+				"schedule": "200 hours per month",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "EKS", Name: "cluster", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			if !estimate.SavingsPlanEligibleCost.IsZero() {
+				t.Errorf("expected no Savings Plan eligible cost, got %s", estimate.SavingsPlanEligibleCost)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: map[string]interface{}{}},
+		{name: "no pricing data", client: &MockAWSClient{}, properties: map[string]interface{}{}},
+		{
+			name:       "no extended support price",
+			client:     &MockAWSClient{products: testProducts()[:1]},
+			properties: map[string]interface{}{"supportType": "Extended"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "EKS", Name: "cluster", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
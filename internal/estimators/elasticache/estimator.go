@@ -0,0 +1,518 @@
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Deployment types
+const (
+	DeploymentNodeBased  = "NodeBased"
+	DeploymentServerless = "Serverless"
+)
+
+// Cache engines
+const (
+	EngineRedis     = "redis"
+	EngineValkey    = "valkey"
+	EngineMemcached = "memcached"
+)
+
+// Limits of node-based clusters
+const (
+	maxShards           = 500
+	maxReplicasPerShard = 5
+	maxRetentionDays    = 35
+)
+
+// engineNames maps engines to their cacheEngine names in the pricing API
+var engineNames = map[string]string{
+	EngineRedis:     "Redis",
+	EngineValkey:    "Valkey",
+	EngineMemcached: "Memcached",
+}
+
+// minimumDataStoredGB is the minimum data a serverless cache is billed for
+var minimumDataStoredGB = map[string]float64{
+	EngineRedis:     1,
+	EngineValkey:    0.1,
+	EngineMemcached: 1,
+}
+
+// Estimator implements the ResourceEstimator interface for ElastiCache clusters and serverless caches
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new ElastiCache cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "ElastiCache"
+}
+
+// ValidateResource validates that the resource specification is valid for ElastiCache
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "ElastiCache" {
+		return errors.ValidationError("resource type must be 'ElastiCache'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'ElastiCache' as the resource type")
+	}
+
+	// Validate engine
+	if _, exists := resource.GetProperty("engine"); !exists {
+		return errors.ValidationError("missing required property 'engine'").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Add 'engine' property to the resource configuration")
+	}
+	engine, err := resource.GetStringProperty("engine")
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid engine property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Ensure engine is a string")
+	}
+	if engineNames[engine] == "" {
+		return errors.ValidationError("invalid ElastiCache engine").
+			WithContext("resourceName", resource.Name).
+			WithContext("engine", engine).
+			WithSuggestion("Use valid ElastiCache engine: redis, valkey, memcached")
+	}
+
+	deploymentType := DeploymentNodeBased
+	if _, exists := resource.GetProperty("deploymentType"); exists {
+		deploymentType, err = resource.GetStringProperty("deploymentType")
+		if err != nil || (deploymentType != DeploymentNodeBased && deploymentType != DeploymentServerless) {
+			return errors.ValidationError("invalid deploymentType").
+				WithContext("resourceName", resource.Name).
+				WithContext("deploymentType", resource.Properties["deploymentType"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s'", DeploymentNodeBased, DeploymentServerless))
+		}
+	}
+
+	// Node properties only apply to node-based clusters, usage properties to serverless caches
+	modeProps := map[string][]string{
+		DeploymentNodeBased:  {"nodeType", "shards", "replicasPerShard"},
+		DeploymentServerless: {"dataStoredGB", "ecpusPerMonth"},
+	}
+	for mode, props := range modeProps {
+		if mode == deploymentType {
+			continue
+		}
+		for _, prop := range props {
+			if _, exists := resource.GetProperty(prop); exists {
+				return errors.ValidationError(fmt.Sprintf("%s only applies to %s deployments", prop, mode)).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Set deploymentType to '%s' or remove %s", mode, prop))
+			}
+		}
+	}
+
+	// Validate optional non-negative numeric properties
+	numericProps := []string{"shards", "replicasPerShard", "dataStoredGB", "ecpusPerMonth", "snapshotSizeGB", "backupRetentionDays"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", prop), err).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", prop))
+			}
+			if value < 0 {
+				return errors.ValidationError(fmt.Sprintf("%s cannot be negative", prop)).
+					WithContext("resourceName", resource.Name).
+					WithContext(prop, value).
+					WithSuggestion(fmt.Sprintf("Set %s to zero or more", prop))
+			}
+		}
+	}
+
+	if deploymentType == DeploymentNodeBased {
+		if err := e.validateCluster(resource, engine); err != nil {
+			return err
+		}
+	}
+
+	// Validate backups
+	retentionDays, _ := resource.GetIntProperty("backupRetentionDays")
+	if retentionDays > maxRetentionDays {
+		return errors.ValidationError(fmt.Sprintf("backupRetentionDays cannot exceed %d", maxRetentionDays)).
+			WithContext("resourceName", resource.Name).
+			WithContext("backupRetentionDays", retentionDays)
+	}
+	if retentionDays > 0 && deploymentType == DeploymentNodeBased {
+		if engine == EngineMemcached {
+			return errors.ValidationError("node-based Memcached clusters do not support backups").
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Remove backupRetentionDays or use the redis or valkey engine")
+		}
+		if _, exists := resource.GetProperty("snapshotSizeGB"); !exists {
+			return errors.ValidationError("missing required property 'snapshotSizeGB' for backups").
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Set snapshotSizeGB to the size of the data in the cluster")
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for ElastiCache resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// validateCluster validates the node type and topology of a node-based cluster
+func (e *Estimator) validateCluster(resource models.ResourceSpec, engine string) error {
+	if _, exists := resource.GetProperty("nodeType"); !exists {
+		return errors.ValidationError("missing required property 'nodeType' for node-based cluster").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Add 'nodeType' property to the resource configuration, or set deploymentType to 'Serverless'")
+	}
+
+	nodeType, err := resource.GetStringProperty("nodeType")
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid nodeType property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Ensure nodeType is a string (e.g., 'cache.t4g.micro', 'cache.r6g.large')")
+	}
+
+	if !e.isValidNodeType(nodeType) {
+		return errors.ValidationError("invalid ElastiCache node type format").
+			WithContext("resourceName", resource.Name).
+			WithContext("nodeType", nodeType).
+			WithSuggestion("Use valid ElastiCache node type format (e.g., 'cache.t4g.micro', 'cache.r6g.large')").
+			WithSuggestion("Check AWS documentation for available ElastiCache node types")
+	}
+
+	if shards, err := resource.GetIntProperty("shards"); err == nil && (shards < 1 || shards > maxShards) {
+		return errors.ValidationError(fmt.Sprintf("shards must be between 1 and %d", maxShards)).
+			WithContext("resourceName", resource.Name).
+			WithContext("shards", shards)
+	}
+
+	if replicas, err := resource.GetIntProperty("replicasPerShard"); err == nil {
+		if engine == EngineMemcached && replicas > 0 {
+			return errors.ValidationError("Memcached clusters do not support replicas").
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Remove replicasPerShard and set shards to the number of nodes")
+		}
+		if replicas > maxReplicasPerShard {
+			return errors.ValidationError(fmt.Sprintf("replicasPerShard cannot exceed %d", maxReplicasPerShard)).
+				WithContext("resourceName", resource.Name).
+				WithContext("replicasPerShard", replicas)
+		}
+	}
+
+	return nil
+}
+
+// EstimateCost calculates the cost for an ElastiCache cluster or serverless cache
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	engine, _ := resource.GetStringProperty("engine")
+	deploymentType := DeploymentNodeBased
+	if dt, err := resource.GetStringProperty("deploymentType"); err == nil {
+		deploymentType = dt
+	}
+
+	estimate := &models.CostEstimate{
+		ResourceName: resource.Name,
+		ResourceType: resource.Type,
+		Region:       resource.Region,
+		Currency:     "USD",
+		Timestamp:    time.Now(),
+	}
+	estimate.SetDetail("engine", engine)
+	estimate.SetDetail("deploymentType", deploymentType)
+
+	var monthlyCost money.Amount
+	var snapshotSizeGB int
+	var err error
+	if deploymentType == DeploymentServerless {
+		monthlyCost, snapshotSizeGB, err = e.estimateServerless(ctx, resource, engine, estimate)
+	} else {
+		monthlyCost, snapshotSizeGB, err = e.estimateCluster(ctx, resource, engine, estimate)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// One snapshot of each cache is free; the rest of the retained backups are billed
+	retentionDays, _ := resource.GetIntProperty("backupRetentionDays")
+	backupGB := snapshotSizeGB * max(retentionDays-1, 0)
+	backupCost := money.Amount{}
+	if backupGB > 0 {
+		backupCost, err = e.priceBackups(ctx, resource.Region, float64(backupGB))
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate ElastiCache backup costs").
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region)
+		}
+	}
+	monthlyCost = monthlyCost.Add(backupCost)
+
+	estimate.HourlyCost = monthlyCost.DivFloat(models.HoursPerMonth)
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	if retentionDays > 0 {
+		estimate.AddAssumption(fmt.Sprintf("Daily backups of %d GB retained for %d days; one snapshot is free", snapshotSizeGB, retentionDays))
+	}
+	estimate.AddAssumption("Data transfer between availability zones is billed separately (use a DataTransfer resource)")
+
+	estimate.SetDetail("backupRetentionDays", fmt.Sprintf("%d", retentionDays))
+	estimate.SetDetail("billedBackupStorageGB", fmt.Sprintf("%d", backupGB))
+	estimate.SetDetail("monthlyBackupCost", fmt.Sprintf("USD %s", backupCost.StringFixed(4)))
+
+	return estimate, nil
+}
+
+// estimateCluster prices the nodes of a node-based cluster: one primary and
+// replicasPerShard replicas in each shard, running 24/7
+func (e *Estimator) estimateCluster(ctx context.Context, resource models.ResourceSpec, engine string, estimate *models.CostEstimate) (money.Amount, int, error) {
+	nodeType, _ := resource.GetStringProperty("nodeType")
+
+	shards := 1 // Default single shard
+	if s, err := resource.GetIntProperty("shards"); err == nil {
+		shards = s
+	}
+	replicas, _ := resource.GetIntProperty("replicasPerShard")
+	nodes := shards * (1 + replicas)
+
+	products, err := e.pricingService.GetElastiCachePricing(ctx, nodeType, engineNames[engine], resource.Region)
+	if err != nil {
+		return money.Amount{}, 0, errors.WrapError(err, errors.APIErrorType, "failed to retrieve ElastiCache pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("nodeType", nodeType).
+			WithContext("region", resource.Region)
+	}
+
+	var nodeProduct *interfaces.PricingProduct
+	for i, product := range products {
+		if e.isNodeUsage(product.Attributes["usageType"]) {
+			nodeProduct = &products[i]
+			break
+		}
+	}
+	if nodeProduct == nil {
+		return money.Amount{}, 0, errors.APIError("no ElastiCache node pricing found").
+			WithContext("resourceName", resource.Name).
+			WithContext("nodeType", nodeType).
+			WithContext("region", resource.Region).
+			WithSuggestion("Check that the node type is available in the specified region")
+	}
+
+	hourlyPrice, err := e.pricingService.ExtractHourlyPrice(*nodeProduct)
+	if err != nil {
+		return money.Amount{}, 0, errors.WrapError(err, errors.APIErrorType, "failed to extract ElastiCache hourly price").
+			WithContext("resourceName", resource.Name).
+			WithContext("sku", nodeProduct.SKU)
+	}
+
+	monthlyNodeCost := hourlyPrice.MulFloat(float64(nodes)).MulFloat(models.HoursPerMonth)
+	estimate.HourlyBilledCost = monthlyNodeCost.DivFloat(models.HoursPerMonth)
+
+	estimate.AddAssumption("24/7 node operation assumed")
+	if nodes > 1 {
+		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d nodes: %d shards with %d replicas each", nodes, shards, replicas))
+	}
+
+	estimate.SetDetail("nodeType", nodeType)
+	estimate.SetDetail("shards", fmt.Sprintf("%d", shards))
+	estimate.SetDetail("replicasPerShard", fmt.Sprintf("%d", replicas))
+	estimate.SetDetail("nodes", fmt.Sprintf("%d", nodes))
+	estimate.SetDetail("hourlyPrice", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
+	estimate.SetDetail("monthlyNodeCost", fmt.Sprintf("USD %s", monthlyNodeCost.StringFixed(4)))
+
+	snapshotSizeGB, _ := resource.GetIntProperty("snapshotSizeGB")
+	return monthlyNodeCost, snapshotSizeGB, nil
+}
+
+// estimateServerless prices the data stored, in GB-hours, and the ElastiCache
+// Processing Units consumed by a serverless cache
+func (e *Estimator) estimateServerless(ctx context.Context, resource models.ResourceSpec, engine string, estimate *models.CostEstimate) (money.Amount, int, error) {
+	dataStoredGB, _ := resource.GetIntProperty("dataStoredGB")
+	ecpus, _ := resource.GetIntProperty("ecpusPerMonth")
+
+	// Serverless caches are billed for a minimum amount of data stored
+	billedGB := max(float64(dataStoredGB), minimumDataStoredGB[engine])
+
+	products, err := e.pricingService.GetElastiCacheServerlessPricing(ctx, engineNames[engine], resource.Region)
+	if err != nil {
+		return money.Amount{}, 0, errors.WrapError(err, errors.APIErrorType, "failed to retrieve ElastiCache Serverless pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	var storageProduct, ecpuProduct *interfaces.PricingProduct
+	for i, product := range products {
+		usageType := product.Attributes["usageType"]
+		switch {
+		case e.isDataStoredUsage(usageType):
+			storageProduct = &products[i]
+		case e.isECPUUsage(usageType):
+			ecpuProduct = &products[i]
+		}
+	}
+	if storageProduct == nil || ecpuProduct == nil {
+		return money.Amount{}, 0, errors.APIError("incomplete ElastiCache Serverless pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region).
+			WithContext("dataStoredPriceFound", storageProduct != nil).
+			WithContext("ecpuPriceFound", ecpuProduct != nil).
+			WithSuggestion("Check that ElastiCache Serverless is available for the engine in the specified region")
+	}
+
+	storageCost, err := e.pricingService.CalculateTieredCost(*storageProduct, billedGB*models.HoursPerMonth)
+	if err != nil {
+		return money.Amount{}, 0, errors.WrapError(err, errors.APIErrorType, "failed to calculate ElastiCache Serverless storage costs").
+			WithContext("resourceName", resource.Name).
+			WithContext("sku", storageProduct.SKU)
+	}
+	ecpuCost, err := e.pricingService.CalculateTieredCost(*ecpuProduct, float64(ecpus))
+	if err != nil {
+		return money.Amount{}, 0, errors.WrapError(err, errors.APIErrorType, "failed to calculate ElastiCache Serverless ECPU costs").
+			WithContext("resourceName", resource.Name).
+			WithContext("sku", ecpuProduct.SKU)
+	}
+
+	// Data stored is billed in GB-hours; ECPUs are billed as they are consumed
+	estimate.HourlyBilledCost = storageCost.Total.DivFloat(models.HoursPerMonth)
+
+	estimate.AddAssumption("Average data stored held for the whole month")
+	if float64(dataStoredGB) < billedGB {
+		estimate.AddAssumption(fmt.Sprintf("Serverless %s caches are billed for at least %s GB of data stored",
+			engineNames[engine], strconv.FormatFloat(billedGB, 'f', -1, 64)))
+	}
+
+	estimate.SetDetail("dataStoredGB", fmt.Sprintf("%d", dataStoredGB))
+	estimate.SetDetail("ecpusPerMonth", fmt.Sprintf("%d", ecpus))
+	estimate.SetDetail("monthlyDataStoredCost", fmt.Sprintf("USD %s", storageCost.Total.StringFixed(4)))
+	estimate.SetDetail("monthlyECPUCost", fmt.Sprintf("USD %s", ecpuCost.Total.StringFixed(4)))
+
+	// Snapshots of a serverless cache are the size of the data stored
+	snapshotSizeGB := dataStoredGB
+	if size, err := resource.GetIntProperty("snapshotSizeGB"); err == nil {
+		snapshotSizeGB = size
+	}
+	return storageCost.Total.Add(ecpuCost.Total), snapshotSizeGB, nil
+}
+
+// priceBackups prices a month of backup storage
+func (e *Estimator) priceBackups(ctx context.Context, region string, gb float64) (money.Amount, error) {
+	products, err := e.pricingService.GetElastiCacheBackupPricing(ctx, region)
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	for _, product := range products {
+		if e.isBackupUsage(product.Attributes["usageType"]) {
+			cost, err := e.pricingService.CalculateTieredCost(product, gb)
+			if err != nil {
+				return money.Amount{}, err
+			}
+			return cost.Total, nil
+		}
+	}
+
+	return money.Amount{}, errors.APIError("no ElastiCache backup pricing found").
+		WithContext("region", region)
+}
+
+// Helper functions
+
+func (e *Estimator) isValidNodeType(nodeType string) bool {
+	if nodeType == "" {
+		return false
+	}
+
+	// Basic validation: should start with "cache." and have proper format
+	if !strings.HasPrefix(nodeType, "cache.") {
+		return false
+	}
+
+	// Check for valid node family patterns
+	validFamilies := []string{"cache.t3", "cache.t4g", "cache.m5", "cache.m6g", "cache.m7g",
+		"cache.r5", "cache.r6g", "cache.r6gd", "cache.r7g", "cache.c7gn"}
+	for _, family := range validFamilies {
+		if strings.HasPrefix(nodeType, family+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Estimator) isNodeUsage(usageType string) bool {
+	// Node usage types look like "USE1-NodeUsage:cache.r6g.large"
+	return strings.Contains(usageType, "NodeUsage")
+}
+
+func (e *Estimator) isDataStoredUsage(usageType string) bool {
+	// Serverless data stored usage types look like "USE1-ElastiCache:ServerlessRedis-CachedData"
+	return strings.Contains(usageType, "CachedData")
+}
+
+func (e *Estimator) isECPUUsage(usageType string) bool {
+	// Serverless ECPU usage types look like "USE1-ElastiCache:ServerlessRedis-ECPU"
+	return strings.Contains(usageType, "ECPU")
+}
+
+func (e *Estimator) isBackupUsage(usageType string) bool {
+	// Backup usage types look like "USE1-BackupUsage"
+	return strings.Contains(usageType, "BackupUsage")
+}
+
+// GetSupportedNodeTypes returns common ElastiCache node types
+func (e *Estimator) GetSupportedNodeTypes() []string {
+	return []string{
+		// Burstable performance
+		"cache.t3.micro", "cache.t3.small", "cache.t3.medium",
+		"cache.t4g.micro", "cache.t4g.small", "cache.t4g.medium",
+
+		// General purpose
+		"cache.m6g.large", "cache.m6g.xlarge", "cache.m6g.2xlarge",
+		"cache.m7g.large", "cache.m7g.xlarge", "cache.m7g.2xlarge",
+
+		// Memory optimized
+		"cache.r6g.large", "cache.r6g.xlarge", "cache.r6g.2xlarge",
+		"cache.r7g.large", "cache.r7g.xlarge", "cache.r7g.2xlarge",
+	}
+}
+
+// GetSupportedEngines returns supported ElastiCache engines
+func (e *Estimator) GetSupportedEngines() []string {
+	return []string{EngineRedis, EngineValkey, EngineMemcached}
+}
+
+// GetEngineDescription returns description for cache engines
+func (e *Estimator) GetEngineDescription(engine string) string {
+	descriptions := map[string]string{
+		EngineRedis:     "Redis OSS, with shards, replicas and backups",
+		EngineValkey:    "Valkey, the Redis OSS-compatible engine at a lower price",
+		EngineMemcached: "Memcached, with nodes partitioning the keys; node-based clusters have no replicas or backups",
+	}
+	if desc, exists := descriptions[engine]; exists {
+		return desc
+	}
+	return "Unknown cache engine"
+}
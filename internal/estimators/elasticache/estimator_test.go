@@ -0,0 +1,240 @@
+package elasticache
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient returns the products of the product family in the filters
+type MockAWSClient struct {
+	products      map[string][]interfaces.PricingProduct // Products by product family
+	shouldFailGet bool
+	filters       []map[string]string
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	m.filters = append(m.filters, filters)
+	return m.products[filters["productFamily"]], nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func testClient() *MockAWSClient {
+	return &MockAWSClient{
+		products: map[string][]interfaces.PricingProduct{
+			"Cache Instance": {
+				awstest.FlatProduct("R6GLARGE", "USE1-NodeUsage:cache.r6g.large", "0.206"),
+			},
+			"ElastiCache Serverless": {
+				awstest.FlatProduct("CACHEDDATA", "USE1-ElastiCache:ServerlessValkey-CachedData", "0.084"),
+				awstest.FlatProduct("ECPU", "USE1-ElastiCache:ServerlessValkey-ECPU", "0.0000000023"),
+			},
+			"Storage Snapshot": {
+				awstest.FlatProduct("BACKUP", "USE1-BackupUsage", "0.085"),
+			},
+		},
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "ElastiCache" {
+		t.Errorf("expected resource type 'ElastiCache', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid Redis cluster", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "shards": 3, "replicasPerShard": 2}},
+		{name: "valid Memcached cluster", properties: map[string]interface{}{"engine": "memcached", "nodeType": "cache.t4g.micro", "shards": 2}},
+		{name: "valid serverless cache", properties: map[string]interface{}{"engine": "valkey", "deploymentType": "Serverless", "dataStoredGB": 5, "ecpusPerMonth": 1000000}},
+		{name: "valid backups", properties: map[string]interface{}{"engine": "valkey", "nodeType": "cache.m7g.large", "backupRetentionDays": 7, "snapshotSizeGB": 10}},
+		{name: "wrong resource type", resourceType: "RDS", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large"}, expectError: true},
+		{name: "missing engine", properties: map[string]interface{}{"nodeType": "cache.r6g.large"}, expectError: true},
+		{name: "invalid engine", properties: map[string]interface{}{"engine": "mysql", "nodeType": "cache.r6g.large"}, expectError: true},
+		{name: "invalid deployment type", properties: map[string]interface{}{"engine": "redis", "deploymentType": "Managed"}, expectError: true},
+		{name: "missing node type", properties: map[string]interface{}{"engine": "redis"}, expectError: true},
+		{name: "RDS instance class as node type", properties: map[string]interface{}{"engine": "redis", "nodeType": "db.r6g.large"}, expectError: true},
+		{name: "unknown node family", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.x9.large"}, expectError: true},
+		{name: "zero shards", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "shards": 0}, expectError: true},
+		{name: "too many replicas", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "replicasPerShard": 6}, expectError: true},
+		{name: "Memcached replicas", properties: map[string]interface{}{"engine": "memcached", "nodeType": "cache.r6g.large", "replicasPerShard": 1}, expectError: true},
+		{name: "Memcached cluster backups", properties: map[string]interface{}{"engine": "memcached", "nodeType": "cache.r6g.large", "backupRetentionDays": 1, "snapshotSizeGB": 1}, expectError: true},
+		{name: "cluster backups without snapshot size", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "backupRetentionDays": 7}, expectError: true},
+		{name: "retention too long", properties: map[string]interface{}{"engine": "valkey", "deploymentType": "Serverless", "backupRetentionDays": 36}, expectError: true},
+		{name: "node type on serverless cache", properties: map[string]interface{}{"engine": "redis", "deploymentType": "Serverless", "nodeType": "cache.r6g.large"}, expectError: true},
+		{name: "serverless usage on cluster", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "ecpusPerMonth": 100}, expectError: true},
+		{name: "negative data stored", properties: map[string]interface{}{"engine": "redis", "deploymentType": "Serverless", "dataStoredGB": -1}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "ElastiCache", Name: "cache", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedDetails map[string]string
+		expectedEngine  string
+	}{
+		{
+			// 3 shards × 2 nodes × 730 hours × 0.206, and 6 of 7 daily 10 GB snapshots at 0.085
+			name: "Redis cluster with replicas and backups",
+			properties: map[string]interface{}{
+				"engine": "redis", "nodeType": "cache.r6g.large", "shards": 3, "replicasPerShard": 1,
+				"backupRetentionDays": 7, "snapshotSizeGB": 10,
+			},
+			expectedMonthly: "907.3800",
+			expectedDetails: map[string]string{
+				"nodes":                 "6",
+				"hourlyPrice":           "USD 0.2060/hour",
+				"monthlyNodeCost":       "USD 902.2800",
+				"billedBackupStorageGB": "60",
+				"monthlyBackupCost":     "USD 5.1000",
+			},
+			expectedEngine: "Redis",
+		},
+		{
+			name:            "Memcached nodes",
+			properties:      map[string]interface{}{"engine": "memcached", "nodeType": "cache.r6g.large", "shards": 2},
+			expectedMonthly: "300.7600",
+			expectedDetails: map[string]string{
+				"nodes":             "2",
+				"replicasPerShard":  "0",
+				"monthlyBackupCost": "USD 0.0000",
+			},
+			expectedEngine: "Memcached",
+		},
+		{
+			// 10 GB × 730 hours × 0.084, 1B ECPUs at 0.0000000023, and 2 of 3 daily 10 GB snapshots
+			name: "serverless cache with backups",
+			properties: map[string]interface{}{
+				"engine": "valkey", "deploymentType": "Serverless", "dataStoredGB": 10, "ecpusPerMonth": 1000000000,
+				"backupRetentionDays": 3,
+			},
+			expectedMonthly: "617.2000",
+			expectedDetails: map[string]string{
+				"deploymentType":        "Serverless",
+				"monthlyDataStoredCost": "USD 613.2000",
+				"monthlyECPUCost":       "USD 2.3000",
+				"billedBackupStorageGB": "20",
+				"monthlyBackupCost":     "USD 1.7000",
+			},
+			expectedEngine: "Valkey",
+		},
+		{
+			// Billed for the 1 GB minimum
+			name:            "empty serverless cache",
+			properties:      map[string]interface{}{"engine": "redis", "deploymentType": "Serverless"},
+			expectedMonthly: "61.3200",
+			expectedDetails: map[string]string{
+				"dataStoredGB":          "0",
+				"monthlyDataStoredCost": "USD 61.3200",
+			},
+			expectedEngine: "Redis",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testClient()
+			estimator := NewEstimator(client)
+			resource := models.ResourceSpec{Type: "ElastiCache", Name: "cache", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+			if engine := client.filters[0]["cacheEngine"]; engine != tt.expectedEngine {
+				t.Errorf("expected cacheEngine filter %q, got %q", tt.expectedEngine, engine)
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	noBackups := testClient()
+	delete(noBackups.products, "Storage Snapshot")
+	noECPU := testClient()
+	noECPU.products["ElastiCache Serverless"] = noECPU.products["ElastiCache Serverless"][:1]
+
+	cluster := map[string]interface{}{"engine": "redis", "nodeType": "cache.r6g.large", "backupRetentionDays": 2, "snapshotSizeGB": 5}
+	serverless := map[string]interface{}{"engine": "valkey", "deploymentType": "Serverless", "dataStoredGB": 5}
+
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: cluster},
+		{name: "no node pricing", client: &MockAWSClient{}, properties: cluster},
+		{name: "no backup pricing", client: noBackups, properties: cluster},
+		{name: "no ECPU pricing", client: noECPU, properties: serverless},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "ElastiCache", Name: "cache", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
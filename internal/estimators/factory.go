@@ -4,10 +4,19 @@
 //
 // The factory supports the following AWS services:
 //   - EC2: Elastic Compute Cloud instances
+//   - EBS: Elastic Block Store volumes and snapshots
 //   - ALB: Application Load Balancers
+//   - APIGateway: REST, HTTP and WebSocket APIs
 //   - RDS: Relational Database Service
 //   - Lambda: Serverless functions
 //   - S3: Simple Storage Service
+//   - DynamoDB: On-demand and provisioned tables
+//   - CloudFront: Content delivery distributions
+//   - ElastiCache: Node-based clusters and serverless caches
+//   - Fargate: ECS tasks on AWS Fargate
+//   - EKS: Kubernetes cluster control planes
+//   - NATGateway: NAT gateways
+//   - DataTransfer: Internet, inter-region, inter-AZ and CloudFront origin data transfer
 //
 // Usage:
 //
@@ -19,29 +28,42 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"shylock/internal/errors"
 	"shylock/internal/estimators/alb"
+	"shylock/internal/estimators/apigateway"
+	"shylock/internal/estimators/cloudfront"
+	"shylock/internal/estimators/datatransfer"
+	"shylock/internal/estimators/dynamodb"
+	"shylock/internal/estimators/ebs"
 	"shylock/internal/estimators/ec2"
+	"shylock/internal/estimators/eks"
+	"shylock/internal/estimators/elasticache"
+	"shylock/internal/estimators/fargate"
 	"shylock/internal/estimators/lambda"
+	"shylock/internal/estimators/natgateway"
 	"shylock/internal/estimators/rds"
 	"shylock/internal/estimators/s3"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Factory creates and manages resource estimators for different AWS services.
 // It implements the factory pattern to provide a unified interface for cost
 // estimation across multiple AWS resource types.
 type Factory struct {
-	estimators map[string]interfaces.ResourceEstimator // Map of resource type to estimator
-	awsClient  interfaces.AWSPricingClient             // AWS Pricing API client
+	estimators    map[string]interfaces.ResourceEstimator // Map of resource type to estimator
+	awsClient     interfaces.AWSPricingClient             // AWS Pricing API client
+	exchangeRates *money.ExchangeRates                    // Rates for converting costs from USD
 }
 
 // NewFactory creates a new estimator factory with all supported AWS service
 // estimators pre-registered. The factory automatically registers estimators
-// for EC2, ALB, RDS, Lambda, and S3 services.
+// for EC2, EBS, ALB, RDS, Lambda, S3, DynamoDB, CloudFront, ElastiCache,
+// Fargate, EKS, API Gateway, NAT gateway and data transfer services.
 //
 // Parameters:
 //   - awsClient: AWS Pricing API client for retrieving pricing data
@@ -56,14 +78,41 @@ func NewFactory(awsClient interfaces.AWSPricingClient) *Factory {
 
 	// Register built-in estimators
 	factory.RegisterEstimator("ALB", alb.NewEstimator(awsClient))
+	factory.RegisterEstimator("APIGateway", apigateway.NewEstimator(awsClient))
+	factory.RegisterEstimator("CloudFront", cloudfront.NewEstimator(awsClient))
+	factory.RegisterEstimator("DataTransfer", datatransfer.NewEstimator(awsClient))
+	factory.RegisterEstimator("DynamoDB", dynamodb.NewEstimator(awsClient))
+	factory.RegisterEstimator("EBS", ebs.NewEstimator(awsClient))
 	factory.RegisterEstimator("EC2", ec2.NewEstimator(awsClient))
+	factory.RegisterEstimator("EKS", eks.NewEstimator(awsClient))
+	factory.RegisterEstimator("ElastiCache", elasticache.NewEstimator(awsClient))
+	factory.RegisterEstimator("Fargate", fargate.NewEstimator(awsClient))
 	factory.RegisterEstimator("Lambda", lambda.NewEstimator(awsClient))
+	factory.RegisterEstimator("NATGateway", natgateway.NewEstimator(awsClient))
 	factory.RegisterEstimator("RDS", rds.NewEstimator(awsClient))
 	factory.RegisterEstimator("S3", s3.NewEstimator(awsClient))
 
 	return factory
 }
 
+// SetSpotPriceSource enables EC2 spot pricing from the given spot price history
+func (f *Factory) SetSpotPriceSource(source interfaces.SpotPriceSource) {
+	if ec2Estimator, ok := f.estimators["EC2"].(*ec2.Estimator); ok {
+		ec2Estimator.SetSpotPriceSource(source)
+	}
+}
+
+// SetExchangeRates sets the exchange rates used to convert costs into the
+// currency selected in the configuration options
+func (f *Factory) SetExchangeRates(rates *money.ExchangeRates) {
+	f.exchangeRates = rates
+}
+
+// ExchangeRates returns the exchange rates set with SetExchangeRates, if any
+func (f *Factory) ExchangeRates() *money.ExchangeRates {
+	return f.exchangeRates
+}
+
 // RegisterEstimator registers a resource estimator for a specific resource type
 func (f *Factory) RegisterEstimator(resourceType string, estimator interfaces.ResourceEstimator) error {
 	if resourceType == "" {
@@ -152,60 +201,63 @@ func (f *Factory) EstimateFromConfig(ctx context.Context, config *models.Estimat
 
 	result := &models.EstimationResult{
 		ResourceCosts: make([]models.CostEstimate, 0, len(config.Resources)),
-		Currency:      "USD", // Default currency
+		Currency:      money.USD, // Converted by ApplyCurrency once totals are known
 		GeneratedAt:   time.Now(),
 	}
 
-	// Apply configuration options
-	if config.Options.Currency != "" {
-		result.Currency = config.Options.Currency
-	}
-
-	var totalHourly, totalDaily, totalMonthly float64
-	var estimationErrors []error
+	estimationErrors := make([]error, len(config.Resources))
 
 	// Estimate cost for each resource
 	for i, resource := range config.Resources {
 		estimate, err := f.EstimateResource(ctx, resource)
 		if err != nil {
-			// Collect errors but continue with other resources
-			wrappedErr := errors.WrapError(err, "", fmt.Sprintf("failed to estimate resource %d", i+1)).
+			// Collect errors but continue with other resources so all failures are reported
+			estimationErrors[i] = errors.WrapError(err, "", fmt.Sprintf("failed to estimate resource %d", i+1)).
 				WithContext("resourceIndex", i).
 				WithContext("resourceName", resource.Name).
 				WithContext("resourceType", resource.Type)
-			estimationErrors = append(estimationErrors, wrappedErr)
 			continue
 		}
 
 		// Add to results
 		result.ResourceCosts = append(result.ResourceCosts, *estimate)
+	}
 
-		// Accumulate totals
-		totalHourly += estimate.HourlyCost
-		totalDaily += estimate.DailyCost
-		totalMonthly += estimate.MonthlyCost
+	if err := FailedResourcesError(config.Resources, estimationErrors); err != nil {
+		return nil, err
 	}
 
-	// Check if we have any successful estimates
-	if len(result.ResourceCosts) == 0 {
-		if len(estimationErrors) > 0 {
-			// Return the first error if no estimates succeeded
-			return nil, estimationErrors[0]
-		}
-		return nil, errors.ValidationError("no resources could be estimated").
-			WithSuggestion("Check that all resources have valid configurations")
+	if err := Finalize(config.Options, f.exchangeRates, result); err != nil {
+		return nil, err
 	}
 
-	// Set totals
-	result.TotalHourlyCost = totalHourly
-	result.TotalDailyCost = totalDaily
-	result.TotalMonthlyCost = totalMonthly
+	return result, nil
+}
 
-	// If there were some errors but also some successes, we could optionally
-	// include error information in the result or log warnings
-	// For now, we'll return the partial results
+// FailedResourcesError reports the resources that could not be estimated, or
+// returns nil when every resource was. errs holds the error for each resource
+// in order, nil for resources that were estimated. Totals that leave out a
+// resource understate costs and would let a budget check or a diff pass, so
+// estimation fails when any resource fails.
+func FailedResourcesError(resources []models.ResourceSpec, errs []error) error {
+	var first error
+	var failed []string
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if first == nil {
+			first = err
+		}
+		failed = append(failed, resources[i].Name)
+	}
+	if first == nil {
+		return nil
+	}
 
-	return result, nil
+	return errors.WrapError(first, "", fmt.Sprintf("failed to estimate %d of %d resources", len(failed), len(resources))).
+		WithContext("failedResources", strings.Join(failed, ", ")).
+		WithSuggestion("Fix or remove the resources that failed; no totals are reported while any resource is missing")
 }
 
 // ValidateResource validates a resource using the appropriate estimator
@@ -269,11 +321,105 @@ func (f *Factory) GetEstimatorInfo(resourceType string) (map[string]interface{},
 			}
 			info["albTypeDescriptions"] = albTypeInfo
 		}
+	case "APIGateway":
+		if apiGatewayEstimator, ok := estimator.(*apigateway.Estimator); ok {
+			info["supportedAPITypes"] = apiGatewayEstimator.GetSupportedAPITypes()
+			info["supportedCacheSizes"] = apiGatewayEstimator.GetSupportedCacheSizes()
+
+			// Add API type descriptions
+			apiTypeInfo := make(map[string]string)
+			for _, apiType := range apiGatewayEstimator.GetSupportedAPITypes() {
+				apiTypeInfo[apiType] = apiGatewayEstimator.GetAPITypeDescription(apiType)
+			}
+			info["apiTypeDescriptions"] = apiTypeInfo
+		}
+	case "CloudFront":
+		if cloudFrontEstimator, ok := estimator.(*cloudfront.Estimator); ok {
+			info["supportedPriceClasses"] = cloudFrontEstimator.GetSupportedPriceClasses()
+			info["supportedGeographies"] = cloudFrontEstimator.GetSupportedGeographies()
+
+			// Add price class descriptions
+			priceClassInfo := make(map[string]string)
+			for _, priceClass := range cloudFrontEstimator.GetSupportedPriceClasses() {
+				priceClassInfo[priceClass] = cloudFrontEstimator.GetPriceClassDescription(priceClass)
+			}
+			info["priceClassDescriptions"] = priceClassInfo
+		}
+	case "DataTransfer":
+		if dataTransferEstimator, ok := estimator.(*datatransfer.Estimator); ok {
+			info["supportedTransferTypes"] = dataTransferEstimator.GetSupportedTransferTypes()
+
+			// Add transfer type descriptions
+			transferTypeInfo := make(map[string]string)
+			for _, transferType := range dataTransferEstimator.GetSupportedTransferTypes() {
+				transferTypeInfo[transferType] = dataTransferEstimator.GetTransferTypeDescription(transferType)
+			}
+			info["transferTypeDescriptions"] = transferTypeInfo
+		}
+	case "DynamoDB":
+		if dynamoDBEstimator, ok := estimator.(*dynamodb.Estimator); ok {
+			info["supportedCapacityModes"] = dynamoDBEstimator.GetSupportedCapacityModes()
+			info["supportedTableClasses"] = dynamoDBEstimator.GetSupportedTableClasses()
+
+			// Add table class descriptions
+			tableClassInfo := make(map[string]string)
+			for _, tableClass := range dynamoDBEstimator.GetSupportedTableClasses() {
+				tableClassInfo[tableClass] = dynamoDBEstimator.GetTableClassDescription(tableClass)
+			}
+			info["tableClassDescriptions"] = tableClassInfo
+		}
+	case "EBS":
+		if ebsEstimator, ok := estimator.(*ebs.Estimator); ok {
+			info["supportedVolumeTypes"] = ebsEstimator.GetSupportedVolumeTypes()
+
+			// Add volume type descriptions
+			volumeTypeInfo := make(map[string]string)
+			for _, volumeType := range ebsEstimator.GetSupportedVolumeTypes() {
+				volumeTypeInfo[volumeType] = ebsEstimator.GetVolumeTypeDescription(volumeType)
+			}
+			info["volumeTypeDescriptions"] = volumeTypeInfo
+		}
 	case "EC2":
 		if ec2Estimator, ok := estimator.(*ec2.Estimator); ok {
 			info["supportedInstanceFamilies"] = ec2Estimator.GetSupportedInstanceFamilies()
 			info["commonInstanceTypes"] = ec2Estimator.GetCommonInstanceTypes()
 		}
+	case "EKS":
+		if eksEstimator, ok := estimator.(*eks.Estimator); ok {
+			info["supportedSupportTypes"] = eksEstimator.GetSupportedSupportTypes()
+
+			// Add support type descriptions
+			supportInfo := make(map[string]string)
+			for _, supportType := range eksEstimator.GetSupportedSupportTypes() {
+				supportInfo[supportType] = eksEstimator.GetSupportTypeDescription(supportType)
+			}
+			info["supportTypeDescriptions"] = supportInfo
+		}
+	case "ElastiCache":
+		if elastiCacheEstimator, ok := estimator.(*elasticache.Estimator); ok {
+			info["supportedNodeTypes"] = elastiCacheEstimator.GetSupportedNodeTypes()
+			info["supportedEngines"] = elastiCacheEstimator.GetSupportedEngines()
+
+			// Add engine descriptions
+			engineInfo := make(map[string]string)
+			for _, engine := range elastiCacheEstimator.GetSupportedEngines() {
+				engineInfo[engine] = elastiCacheEstimator.GetEngineDescription(engine)
+			}
+			info["engineDescriptions"] = engineInfo
+		}
+	case "Fargate":
+		if fargateEstimator, ok := estimator.(*fargate.Estimator); ok {
+			info["supportedVCPUs"] = fargateEstimator.GetSupportedVCPUs()
+			info["supportedOperatingSystems"] = fargateEstimator.GetSupportedOperatingSystems()
+			info["supportedArchitectures"] = fargateEstimator.GetSupportedArchitectures()
+
+			// Add architecture descriptions
+			archInfo := make(map[string]string)
+			for _, arch := range fargateEstimator.GetSupportedArchitectures() {
+				archInfo[arch] = fargateEstimator.GetArchitectureDescription(arch)
+			}
+			info["architectureDescriptions"] = archInfo
+		}
 	case "Lambda":
 		if lambdaEstimator, ok := estimator.(*lambda.Estimator); ok {
 			info["supportedMemorySizes"] = lambdaEstimator.GetSupportedMemorySizes()
@@ -2,12 +2,14 @@ package estimators
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // MockEstimator for testing
@@ -47,9 +49,9 @@ func (m *MockEstimator) EstimateCost(ctx context.Context, resource models.Resour
 		ResourceName: resource.Name,
 		ResourceType: resource.Type,
 		Region:       resource.Region,
-		HourlyCost:   1.0,
-		DailyCost:    24.0,
-		MonthlyCost:  720.0,
+		HourlyCost:   money.NewAmount(1.0),
+		DailyCost:    money.NewAmount(24.0),
+		MonthlyCost:  money.NewAmount(720.0),
 		Currency:     "USD",
 		Timestamp:    time.Now(),
 	}, nil
@@ -80,7 +82,7 @@ func TestNewFactory(t *testing.T) {
 
 	// Check that built-in estimators are registered
 	supportedTypes := factory.GetSupportedResourceTypes()
-	expectedTypes := []string{"ALB", "EC2", "Lambda", "RDS", "S3"}
+	expectedTypes := []string{"ALB", "APIGateway", "CloudFront", "DataTransfer", "DynamoDB", "EBS", "EC2", "EKS", "ElastiCache", "Fargate", "Lambda", "NATGateway", "RDS", "S3"}
 
 	if len(supportedTypes) != len(expectedTypes) {
 		t.Errorf("expected %d supported types, got %d", len(expectedTypes), len(supportedTypes))
@@ -186,7 +188,7 @@ func TestGetEstimator(t *testing.T) {
 		},
 		{
 			name:         "non-existing estimator",
-			resourceType: "DynamoDB",
+			resourceType: "Redshift",
 			expectError:  true,
 			errorType:    errors.ValidationErrorType,
 		},
@@ -307,18 +309,18 @@ func TestEstimateFromConfig(t *testing.T) {
 	mockEstimator1 := &MockEstimator{
 		resourceType: "TEST1",
 		costEstimate: &models.CostEstimate{
-			HourlyCost:  1.0,
-			DailyCost:   24.0,
-			MonthlyCost: 720.0,
+			HourlyCost:  money.NewAmount(1.0),
+			DailyCost:   money.NewAmount(24.0),
+			MonthlyCost: money.NewAmount(720.0),
 			Currency:    "USD",
 		},
 	}
 	mockEstimator2 := &MockEstimator{
 		resourceType: "TEST2",
 		costEstimate: &models.CostEstimate{
-			HourlyCost:  2.0,
-			DailyCost:   48.0,
-			MonthlyCost: 1440.0,
+			HourlyCost:  money.NewAmount(2.0),
+			DailyCost:   money.NewAmount(48.0),
+			MonthlyCost: money.NewAmount(1440.0),
 			Currency:    "USD",
 		},
 	}
@@ -382,10 +384,8 @@ func TestEstimateFromConfig(t *testing.T) {
 				mockEstimator1.shouldFailCost = false
 				mockEstimator2.shouldFailCost = true // This one fails
 			},
-			expectError:     false, // Should succeed with partial results
-			expectedHourly:  1.0,   // Only first resource
-			expectedDaily:   24.0,
-			expectedMonthly: 720.0,
+			expectError: true, // Partial totals would understate costs
+			errorType:   errors.APIErrorType,
 		},
 	}
 
@@ -415,14 +415,14 @@ func TestEstimateFromConfig(t *testing.T) {
 
 				// Check totals
 				tolerance := 0.0001
-				if abs(result.TotalHourlyCost-tt.expectedHourly) > tolerance {
-					t.Errorf("expected hourly cost %.2f, got %.2f", tt.expectedHourly, result.TotalHourlyCost)
+				if abs(result.TotalHourlyCost.Float64()-tt.expectedHourly) > tolerance {
+					t.Errorf("expected hourly cost %.2f, got %s", tt.expectedHourly, result.TotalHourlyCost)
 				}
-				if abs(result.TotalDailyCost-tt.expectedDaily) > tolerance {
-					t.Errorf("expected daily cost %.2f, got %.2f", tt.expectedDaily, result.TotalDailyCost)
+				if abs(result.TotalDailyCost.Float64()-tt.expectedDaily) > tolerance {
+					t.Errorf("expected daily cost %.2f, got %s", tt.expectedDaily, result.TotalDailyCost)
 				}
-				if abs(result.TotalMonthlyCost-tt.expectedMonthly) > tolerance {
-					t.Errorf("expected monthly cost %.2f, got %.2f", tt.expectedMonthly, result.TotalMonthlyCost)
+				if abs(result.TotalMonthlyCost.Float64()-tt.expectedMonthly) > tolerance {
+					t.Errorf("expected monthly cost %.2f, got %s", tt.expectedMonthly, result.TotalMonthlyCost)
 				}
 
 				// Check metadata
@@ -437,6 +437,29 @@ func TestEstimateFromConfig(t *testing.T) {
 	}
 }
 
+func TestFailedResourcesError(t *testing.T) {
+	resources := []models.ResourceSpec{{Name: "web"}, {Name: "db"}, {Name: "cache"}}
+
+	if err := FailedResourcesError(resources, make([]error, len(resources))); err != nil {
+		t.Errorf("expected no error when every resource was estimated, got %v", err)
+	}
+
+	err := FailedResourcesError(resources, []error{
+		nil,
+		errors.APIError("no pricing for db.x9.large"),
+		errors.ValidationError("unsupported resource type"),
+	})
+	if !errors.IsErrorType(err, errors.APIErrorType) {
+		t.Fatalf("expected the first failure's API error type, got %v", err)
+	}
+	message := err.Error()
+	for _, expected := range []string{"failed to estimate 2 of 3 resources", "failedResources=db, cache", "no pricing for db.x9.large"} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("expected error to contain %q, got %q", expected, message)
+		}
+	}
+}
+
 func TestValidateResource(t *testing.T) {
 	mockClient := &MockAWSClient{}
 	factory := NewFactory(mockClient)
@@ -612,7 +635,7 @@ func TestGetEstimatorInfo(t *testing.T) {
 		},
 		{
 			name:         "unsupported estimator",
-			resourceType: "DynamoDB",
+			resourceType: "Redshift",
 			expectError:  true,
 		},
 	}
@@ -0,0 +1,468 @@
+package fargate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Operating systems
+const (
+	OSLinux   = "Linux"
+	OSWindows = "Windows"
+)
+
+// CPU architectures
+const (
+	ArchX86 = "x86_64"
+	ArchARM = "arm64"
+)
+
+// Ephemeral storage limits, in GB. Every task gets the included storage for free.
+const (
+	includedEphemeralStorageGB = 20
+	maxEphemeralStorageGB      = 200
+)
+
+// memoryRange is the memory, in GB, a task with a given vCPU count can have:
+// from min to max in step increments, or one of the listed sizes
+type memoryRange struct {
+	min, max, step float64
+	sizes          []float64
+}
+
+// taskSizes maps the supported vCPU counts to their memory ranges
+var taskSizes = map[float64]memoryRange{
+	0.25: {sizes: []float64{0.5, 1, 2}},
+	0.5:  {min: 1, max: 4, step: 1},
+	1:    {min: 2, max: 8, step: 1},
+	2:    {min: 4, max: 16, step: 1},
+	4:    {min: 8, max: 30, step: 1},
+	8:    {min: 16, max: 60, step: 4},
+	16:   {min: 32, max: 120, step: 8},
+}
+
+// minWindowsVCPU is the smallest task size Windows containers support
+const minWindowsVCPU = 1
+
+// regionPrefix matches the region code that prefixes usage types, e.g.
+// "USE1-" in "USE1-Fargate-vCPU-Hours:perCPU"
+var regionPrefix = regexp.MustCompile(`^[A-Z]+[0-9]+-`)
+
+// Usage types of the Fargate components, without the region prefix
+const (
+	usageWindowsLicense   = "Fargate-Windows-OS-Hours:perCPU"
+	usageEphemeralStorage = "Fargate-EphemeralStorage-GB-Hours"
+	usageSpotVCPU         = "SpotUsage-Fargate-vCPU-Hours:perCPU"
+	usageSpotMemory       = "SpotUsage-Fargate-GB-Hours"
+)
+
+// Estimator implements the ResourceEstimator interface for ECS tasks on AWS Fargate
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new Fargate cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "Fargate"
+}
+
+// ValidateResource validates that the resource specification is valid for Fargate
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "Fargate" {
+		return errors.ValidationError("resource type must be 'Fargate'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'Fargate' as the resource type")
+	}
+
+	// Validate required properties
+	requiredProps := []string{"vcpu", "memoryGB"}
+	for _, prop := range requiredProps {
+		if _, exists := resource.GetProperty(prop); !exists {
+			return errors.ValidationError(fmt.Sprintf("missing required property '%s'", prop)).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion(fmt.Sprintf("Add '%s' property to the resource configuration", prop))
+		}
+	}
+
+	operatingSystem, architecture, err := e.platformFromResource(resource)
+	if err != nil {
+		return err
+	}
+
+	// Validate task size
+	vcpu, err := resource.GetFloatProperty("vcpu")
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid vcpu property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Ensure vcpu is a number (e.g., 0.25, 1, 4)")
+	}
+	memoryGB, err := resource.GetFloatProperty("memoryGB")
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid memoryGB property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Ensure memoryGB is a number (e.g., 0.5, 2, 8)")
+	}
+	memory, exists := taskSizes[vcpu]
+	if !exists {
+		return errors.ValidationError("invalid Fargate vCPU count").
+			WithContext("resourceName", resource.Name).
+			WithContext("vcpu", vcpu).
+			WithSuggestion("Use 0.25, 0.5, 1, 2, 4, 8 or 16 vCPU")
+	}
+	if !memory.allows(memoryGB) {
+		return errors.ValidationError("invalid memory for the Fargate vCPU count").
+			WithContext("resourceName", resource.Name).
+			WithContext("vcpu", vcpu).
+			WithContext("memoryGB", memoryGB).
+			WithSuggestion(fmt.Sprintf("Tasks with %s vCPU support %s", formatNumber(vcpu), memory))
+	}
+	if operatingSystem == OSWindows && vcpu < minWindowsVCPU {
+		return errors.ValidationError("Windows tasks need at least 1 vCPU").
+			WithContext("resourceName", resource.Name).
+			WithContext("vcpu", vcpu)
+	}
+
+	// Validate optional numeric properties
+	numericProps := []string{"tasks", "ephemeralStorageGB", "spotPercentage"}
+	for _, prop := range numericProps {
+		if _, exists := resource.GetProperty(prop); exists {
+			value, err := resource.GetIntProperty(prop)
+			if err != nil {
+				return errors.ValidationErrorWithCause(fmt.Sprintf("invalid %s property", prop), err).
+					WithContext("resourceName", resource.Name).
+					WithSuggestion(fmt.Sprintf("Ensure %s is a non-negative integer", prop))
+			}
+			if value < 0 {
+				return errors.ValidationError(fmt.Sprintf("%s cannot be negative", prop)).
+					WithContext("resourceName", resource.Name).
+					WithContext(prop, value).
+					WithSuggestion(fmt.Sprintf("Set %s to a non-negative integer", prop))
+			}
+		}
+	}
+
+	if tasks, err := resource.GetIntProperty("tasks"); err == nil && tasks < 1 {
+		return errors.ValidationError("tasks must be at least 1").
+			WithContext("resourceName", resource.Name).
+			WithContext("tasks", tasks)
+	}
+
+	if storage, err := resource.GetIntProperty("ephemeralStorageGB"); err == nil &&
+		(storage < includedEphemeralStorageGB || storage > maxEphemeralStorageGB) {
+		return errors.ValidationError(fmt.Sprintf("ephemeralStorageGB must be between %d and %d",
+			includedEphemeralStorageGB, maxEphemeralStorageGB)).
+			WithContext("resourceName", resource.Name).
+			WithContext("ephemeralStorageGB", storage)
+	}
+
+	if spot, err := resource.GetIntProperty("spotPercentage"); err == nil {
+		if spot > 100 {
+			return errors.ValidationError("spotPercentage cannot exceed 100").
+				WithContext("resourceName", resource.Name).
+				WithContext("spotPercentage", spot)
+		}
+		if spot > 0 && (operatingSystem != OSLinux || architecture != ArchX86) {
+			return errors.ValidationError("Fargate Spot only runs Linux tasks on x86_64").
+				WithContext("resourceName", resource.Name).
+				WithContext("operatingSystem", operatingSystem).
+				WithContext("architecture", architecture).
+				WithSuggestion("Remove spotPercentage or use Linux tasks on x86_64")
+		}
+	}
+
+	// Validate usage schedule
+	if _, err := resource.GetSchedule(); err != nil {
+		return errors.ValidationErrorWithCause("invalid schedule property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(models.ScheduleSuggestion)
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for Fargate resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// platformFromResource returns the operating system and architecture of the
+// tasks, defaulting to Linux on x86_64
+func (e *Estimator) platformFromResource(resource models.ResourceSpec) (string, string, error) {
+	operatingSystem := OSLinux
+	if _, exists := resource.GetProperty("operatingSystem"); exists {
+		value, err := resource.GetStringProperty("operatingSystem")
+		if err != nil || (value != OSLinux && value != OSWindows) {
+			return "", "", errors.ValidationError("invalid Fargate operating system").
+				WithContext("resourceName", resource.Name).
+				WithContext("operatingSystem", resource.Properties["operatingSystem"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s'", OSLinux, OSWindows))
+		}
+		operatingSystem = value
+	}
+
+	architecture := ArchX86
+	if _, exists := resource.GetProperty("architecture"); exists {
+		value, err := resource.GetStringProperty("architecture")
+		if err != nil || (value != ArchX86 && value != ArchARM) {
+			return "", "", errors.ValidationError("invalid Fargate architecture").
+				WithContext("resourceName", resource.Name).
+				WithContext("architecture", resource.Properties["architecture"]).
+				WithSuggestion(fmt.Sprintf("Use '%s' or '%s' as architecture", ArchX86, ArchARM))
+		}
+		architecture = value
+	}
+
+	if operatingSystem == OSWindows && architecture != ArchX86 {
+		return "", "", errors.ValidationError("Windows tasks only run on x86_64").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Remove architecture or set it to 'x86_64'")
+	}
+
+	return operatingSystem, architecture, nil
+}
+
+// EstimateCost calculates the cost for a set of Fargate tasks
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	operatingSystem, architecture, _ := e.platformFromResource(resource)
+	vcpu, _ := resource.GetFloatProperty("vcpu")
+	memoryGB, _ := resource.GetFloatProperty("memoryGB")
+
+	tasks := 1 // Default single task
+	if t, err := resource.GetIntProperty("tasks"); err == nil {
+		tasks = t
+	}
+	ephemeralStorageGB := includedEphemeralStorageGB
+	if s, err := resource.GetIntProperty("ephemeralStorageGB"); err == nil {
+		ephemeralStorageGB = s
+	}
+	spotPercentage, _ := resource.GetIntProperty("spotPercentage")
+
+	// Task hours in the month, split between on-demand and Spot capacity
+	schedule, _ := resource.GetSchedule()
+	monthlyHours := float64(models.HoursPerMonth)
+	if schedule != nil {
+		monthlyHours = schedule.MonthlyHours()
+	}
+	taskHours := float64(tasks) * monthlyHours
+	spotHours := taskHours * float64(spotPercentage) / 100
+	onDemandHours := taskHours - spotHours
+
+	products, err := e.pricingService.GetFargatePricing(ctx, resource.Region)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve Fargate pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+	productsByUsage := make(map[string]interfaces.PricingProduct)
+	for _, product := range products {
+		productsByUsage[regionPrefix.ReplaceAllString(product.Attributes["usageType"], "")] = product
+	}
+
+	// Each component is priced per unit-hour
+	components := []struct {
+		name      string
+		usageType string
+		units     float64
+		hours     float64
+	}{
+		{"vCPU", computeUsageType(operatingSystem, architecture, "vCPU-Hours:perCPU"), vcpu, onDemandHours},
+		{"memory", computeUsageType(operatingSystem, architecture, "GB-Hours"), memoryGB, onDemandHours},
+		{"spotVCPU", usageSpotVCPU, vcpu, spotHours},
+		{"spotMemory", usageSpotMemory, memoryGB, spotHours},
+		{"windowsLicense", usageWindowsLicense, vcpu, taskHours},
+		{"ephemeralStorage", usageEphemeralStorage, float64(ephemeralStorageGB - includedEphemeralStorageGB), taskHours},
+	}
+
+	costs := make(map[string]money.Amount)
+	for _, c := range components {
+		if c.units == 0 || c.hours == 0 || (c.name == "windowsLicense" && operatingSystem != OSWindows) {
+			continue
+		}
+		product, exists := productsByUsage[c.usageType]
+		if !exists {
+			return nil, errors.APIError(fmt.Sprintf("no Fargate %s pricing found", c.name)).
+				WithContext("resourceName", resource.Name).
+				WithContext("region", resource.Region).
+				WithContext("usageType", c.usageType).
+				WithSuggestion("Check that the operating system and architecture are available on Fargate in the specified region")
+		}
+		price, err := e.pricingService.ExtractHourlyPrice(product)
+		if err != nil {
+			return nil, errors.WrapError(err, errors.APIErrorType, fmt.Sprintf("failed to extract Fargate %s price", c.name)).
+				WithContext("resourceName", resource.Name).
+				WithContext("sku", product.SKU)
+		}
+		costs[c.name] = price.MulFloat(c.units * c.hours)
+	}
+
+	onDemandCost := costs["vCPU"].Add(costs["memory"])
+	spotCost := costs["spotVCPU"].Add(costs["spotMemory"])
+	monthlyCost := money.Sum(onDemandCost, spotCost, costs["windowsLicense"], costs["ephemeralStorage"])
+
+	estimate := &models.CostEstimate{
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       monthlyCost.DivFloat(models.HoursPerMonth),
+		HourlyBilledCost: monthlyCost.DivFloat(models.HoursPerMonth), // Every Fargate charge is per task hour
+		Currency:         "USD",
+		Timestamp:        time.Now(),
+
+		// Compute Savings Plans cover on-demand vCPU and memory, not Spot or storage
+		SavingsPlanEligibleCost: onDemandCost.DivFloat(models.HoursPerMonth),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	if schedule != nil {
+		estimate.Schedule, estimate.ScheduledCost = schedule, estimate.HourlyBilledCost
+		estimate.AddAssumption(fmt.Sprintf("Tasks run on a schedule: %s", schedule))
+		estimate.SetDetail("schedule", schedule.String())
+	} else {
+		estimate.AddAssumption("24/7 task operation assumed")
+	}
+	if tasks > 1 {
+		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d tasks", tasks))
+	}
+	if spotPercentage > 0 {
+		estimate.AddAssumption(fmt.Sprintf("%d%% of task hours run on Fargate Spot at the current Spot price", spotPercentage))
+	}
+	if ephemeralStorageGB > includedEphemeralStorageGB {
+		estimate.AddAssumption(fmt.Sprintf("Ephemeral storage above the included %d GB is billed per GB-hour", includedEphemeralStorageGB))
+	}
+	estimate.AddAssumption("Data transfer and load balancers are billed separately")
+
+	// Add details
+	estimate.SetDetail("vcpu", formatNumber(vcpu))
+	estimate.SetDetail("memoryGB", formatNumber(memoryGB))
+	estimate.SetDetail("tasks", fmt.Sprintf("%d", tasks))
+	estimate.SetDetail("operatingSystem", operatingSystem)
+	estimate.SetDetail("architecture", architecture)
+	estimate.SetDetail("ephemeralStorageGB", fmt.Sprintf("%d", ephemeralStorageGB))
+	estimate.SetDetail("spotPercentage", fmt.Sprintf("%d", spotPercentage))
+	estimate.SetDetail("taskHours", formatNumber(taskHours))
+	estimate.SetDetail("monthlyOnDemandCost", fmt.Sprintf("USD %s", onDemandCost.StringFixed(4)))
+	if spotPercentage > 0 {
+		estimate.SetDetail("monthlySpotCost", fmt.Sprintf("USD %s", spotCost.StringFixed(4)))
+	}
+	if operatingSystem == OSWindows {
+		estimate.SetDetail("monthlyWindowsLicenseCost", fmt.Sprintf("USD %s", costs["windowsLicense"].StringFixed(4)))
+	}
+	if ephemeralStorageGB > includedEphemeralStorageGB {
+		estimate.SetDetail("monthlyEphemeralStorageCost", fmt.Sprintf("USD %s", costs["ephemeralStorage"].StringFixed(4)))
+	}
+
+	return estimate, nil
+}
+
+// computeUsageType returns the on-demand usage type of a compute dimension,
+// e.g. "Fargate-ARM-GB-Hours" for memory of ARM tasks
+func computeUsageType(operatingSystem, architecture, dimension string) string {
+	switch {
+	case operatingSystem == OSWindows:
+		return "Fargate-Windows-" + dimension
+	case architecture == ArchARM:
+		return "Fargate-ARM-" + dimension
+	default:
+		return "Fargate-" + dimension
+	}
+}
+
+// allows reports whether a task can have the memory size
+func (m memoryRange) allows(memoryGB float64) bool {
+	if len(m.sizes) > 0 {
+		for _, size := range m.sizes {
+			if size == memoryGB {
+				return true
+			}
+		}
+		return false
+	}
+	if memoryGB < m.min || memoryGB > m.max {
+		return false
+	}
+	steps := (memoryGB - m.min) / m.step
+	return steps == float64(int(steps))
+}
+
+// String describes the memory sizes, e.g. "16 to 60 GB in 4 GB increments"
+func (m memoryRange) String() string {
+	if len(m.sizes) > 0 {
+		sizes := ""
+		for i, size := range m.sizes {
+			if i > 0 {
+				sizes += ", "
+			}
+			sizes += formatNumber(size)
+		}
+		return sizes + " GB"
+	}
+	return fmt.Sprintf("%s to %s GB in %s GB increments", formatNumber(m.min), formatNumber(m.max), formatNumber(m.step))
+}
+
+// formatNumber formats a number without trailing zeros, e.g. "0.25"
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// GetSupportedVCPUs returns the supported vCPU counts, smallest first
+func (e *Estimator) GetSupportedVCPUs() []string {
+	vcpus := make([]float64, 0, len(taskSizes))
+	for vcpu := range taskSizes {
+		vcpus = append(vcpus, vcpu)
+	}
+	sort.Float64s(vcpus)
+
+	names := make([]string, len(vcpus))
+	for i, vcpu := range vcpus {
+		names[i] = formatNumber(vcpu)
+	}
+	return names
+}
+
+// GetSupportedOperatingSystems returns supported task operating systems
+func (e *Estimator) GetSupportedOperatingSystems() []string {
+	return []string{OSLinux, OSWindows}
+}
+
+// GetSupportedArchitectures returns supported task architectures
+func (e *Estimator) GetSupportedArchitectures() []string {
+	return []string{ArchX86, ArchARM}
+}
+
+// GetArchitectureDescription returns description for task architectures
+func (e *Estimator) GetArchitectureDescription(architecture string) string {
+	descriptions := map[string]string{
+		ArchX86: "Intel/AMD 64-bit architecture (default); the only architecture for Windows and Fargate Spot",
+		ArchARM: "ARM-based Graviton processors at a lower vCPU and memory price",
+	}
+	if desc, exists := descriptions[architecture]; exists {
+		return desc
+	}
+	return "Unknown architecture"
+}
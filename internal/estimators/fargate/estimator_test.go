@@ -0,0 +1,229 @@
+package fargate
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.FlatProduct("VCPU", "USE1-Fargate-vCPU-Hours:perCPU", "0.04"),
+		awstest.FlatProduct("MEMORY", "USE1-Fargate-GB-Hours", "0.004"),
+		awstest.FlatProduct("ARMVCPU", "USE1-Fargate-ARM-vCPU-Hours:perCPU", "0.032"),
+		awstest.FlatProduct("ARMMEMORY", "USE1-Fargate-ARM-GB-Hours", "0.0035"),
+		awstest.FlatProduct("WINVCPU", "USE1-Fargate-Windows-vCPU-Hours:perCPU", "0.09"),
+		awstest.FlatProduct("WINMEMORY", "USE1-Fargate-Windows-GB-Hours", "0.01"),
+		awstest.FlatProduct("WINLICENSE", "USE1-Fargate-Windows-OS-Hours:perCPU", "0.046"),
+		awstest.FlatProduct("STORAGE", "USE1-Fargate-EphemeralStorage-GB-Hours", "0.0001"),
+		awstest.FlatProduct("SPOTVCPU", "USE1-SpotUsage-Fargate-vCPU-Hours:perCPU", "0.012"),
+		awstest.FlatProduct("SPOTMEMORY", "USE1-SpotUsage-Fargate-GB-Hours", "0.0013"),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "Fargate" {
+		t.Errorf("expected resource type 'Fargate', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid task", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2}},
+		{name: "fractional task size", properties: map[string]interface{}{"vcpu": 0.25, "memoryGB": 0.5}},
+		{name: "large task in increments", properties: map[string]interface{}{"vcpu": 16, "memoryGB": 120, "tasks": 3}},
+		{name: "Spot share with storage", properties: map[string]interface{}{"vcpu": 2, "memoryGB": 4, "spotPercentage": 50, "ephemeralStorageGB": 100}},
+		{name: "Windows task", properties: map[string]interface{}{"vcpu": 4, "memoryGB": 8, "operatingSystem": "Windows"}},
+		{name: "ARM task on a schedule", properties: map[string]interface{}{"vcpu": 0.5, "memoryGB": 1, "architecture": "arm64", "schedule": map[string]interface{}{"hoursPerMonth": 200}}},
+		{name: "wrong resource type", resourceType: "EC2", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2}, expectError: true},
+		{name: "missing vcpu", properties: map[string]interface{}{"memoryGB": 2}, expectError: true},
+		{name: "missing memory", properties: map[string]interface{}{"vcpu": 1}, expectError: true},
+		{name: "unsupported vcpu", properties: map[string]interface{}{"vcpu": 3, "memoryGB": 8}, expectError: true},
+		{name: "memory too small for vcpu", properties: map[string]interface{}{"vcpu": 4, "memoryGB": 4}, expectError: true},
+		{name: "memory between increments", properties: map[string]interface{}{"vcpu": 8, "memoryGB": 18}, expectError: true},
+		{name: "memory not a listed size", properties: map[string]interface{}{"vcpu": 0.25, "memoryGB": 1.5}, expectError: true},
+		{name: "invalid operating system", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "operatingSystem": "macOS"}, expectError: true},
+		{name: "invalid architecture", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "architecture": "riscv"}, expectError: true},
+		{name: "Windows on ARM", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "operatingSystem": "Windows", "architecture": "arm64"}, expectError: true},
+		{name: "small Windows task", properties: map[string]interface{}{"vcpu": 0.5, "memoryGB": 1, "operatingSystem": "Windows"}, expectError: true},
+		{name: "zero tasks", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "tasks": 0}, expectError: true},
+		{name: "ephemeral storage below included", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "ephemeralStorageGB": 10}, expectError: true},
+		{name: "ephemeral storage too large", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "ephemeralStorageGB": 201}, expectError: true},
+		{name: "Spot share above 100", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "spotPercentage": 101}, expectError: true},
+		{name: "Spot on ARM", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "architecture": "arm64", "spotPercentage": 50}, expectError: true},
+		{name: "invalid schedule", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "schedule": "weekdays"}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "Fargate", Name: "service", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name             string
+		properties       map[string]interface{}
+		expectedMonthly  string
+		expectedEligible string // Monthly cost eligible for Savings Plans
+		expectedDetails  map[string]string
+	}{
+		{
+			// 2 tasks × 730 hours × (1 × 0.04 + 2 × 0.004)
+			name:             "Linux tasks",
+			properties:       map[string]interface{}{"vcpu": 1, "memoryGB": 2, "tasks": 2},
+			expectedMonthly:  "70.0800",
+			expectedEligible: "70.0800",
+			expectedDetails: map[string]string{
+				"taskHours":           "1460",
+				"architecture":        "x86_64",
+				"monthlyOnDemandCost": "USD 70.0800",
+			},
+		},
+		{
+			// 4380 on-demand and 2920 Spot task hours, and 30 GB of extra storage for 7300 task hours
+			name: "Spot share and ephemeral storage",
+			properties: map[string]interface{}{
+				"vcpu": 4, "memoryGB": 8, "tasks": 10, "spotPercentage": 40, "ephemeralStorageGB": 50,
+			},
+			expectedMonthly:  "1033.3880",
+			expectedEligible: "840.9600",
+			expectedDetails: map[string]string{
+				"spotPercentage":              "40",
+				"monthlyOnDemandCost":         "USD 840.9600",
+				"monthlySpotCost":             "USD 170.5280",
+				"monthlyEphemeralStorageCost": "USD 21.9000",
+			},
+		},
+		{
+			// 200 hours × (2 × 0.09 + 4 × 0.01), plus the license fee of 2 × 0.046 per hour
+			name: "Windows task on a schedule",
+			properties: map[string]interface{}{
+				"vcpu": 2, "memoryGB": 4, "operatingSystem": "Windows",
+				"schedule": map[string]interface{}{"hoursPerMonth": 200},
+			},
+			expectedMonthly:  "62.4000",
+			expectedEligible: "44.0000",
+			expectedDetails: map[string]string{
+				"operatingSystem":           "Windows",
+				"schedule":                  "200 hours per month",
+				"monthlyWindowsLicenseCost": "USD 18.4000",
+			},
+		},
+		{
+			// 4 tasks × 730 hours × (0.25 × 0.032 + 0.5 × 0.0035)
+			name:             "ARM tasks",
+			properties:       map[string]interface{}{"vcpu": 0.25, "memoryGB": 0.5, "tasks": 4, "architecture": "arm64"},
+			expectedMonthly:  "28.4700",
+			expectedEligible: "28.4700",
+			expectedDetails: map[string]string{
+				"vcpu":     "0.25",
+				"memoryGB": "0.5",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "Fargate", Name: "service", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			eligible := estimate.SavingsPlanEligibleCost.MulFloat(models.HoursPerMonth)
+			if got := eligible.StringFixed(4); got != tt.expectedEligible {
+				t.Errorf("expected %s of monthly cost eligible for Savings Plans, got %s", tt.expectedEligible, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	withoutSpot := testProducts()[:8]
+
+	tests := []struct {
+		name       string
+		client     *MockAWSClient
+		properties map[string]interface{}
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}, properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2}},
+		{name: "no pricing data", client: &MockAWSClient{}, properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2}},
+		{name: "no Spot pricing", client: &MockAWSClient{products: withoutSpot}, properties: map[string]interface{}{"vcpu": 1, "memoryGB": 2, "spotPercentage": 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "Fargate", Name: "service", Region: "us-east-1", Properties: tt.properties}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package estimators
+
+import (
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Finalize completes an estimation result once every resource is priced. It
+// records the reporting period, which Free Tier instance hours depend on, and
+// sums the resource costs into the totals. It then applies the account-wide
+// Free Tier allowances and Savings Plan commitment, scales costs to the
+// reporting period and converts the currency, in that order. Every estimation
+// path finalizes its result here so that configuration options apply the same
+// way however resources are priced.
+func Finalize(options models.ConfigOptions, rates *money.ExchangeRates, result *models.EstimationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	// Free Tier instance hours depend on the length of the reported month
+	if err := setPeriod(options, result); err != nil {
+		return err
+	}
+
+	recalculateTotals(result, money.Amount{})
+	result.TotalUpfrontCost = money.Amount{}
+	for _, cost := range result.ResourceCosts {
+		result.TotalUpfrontCost = result.TotalUpfrontCost.Add(cost.UpfrontCost)
+	}
+
+	// Free Tier allowances and Savings Plan commitments are shared across
+	// resources, so apply them once all are priced
+	if options.ApplyFreeTier {
+		if err := ApplyFreeTier(result); err != nil {
+			return err
+		}
+	}
+	ApplySavingsPlans(options.SavingsPlans, result)
+	if err := ApplyPeriod(options, result); err != nil {
+		return err
+	}
+	return ApplyCurrency(options.Currency, rates, result)
+}
@@ -0,0 +1,67 @@
+package estimators
+
+import (
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+func TestFinalize(t *testing.T) {
+	rates := &money.ExchangeRates{Base: money.USD, Rates: map[string]float64{"EUR": 0.5}}
+
+	tests := []struct {
+		name            string
+		options         models.ConfigOptions
+		expectError     bool
+		expectedHourly  float64
+		expectedUpfront float64
+		expectedLabel   string
+	}{
+		{name: "totals only", expectedHourly: 1.5, expectedUpfront: 100, expectedLabel: money.USD},
+		{
+			name:            "savings plan before currency",
+			options:         models.ConfigOptions{Currency: "EUR", SavingsPlans: &models.SavingsPlans{Type: models.SavingsPlanTypeEC2Instance, HourlyCommitment: 0.64, InstanceFamily: "m5", Region: "us-east-1"}},
+			expectedHourly:  (0.64 + 0.5) * 0.5,
+			expectedUpfront: 50,
+			expectedLabel:   "EUR",
+		},
+		{name: "invalid month", options: models.ConfigOptions{Month: "2026-13"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			web := onDemandCost("web", "EC2", "us-east-1", "m5.large", 1, 1)
+			web.UpfrontCost = money.NewAmount(100)
+			fn := onDemandCost("fn", "Lambda", "us-east-1", "", 0.5, 0.5)
+
+			// Totals start empty and are summed from the resource costs
+			result := &models.EstimationResult{ResourceCosts: []models.CostEstimate{web, fn}}
+
+			err := Finalize(tt.options, rates, result)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !closeTo(result.TotalHourlyCost, tt.expectedHourly) {
+				t.Errorf("expected total hourly cost %.4f, got %s", tt.expectedHourly, result.TotalHourlyCost)
+			}
+			if !closeTo(result.TotalUpfrontCost, tt.expectedUpfront) {
+				t.Errorf("expected total upfront cost %.4f, got %s", tt.expectedUpfront, result.TotalUpfrontCost)
+			}
+			if result.Currency != tt.expectedLabel {
+				t.Errorf("expected currency %s, got %s", tt.expectedLabel, result.Currency)
+			}
+			if result.HoursPerMonth != models.HoursPerMonth {
+				t.Errorf("expected a %d-hour month, got %g", models.HoursPerMonth, result.HoursPerMonth)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package estimators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// FreeTierAllowance is a monthly AWS Free Tier allowance
+type FreeTierAllowance struct {
+	Quantity float64
+	Unit     string
+	PerHour  bool // Whether the allowance is for instance hours, which follow the length of the month
+}
+
+// FreeTierAllowances are the monthly AWS Free Tier allowances. The Lambda
+// and data transfer allowances are always free; the others apply to accounts
+// in their first 12 months.
+var FreeTierAllowances = map[string]FreeTierAllowance{
+	models.FreeTierEC2MicroLinux:   {Quantity: 750, Unit: "Linux t2/t3.micro instance hours", PerHour: true},
+	models.FreeTierEC2MicroWindows: {Quantity: 750, Unit: "Windows t2/t3.micro instance hours", PerHour: true},
+	models.FreeTierLambdaRequests:  {Quantity: 1000000, Unit: "Lambda requests"},
+	models.FreeTierLambdaCompute:   {Quantity: 400000, Unit: "Lambda GB-seconds"},
+	models.FreeTierS3Storage:       {Quantity: 5, Unit: "GB-months of S3 Standard storage"},
+	models.FreeTierRDSMicro:        {Quantity: 750, Unit: "db.t3.micro instance hours", PerHour: true},
+	models.FreeTierRDSStorage:      {Quantity: 20, Unit: "GB-months of RDS storage"},
+	models.FreeTierDataTransfer:    {Quantity: 100, Unit: "GB of data transfer out to the internet"},
+}
+
+// ApplyFreeTier subtracts the AWS Free Tier allowances from an estimation
+// result. Allowances are account-wide, so each is shared across all matching
+// resources, highest unit price first. Instance hours are counted in the
+// calendar month the result covers, if any, and on the days and hours of a
+// resource's schedule. Resource costs and totals are updated in place and the
+// credit is recorded on the result.
+func ApplyFreeTier(result *models.EstimationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	type claim struct {
+		index int
+		usage models.FreeTierUsage
+	}
+
+	claims := make(map[string][]claim)
+	for i, cost := range result.ResourceCosts {
+		for _, usage := range cost.FreeTierUsage {
+			if usage.Quantity > 0 && usage.UnitPrice.Sign() > 0 {
+				claims[usage.Allowance] = append(claims[usage.Allowance], claim{index: i, usage: usage})
+			}
+		}
+	}
+
+	// Go through allowances in a fixed order so assumptions are stable
+	names := make([]string, 0, len(claims))
+	for name := range claims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	credits := make(map[int]money.Amount)
+	for _, name := range names {
+		allowanceClaims := claims[name]
+		allowance, exists := FreeTierAllowances[name]
+		if !exists {
+			continue
+		}
+
+		// Use the allowance where it is worth the most
+		sort.SliceStable(allowanceClaims, func(i, j int) bool {
+			if c := allowanceClaims[i].usage.UnitPrice.Cmp(allowanceClaims[j].usage.UnitPrice); c != 0 {
+				return c > 0
+			}
+			return result.ResourceCosts[allowanceClaims[i].index].ResourceName < result.ResourceCosts[allowanceClaims[j].index].ResourceName
+		})
+
+		remaining := allowance.Quantity
+		for _, c := range allowanceClaims {
+			cost := &result.ResourceCosts[c.index]
+
+			// Usage is estimated for a 730-hour month; instance hours run
+			// for as many hours as the reported month has
+			quantity := c.usage.Quantity
+			creditHours := float64(models.HoursPerMonth)
+			if allowance.PerHour {
+				scale, err := billedHoursScale(cost.Schedule, result)
+				if err != nil {
+					return err
+				}
+				quantity *= scale
+				creditHours *= scale
+			}
+
+			covered := min(remaining, quantity)
+			if covered <= 0 {
+				break
+			}
+			remaining -= covered
+
+			monthlyCredit := c.usage.UnitPrice.MulFloat(covered)
+			hourlyCredit := monthlyCredit.DivFloat(creditHours)
+
+			cost.HourlyCost = cost.HourlyCost.Sub(hourlyCredit)
+			if allowance.PerHour {
+				cost.HourlyBilledCost = money.Max(money.Amount{}, cost.HourlyBilledCost.Sub(hourlyCredit))
+				cost.ScheduledCost = money.Max(money.Amount{}, cost.ScheduledCost.Sub(hourlyCredit))
+			}
+			if c.usage.SavingsPlanEligible {
+				cost.SavingsPlanEligibleCost = money.Max(money.Amount{}, cost.SavingsPlanEligibleCost.Sub(hourlyCredit))
+			}
+			cost.CalculateCosts()
+			cost.AddAssumption(fmt.Sprintf("AWS Free Tier covers %s of %s %s (-USD %s/month)",
+				formatQuantity(covered), formatQuantity(quantity), allowance.Unit, monthlyCredit.StringFixed(2)))
+
+			credits[c.index] = credits[c.index].Add(monthlyCredit)
+			result.FreeTierCredit = result.FreeTierCredit.Add(monthlyCredit)
+		}
+	}
+
+	if len(credits) == 0 {
+		return nil
+	}
+
+	for index, credit := range credits {
+		result.ResourceCosts[index].SetDetail("freeTierCredit", fmt.Sprintf("USD %s/month", credit.StringFixed(2)))
+	}
+
+	recalculateTotals(result, money.Amount{})
+	return nil
+}
+
+// formatQuantity formats a quantity without the rounding noise of scaling it
+// to a calendar month
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(math.Round(quantity*1e6)/1e6, 'f', -1, 64)
+}
@@ -0,0 +1,175 @@
+package estimators
+
+import (
+	"strings"
+	"testing"
+
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// freeTierCost builds a priced resource with the given Free Tier eligible usage
+func freeTierCost(name string, hourly float64, usage ...models.FreeTierUsage) models.CostEstimate {
+	cost := onDemandCost(name, "EC2", "us-east-1", "", hourly, hourly)
+	cost.FreeTierUsage = usage
+	return cost
+}
+
+func TestApplyFreeTier(t *testing.T) {
+	microHours := func(allowance string, instances, price float64) models.FreeTierUsage {
+		return models.FreeTierUsage{
+			Allowance:           allowance,
+			Quantity:            instances * models.HoursPerMonth,
+			UnitPrice:           money.NewAmount(price),
+			SavingsPlanEligible: true,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		result           *models.EstimationResult
+		expectedCredit   map[string]float64 // Monthly credit per resource
+		expectedEligible map[string]float64
+	}{
+		{
+			name: "allowance shared across resources, highest price first",
+			result: resultOf(
+				freeTierCost("t3", 0.0104*2, microHours(models.FreeTierEC2MicroLinux, 2, 0.0104)),
+				freeTierCost("t2", 0.0116, microHours(models.FreeTierEC2MicroLinux, 1, 0.0116)),
+			),
+			expectedCredit: map[string]float64{
+				"t2": models.HoursPerMonth * 0.0116,
+				"t3": 20 * 0.0104,
+			},
+			expectedEligible: map[string]float64{
+				"t2": 0,
+				"t3": 0.0104*2 - 20*0.0104/models.HoursPerMonth,
+			},
+		},
+		{
+			name: "Linux and Windows hours have separate allowances",
+			result: resultOf(
+				freeTierCost("linux", 0.0104, microHours(models.FreeTierEC2MicroLinux, 1, 0.0104)),
+				freeTierCost("windows", 0.0196, microHours(models.FreeTierEC2MicroWindows, 1, 0.0196)),
+			),
+			expectedCredit: map[string]float64{
+				"linux":   models.HoursPerMonth * 0.0104,
+				"windows": models.HoursPerMonth * 0.0196,
+			},
+			expectedEligible: map[string]float64{
+				"linux":   0,
+				"windows": 0,
+			},
+		},
+		{
+			name: "usage below the allowance is fully covered",
+			result: resultOf(
+				freeTierCost("fn", 0.5,
+					models.FreeTierUsage{Allowance: models.FreeTierLambdaRequests, Quantity: 500000, UnitPrice: money.NewAmount(0.0000002)},
+					models.FreeTierUsage{Allowance: models.FreeTierLambdaCompute, Quantity: 100000, UnitPrice: money.NewAmount(0.0000166667), SavingsPlanEligible: true},
+				),
+			),
+			expectedCredit: map[string]float64{
+				"fn": 500000*0.0000002 + 100000*0.0000166667,
+			},
+			expectedEligible: map[string]float64{
+				"fn": 0.5 - 100000*0.0000166667/models.HoursPerMonth,
+			},
+		},
+		{
+			name: "storage usage is not Savings Plan eligible",
+			result: resultOf(
+				freeTierCost("bucket", 1,
+					models.FreeTierUsage{Allowance: models.FreeTierS3Storage, Quantity: 100, UnitPrice: money.NewAmount(0.023)},
+				),
+			),
+			expectedCredit:   map[string]float64{"bucket": 5 * 0.023},
+			expectedEligible: map[string]float64{"bucket": 1},
+		},
+		{
+			name:             "no eligible usage",
+			result:           resultOf(freeTierCost("web", 1)),
+			expectedCredit:   map[string]float64{"web": 0},
+			expectedEligible: map[string]float64{"web": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := make(map[string]money.Amount)
+			for _, cost := range tt.result.ResourceCosts {
+				before[cost.ResourceName] = cost.MonthlyCost
+			}
+			totalBefore := tt.result.TotalMonthlyCost
+
+			if err := ApplyFreeTier(tt.result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var totalCredit float64
+			for _, cost := range tt.result.ResourceCosts {
+				credit := tt.expectedCredit[cost.ResourceName]
+				totalCredit += credit
+
+				if actual := before[cost.ResourceName].Sub(cost.MonthlyCost); !closeTo(actual, credit) {
+					t.Errorf("expected %s credit %.6f, got %s", cost.ResourceName, credit, actual)
+				}
+				if !closeTo(cost.SavingsPlanEligibleCost, tt.expectedEligible[cost.ResourceName]) {
+					t.Errorf("expected %s eligible cost %.6f, got %s", cost.ResourceName, tt.expectedEligible[cost.ResourceName], cost.SavingsPlanEligibleCost)
+				}
+
+				_, hasDetail := cost.Details["freeTierCredit"]
+				hasAssumption := false
+				for _, assumption := range cost.Assumptions {
+					hasAssumption = hasAssumption || strings.HasPrefix(assumption, "AWS Free Tier covers")
+				}
+				if credit > 0 && (!hasDetail || !hasAssumption) {
+					t.Errorf("expected %s to record the Free Tier credit, got %v and %v", cost.ResourceName, cost.Details, cost.Assumptions)
+				}
+				if credit == 0 && (hasDetail || hasAssumption) {
+					t.Errorf("expected no Free Tier credit on %s", cost.ResourceName)
+				}
+			}
+
+			if !closeTo(tt.result.FreeTierCredit, totalCredit) {
+				t.Errorf("expected total credit %.6f, got %s", totalCredit, tt.result.FreeTierCredit)
+			}
+			if actual := totalBefore.Sub(tt.result.TotalMonthlyCost); !closeTo(actual, totalCredit) {
+				t.Errorf("expected totals to drop by %.6f, got %s", totalCredit, actual)
+			}
+		})
+	}
+}
+
+func TestApplyFreeTierCalendarMonth(t *testing.T) {
+	// Two micro instances run for 1,344 hours in February, so the 750-hour
+	// allowance covers more than half of them; storage is billed per GB-month
+	linux := freeTierCost("linux", 0.0104*2, models.FreeTierUsage{
+		Allowance: models.FreeTierEC2MicroLinux, Quantity: 2 * models.HoursPerMonth, UnitPrice: money.NewAmount(0.0104), SavingsPlanEligible: true,
+	})
+	linux.HourlyBilledCost = linux.HourlyCost
+	bucket := freeTierCost("bucket", 1, models.FreeTierUsage{
+		Allowance: models.FreeTierS3Storage, Quantity: 100, UnitPrice: money.NewAmount(0.023),
+	})
+	result := resultOf(linux, bucket)
+
+	if err := Finalize(models.ConfigOptions{Month: "2026-02", ApplyFreeTier: true}, nil, result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]float64{
+		"linux":  0.0104*2*672 - 750*0.0104,
+		"bucket": models.HoursPerMonth - 5*0.023,
+	}
+	for _, cost := range result.ResourceCosts {
+		if !closeTo(cost.MonthlyCost, expected[cost.ResourceName]) {
+			t.Errorf("expected %s monthly cost %.4f, got %s", cost.ResourceName, expected[cost.ResourceName], cost.MonthlyCost)
+		}
+	}
+	if !closeTo(result.TotalMonthlyCost, expected["linux"]+expected["bucket"]) {
+		t.Errorf("expected total monthly cost %.4f, got %s", expected["linux"]+expected["bucket"], result.TotalMonthlyCost)
+	}
+	if !closeTo(result.FreeTierCredit, 750*0.0104+5*0.023) {
+		t.Errorf("expected credit %.4f, got %s", 750*0.0104+5*0.023, result.FreeTierCredit)
+	}
+}
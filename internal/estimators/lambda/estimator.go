@@ -9,6 +9,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Estimator implements the ResourceEstimator interface for AWS Lambda functions
@@ -156,7 +157,7 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	}
 
 	// Calculate costs
-	totalHourlyCost, costBreakdown, err := e.calculateLambdaCosts(products, memoryMB, requestsPerMonth, averageDurationMs, storageGB)
+	totalHourlyCost, costBreakdown, tieredCosts, err := e.calculateLambdaCosts(products, memoryMB, requestsPerMonth, averageDurationMs, storageGB)
 	if err != nil {
 		return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate Lambda costs").
 			WithContext("resourceName", resource.Name)
@@ -170,9 +171,12 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 		HourlyCost:   totalHourlyCost,
 		Currency:     "USD",
 		Timestamp:    time.Now(),
+
+		// Compute Savings Plans cover duration charges, not requests or storage
+		SavingsPlanEligibleCost: costBreakdown["compute"],
 	}
 
-	// Calculate daily and monthly costs
+	// Calculate daily, monthly and annual costs
 	estimate.CalculateCosts()
 
 	// Add assumptions
@@ -197,62 +201,89 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 
 	// Add cost breakdown details
 	for component, cost := range costBreakdown {
-		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("$%.6f/hour", cost))
+		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("USD %s/hour", cost.StringFixed(6)))
+	}
+	for component, tieredCost := range tieredCosts {
+		estimate.SetDetail(fmt.Sprintf("%sTiers", component), tieredCost.String())
+	}
+
+	// Free Tier allowances apply to the first, most expensive tier
+	if requestCost, exists := tieredCosts["request"]; exists {
+		estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+			Allowance: models.FreeTierLambdaRequests,
+			Quantity:  float64(requestsPerMonth),
+			UnitPrice: requestCost.Charges[0].Tier.PricePerUnit.DivFloat(1000000), // Priced per million requests
+		})
+	}
+	if computeCost, exists := tieredCosts["compute"]; exists {
+		estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+			Allowance:           models.FreeTierLambdaCompute,
+			Quantity:            computeCost.Quantity,
+			UnitPrice:           computeCost.Charges[0].Tier.PricePerUnit,
+			SavingsPlanEligible: true,
+		})
 	}
 
 	return estimate, nil
 }
 
-// calculateLambdaCosts calculates Lambda costs based on requests, duration, and memory
-func (e *Estimator) calculateLambdaCosts(products []interfaces.PricingProduct, memoryMB, requestsPerMonth, averageDurationMs, storageGB int) (float64, map[string]float64, error) {
-	costBreakdown := make(map[string]float64)
+// calculateLambdaCosts calculates Lambda costs based on requests, duration, and memory.
+// Each component is priced across its monthly usage tiers, and the tiered costs
+// are returned by component for the cost breakdown.
+func (e *Estimator) calculateLambdaCosts(products []interfaces.PricingProduct, memoryMB, requestsPerMonth, averageDurationMs, storageGB int) (money.Amount, map[string]money.Amount, map[string]*aws.TieredCost, error) {
+	costBreakdown := make(map[string]money.Amount)
+	tieredCosts := make(map[string]*aws.TieredCost)
 
 	// Find pricing components
-	var requestPrice, computePrice, storagePrice float64
+	var requestProduct, computeProduct, storageProduct *interfaces.PricingProduct
 
-	for _, product := range products {
+	for i, product := range products {
 		// Extract pricing based on usage type
 		if usageType, exists := product.Attributes["usageType"]; exists {
-			price, err := e.pricingService.ExtractHourlyPrice(product)
-			if err != nil {
-				continue // Skip products we can't parse
-			}
-
 			if e.isRequestUsage(usageType) {
-				requestPrice = price
+				requestProduct = &products[i]
 			} else if e.isComputeUsage(usageType) {
-				computePrice = price
+				computeProduct = &products[i]
 			} else if e.isStorageUsage(usageType) {
-				storagePrice = price
+				storageProduct = &products[i]
 			}
 		}
 	}
 
-	// Calculate request costs (per million requests)
-	requestsPerHour := float64(requestsPerMonth) / (24 * 30)
-	requestCostPerHour := (requestsPerHour / 1000000) * requestPrice
-	costBreakdown["request"] = requestCostPerHour
-
-	// Calculate compute costs (GB-seconds)
+	// Monthly usage of each component, in the unit it is priced in
 	memoryGB := float64(memoryMB) / 1024
 	durationSeconds := float64(averageDurationMs) / 1000
-	gbSecondsPerRequest := memoryGB * durationSeconds
-	gbSecondsPerHour := gbSecondsPerRequest * requestsPerHour
-	computeCostPerHour := gbSecondsPerHour * computePrice
-	costBreakdown["compute"] = computeCostPerHour
-
-	// Calculate storage costs if applicable
-	var storageCostPerHour float64
-	if storageGB > 0 {
-		// Storage pricing is typically per GB-month, convert to hourly
-		storageMonthlyPrice := storagePrice * float64(storageGB)
-		storageCostPerHour = storageMonthlyPrice / (24 * 30)
-		costBreakdown["storage"] = storageCostPerHour
+	usage := []struct {
+		component string
+		product   *interfaces.PricingProduct
+		quantity  float64
+	}{
+		{"request", requestProduct, float64(requestsPerMonth) / 1000000},                    // Per million requests
+		{"compute", computeProduct, memoryGB * durationSeconds * float64(requestsPerMonth)}, // GB-seconds
+		{"storage", storageProduct, float64(storageGB)},                                     // GB-month
 	}
 
-	totalCost := requestCostPerHour + computeCostPerHour + storageCostPerHour
+	var totalCost money.Amount
+	for _, u := range usage {
+		// Storage is only billed when configured
+		if u.component == "storage" && storageGB == 0 {
+			continue
+		}
+
+		var hourlyCost money.Amount
+		if u.product != nil && u.quantity > 0 {
+			tieredCost, err := e.pricingService.CalculateTieredCost(*u.product, u.quantity)
+			if err == nil { // Skip products we can't parse
+				tieredCosts[u.component] = tieredCost
+				hourlyCost = tieredCost.Total.DivFloat(models.HoursPerMonth)
+			}
+		}
+
+		costBreakdown[u.component] = hourlyCost
+		totalCost = totalCost.Add(hourlyCost)
+	}
 
-	return totalCost, costBreakdown, nil
+	return totalCost, costBreakdown, tieredCosts, nil
 }
 
 // Helper functions
@@ -341,3 +341,69 @@ func TestLambdaEstimator_GetTypicalUseCases(t *testing.T) {
 		}
 	}
 }
+
+func TestLambdaEstimator_EstimateCostTiered(t *testing.T) {
+	product := func(sku, usageType string, tiers ...[3]string) interfaces.PricingProduct {
+		dimensions := make(map[string]interface{})
+		for i, tier := range tiers {
+			dimensions[fmt.Sprintf("%s.%d", sku, i)] = map[string]interface{}{
+				"beginRange":   tier[0],
+				"endRange":     tier[1],
+				"pricePerUnit": map[string]interface{}{"USD": tier[2]},
+			}
+		}
+		return interfaces.PricingProduct{
+			SKU:        sku,
+			Attributes: map[string]string{"usageType": usageType},
+			Terms: map[string]interface{}{
+				"OnDemand": map[string]interface{}{
+					sku + ".JRTCKXETXF": map[string]interface{}{"priceDimensions": dimensions},
+				},
+			},
+		}
+	}
+
+	estimator := NewEstimator(&MockAWSClient{products: []interfaces.PricingProduct{
+		product("REQ", "Request", [3]string{"0", "Inf", "0.20"}),
+		product("GBS", "Lambda-GB-Second",
+			[3]string{"0", "6000000000", "0.0000166667"},
+			[3]string{"6000000000", "15000000000", "0.0000150000"},
+			[3]string{"15000000000", "Inf", "0.0000133334"},
+		),
+	}})
+
+	// 1 GB for 1 second on 10 billion requests: 6B GB-seconds in the first
+	// tier and 4B in the second
+	resource := models.ResourceSpec{
+		Type:   "Lambda",
+		Name:   "high-volume",
+		Region: "us-east-1",
+		Properties: map[string]interface{}{
+			"memoryMB":          1024,
+			"averageDurationMs": 1000,
+			"requestsPerMonth":  10000000000,
+		},
+	}
+
+	estimate, err := estimator.EstimateCost(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedMonthly := 10000*0.20 + 6e9*0.0000166667 + 4e9*0.0000150000
+	if diff := estimate.MonthlyCost.Float64() - expectedMonthly; diff > 0.01 || diff < -0.01 {
+		t.Errorf("Expected monthly cost %.2f, got %s", expectedMonthly, estimate.MonthlyCost)
+	}
+	if diff := estimate.SavingsPlanEligibleCost.Float64()*models.HoursPerMonth - (6e9*0.0000166667 + 4e9*0.0000150000); diff > 0.01 || diff < -0.01 {
+		t.Errorf("Expected compute cost to be Savings Plan eligible, got %s/hour", estimate.SavingsPlanEligibleCost)
+	}
+
+	expectedTiers := "6000000000 @ USD 0.0000166667 (0-6000000000) = USD 100000.2000; " +
+		"4000000000 @ USD 0.000015 (6000000000-15000000000) = USD 60000.0000"
+	if estimate.Details["computeTiers"] != expectedTiers {
+		t.Errorf("Expected computeTiers detail %q, got %q", expectedTiers, estimate.Details["computeTiers"])
+	}
+	if _, exists := estimate.Details["requestTiers"]; !exists {
+		t.Errorf("Expected requestTiers detail but not found")
+	}
+}
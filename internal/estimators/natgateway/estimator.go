@@ -0,0 +1,202 @@
+package natgateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"shylock/internal/aws"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// Estimator implements the ResourceEstimator interface for NAT gateways
+type Estimator struct {
+	pricingService *aws.PricingService
+}
+
+// NewEstimator creates a new NAT gateway cost estimator
+func NewEstimator(awsClient interfaces.AWSPricingClient) interfaces.ResourceEstimator {
+	return &Estimator{
+		pricingService: aws.NewPricingService(awsClient),
+	}
+}
+
+// SupportedResourceType returns the AWS resource type this estimator supports
+func (e *Estimator) SupportedResourceType() string {
+	return "NATGateway"
+}
+
+// ValidateResource validates that the resource specification is valid for a NAT gateway
+func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
+	if resource.Type != "NATGateway" {
+		return errors.ValidationError("resource type must be 'NATGateway'").
+			WithContext("actualType", resource.Type).
+			WithSuggestion("Use 'NATGateway' as the resource type")
+	}
+
+	// Validate optional count property
+	if _, exists := resource.GetProperty("count"); exists {
+		count, err := resource.GetIntProperty("count")
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid count property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure count is a positive integer")
+		}
+		if count <= 0 {
+			return errors.ValidationError("count must be greater than 0").
+				WithContext("resourceName", resource.Name).
+				WithContext("count", count).
+				WithSuggestion("Set count to the number of NAT gateways, usually one per availability zone")
+		}
+	}
+
+	// Validate optional dataProcessedGB property
+	if _, exists := resource.GetProperty("dataProcessedGB"); exists {
+		dataProcessedGB, err := resource.GetIntProperty("dataProcessedGB")
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid dataProcessedGB property", err).
+				WithContext("resourceName", resource.Name).
+				WithSuggestion("Ensure dataProcessedGB is a non-negative integer")
+		}
+		if dataProcessedGB < 0 {
+			return errors.ValidationError("dataProcessedGB cannot be negative").
+				WithContext("resourceName", resource.Name).
+				WithContext("dataProcessedGB", dataProcessedGB).
+				WithSuggestion("Set dataProcessedGB to the GB processed by all gateways per month")
+		}
+	}
+
+	// Validate region
+	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for NAT gateway resource").
+			WithContext("resourceName", resource.Name)
+	}
+
+	return nil
+}
+
+// EstimateCost calculates the cost for NAT gateway hours and data processing
+func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSpec) (*models.CostEstimate, error) {
+	// Validate the resource first
+	if err := e.ValidateResource(resource); err != nil {
+		return nil, err
+	}
+
+	// Get optional properties with defaults
+	count := 1
+	if _, exists := resource.GetProperty("count"); exists {
+		if c, err := resource.GetIntProperty("count"); err == nil {
+			count = c
+		}
+	}
+
+	dataProcessedGB := 0 // Default no traffic
+	if _, exists := resource.GetProperty("dataProcessedGB"); exists {
+		if dp, err := resource.GetIntProperty("dataProcessedGB"); err == nil {
+			dataProcessedGB = dp
+		}
+	}
+
+	// Get pricing data from AWS
+	products, err := e.pricingService.GetNATGatewayPricing(ctx, resource.Region)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to retrieve NAT gateway pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region)
+	}
+
+	var hoursProduct, bytesProduct *interfaces.PricingProduct
+	for i, product := range products {
+		usageType := product.Attributes["usageType"]
+		switch {
+		case e.isHoursUsage(usageType):
+			hoursProduct = &products[i]
+		case e.isBytesUsage(usageType):
+			bytesProduct = &products[i]
+		}
+	}
+
+	if hoursProduct == nil || bytesProduct == nil {
+		return nil, errors.APIError("incomplete NAT gateway pricing data").
+			WithContext("resourceName", resource.Name).
+			WithContext("region", resource.Region).
+			WithContext("hourlyPriceFound", hoursProduct != nil).
+			WithContext("dataProcessingPriceFound", bytesProduct != nil).
+			WithSuggestion("Check that NAT gateways are available in the specified region")
+	}
+
+	hourlyPrice, err := e.pricingService.ExtractHourlyPrice(*hoursProduct)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to extract NAT gateway hourly price").
+			WithContext("resourceName", resource.Name).
+			WithContext("sku", hoursProduct.SKU)
+	}
+
+	dataProcessingCost, err := e.pricingService.CalculateTieredCost(*bytesProduct, float64(dataProcessedGB))
+	if err != nil {
+		return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate NAT gateway data processing costs").
+			WithContext("resourceName", resource.Name).
+			WithContext("sku", bytesProduct.SKU)
+	}
+
+	gatewayHourly := hourlyPrice.MulFloat(float64(count))
+	monthlyGatewayCost := gatewayHourly.MulFloat(models.HoursPerMonth)
+
+	// Create cost estimate
+	estimate := &models.CostEstimate{
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       gatewayHourly.Add(dataProcessingCost.Total.DivFloat(models.HoursPerMonth)),
+		HourlyBilledCost: gatewayHourly, // Data processing is billed per GB
+		Currency:         "USD",
+		Timestamp:        time.Now(),
+	}
+
+	// Calculate daily, monthly and annual costs
+	estimate.CalculateCosts()
+
+	// Add assumptions
+	estimate.AddAssumption("24/7 NAT gateway operation assumed")
+	if dataProcessedGB == 0 {
+		estimate.AddAssumption("No data processing costs included (set dataProcessedGB for traffic through the gateways)")
+	}
+	estimate.AddAssumption("Data transfer out to the internet or other regions is billed separately (use a DataTransfer resource)")
+	if count > 1 {
+		estimate.AddAssumption(fmt.Sprintf("Cost calculated for %d NAT gateways", count))
+	}
+
+	// Add details
+	estimate.SetDetail("count", fmt.Sprintf("%d", count))
+	estimate.SetDetail("dataProcessedGB", fmt.Sprintf("%d", dataProcessedGB))
+	estimate.SetDetail("hourlyPrice", fmt.Sprintf("USD %s/hour", hourlyPrice.StringFixed(4)))
+	estimate.SetDetail("dataProcessingPrice", fmt.Sprintf("USD %s/GB", pricePerUnit(dataProcessingCost).StringFixed(4)))
+	estimate.SetDetail("monthlyGatewayCost", fmt.Sprintf("USD %s", monthlyGatewayCost.StringFixed(4)))
+	estimate.SetDetail("monthlyDataProcessingCost", fmt.Sprintf("USD %s", dataProcessingCost.Total.StringFixed(4)))
+
+	return estimate, nil
+}
+
+// pricePerUnit returns the first tier's price of a tiered cost
+func pricePerUnit(cost *aws.TieredCost) money.Amount {
+	if len(cost.Charges) == 0 {
+		return money.Amount{}
+	}
+	return cost.Charges[0].Tier.PricePerUnit
+}
+
+// Helper functions
+
+func (e *Estimator) isHoursUsage(usageType string) bool {
+	// NAT gateway hour usage types look like "USE1-NatGateway-Hours"
+	return strings.Contains(usageType, "NatGateway-Hours")
+}
+
+func (e *Estimator) isBytesUsage(usageType string) bool {
+	// NAT gateway data processing usage types look like "USE1-NatGateway-Bytes"
+	return strings.Contains(usageType, "NatGateway-Bytes")
+}
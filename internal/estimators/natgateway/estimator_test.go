@@ -0,0 +1,176 @@
+package natgateway
+
+import (
+	"context"
+	"testing"
+
+	"shylock/internal/aws/awstest"
+	"shylock/internal/errors"
+	"shylock/internal/interfaces"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// MockAWSClient for testing
+type MockAWSClient struct {
+	products      []interfaces.PricingProduct
+	shouldFailGet bool
+}
+
+func (m *MockAWSClient) GetProducts(ctx context.Context, serviceCode string, filters map[string]string) ([]interfaces.PricingProduct, error) {
+	if m.shouldFailGet {
+		return nil, errors.APIError("mock API failure")
+	}
+	return m.products, nil
+}
+
+func (m *MockAWSClient) DescribeServices(ctx context.Context) ([]interfaces.ServiceInfo, error) {
+	return []interfaces.ServiceInfo{}, nil
+}
+
+func (m *MockAWSClient) GetRegions(ctx context.Context, serviceCode string) ([]string, error) {
+	return []string{"us-east-1"}, nil
+}
+
+func testProducts() []interfaces.PricingProduct {
+	return []interfaces.PricingProduct{
+		awstest.FlatProduct("NATHOURS", "USE1-NatGateway-Hours", "0.045"),
+		awstest.FlatProduct("NATBYTES", "USE1-NatGateway-Bytes", "0.045"),
+	}
+}
+
+func TestNewEstimator(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+	if estimator.SupportedResourceType() != "NATGateway" {
+		t.Errorf("expected resource type 'NATGateway', got '%s'", estimator.SupportedResourceType())
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	estimator := NewEstimator(&MockAWSClient{})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+		expectError  bool
+	}{
+		{name: "valid NAT gateway", properties: map[string]interface{}{"count": 3, "dataProcessedGB": 1000}},
+		{name: "defaults", properties: map[string]interface{}{}},
+		{name: "wrong resource type", resourceType: "ALB", properties: map[string]interface{}{}, expectError: true},
+		{name: "zero count", properties: map[string]interface{}{"count": 0}, expectError: true},
+		{name: "count not a number", properties: map[string]interface{}{"count": "two"}, expectError: true},
+		{name: "negative dataProcessedGB", properties: map[string]interface{}{"dataProcessedGB": -1}, expectError: true},
+		{name: "invalid region", region: "invalid-region", properties: map[string]interface{}{}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{Type: "NATGateway", Name: "nat", Region: "us-east-1", Properties: tt.properties}
+			if tt.resourceType != "" {
+				resource.Type = tt.resourceType
+			}
+			if tt.region != "" {
+				resource.Region = tt.region
+			}
+
+			err := estimator.ValidateResource(resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		properties      map[string]interface{}
+		expectedMonthly string
+		expectedBilled  string // Hourly cost billed per gateway hour
+		expectedDetails map[string]string
+	}{
+		{
+			// 2 gateways × 730 hours × 0.045 + 1000 GB × 0.045
+			name:            "gateways with traffic",
+			properties:      map[string]interface{}{"count": 2, "dataProcessedGB": 1000},
+			expectedMonthly: "110.7000",
+			expectedBilled:  "0.0900",
+			expectedDetails: map[string]string{
+				"count":                     "2",
+				"hourlyPrice":               "USD 0.0450/hour",
+				"dataProcessingPrice":       "USD 0.0450/GB",
+				"monthlyGatewayCost":        "USD 65.7000",
+				"monthlyDataProcessingCost": "USD 45.0000",
+			},
+		},
+		{
+			name:            "one idle gateway",
+			properties:      map[string]interface{}{},
+			expectedMonthly: "32.8500",
+			expectedBilled:  "0.0450",
+			expectedDetails: map[string]string{
+				"count":                     "1",
+				"dataProcessedGB":           "0",
+				"monthlyDataProcessingCost": "USD 0.0000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(&MockAWSClient{products: testProducts()})
+			resource := models.ResourceSpec{Type: "NATGateway", Name: "nat", Region: "us-east-1", Properties: tt.properties}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := estimate.MonthlyCost.StringFixed(4); got != tt.expectedMonthly {
+				t.Errorf("expected monthly cost %s, got %s", tt.expectedMonthly, got)
+			}
+			if got := estimate.HourlyBilledCost.StringFixed(4); got != tt.expectedBilled {
+				t.Errorf("expected hour-billed cost %s, got %s", tt.expectedBilled, got)
+			}
+			for key, expected := range tt.expectedDetails {
+				if estimate.Details[key] != expected {
+					t.Errorf("expected detail %s %q, got %q", key, expected, estimate.Details[key])
+				}
+			}
+			if !estimate.SavingsPlanEligibleCost.Equal(money.Amount{}) {
+				t.Errorf("expected NAT gateways not to be Savings Plan eligible, got %s", estimate.SavingsPlanEligibleCost)
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *MockAWSClient
+	}{
+		{name: "API failure", client: &MockAWSClient{shouldFailGet: true}},
+		{name: "no data processing price", client: &MockAWSClient{products: testProducts()[:1]}},
+		{name: "no hourly price", client: &MockAWSClient{products: testProducts()[1:]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator := NewEstimator(tt.client)
+			resource := models.ResourceSpec{Type: "NATGateway", Name: "nat", Region: "us-east-1", Properties: map[string]interface{}{}}
+
+			_, err := estimator.EstimateCost(context.Background(), resource)
+			if !errors.IsErrorType(err, errors.APIErrorType) {
+				t.Errorf("expected API error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package estimators
+
+import (
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// ApplyPeriod sets the period an estimation result is reported for. Monthly
+// costs cover the AWS-standard 730-hour month unless options.Month selects a
+// calendar month. Only the part of each cost billed per hour, and any unused
+// Savings Plan commitment, then follows the length of that month, and resources
+// on a schedule are billed for the hours it runs in that month; storage and
+// request charges are billed per month and stay as they are. Annual costs
+// always cover a 365-day year. The time frame the output emphasizes is
+// recorded on the result.
+func ApplyPeriod(options models.ConfigOptions, result *models.EstimationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	if err := setPeriod(options, result); err != nil {
+		return err
+	}
+	if result.Month == "" {
+		return nil
+	}
+
+	result.TotalMonthlyCost = money.Amount{}
+	for i := range result.ResourceCosts {
+		cost := &result.ResourceCosts[i]
+		scheduledScale, err := billedHoursScale(cost.Schedule, result)
+		if err != nil {
+			return err
+		}
+
+		monthlyBilled := cost.HourlyCost.Sub(cost.HourlyBilledCost).MulFloat(models.HoursPerMonth)
+		alwaysOn := cost.HourlyBilledCost.Sub(cost.ScheduledCost).MulFloat(result.HoursPerMonth)
+		scheduled := cost.ScheduledCost.MulFloat(models.HoursPerMonth * scheduledScale)
+		cost.MonthlyCost = money.Sum(monthlyBilled, alwaysOn, scheduled)
+		result.TotalMonthlyCost = result.TotalMonthlyCost.Add(cost.MonthlyCost)
+	}
+	if result.SavingsPlans != nil {
+		unused := result.SavingsPlans.UnusedCommitment.MulFloat(result.HoursPerMonth)
+		result.TotalMonthlyCost = result.TotalMonthlyCost.Add(unused)
+	}
+
+	return nil
+}
+
+// billedHoursScale returns how many times the hours billed in the reported
+// month exceed those in a 730-hour month, for usage running 24/7 or on a
+// schedule
+func billedHoursScale(schedule *models.Schedule, result *models.EstimationResult) (float64, error) {
+	if result.Month == "" || result.HoursPerMonth <= 0 {
+		return 1, nil
+	}
+	if schedule == nil {
+		return result.HoursPerMonth / models.HoursPerMonth, nil
+	}
+
+	hours, err := schedule.HoursInMonth(result.Month)
+	if err != nil {
+		return 0, errors.ValidationErrorWithCause("invalid schedule", err).
+			WithContext("month", result.Month).
+			WithContext("schedule", schedule.String())
+	}
+	return hours / schedule.MonthlyHours(), nil
+}
+
+// setPeriod records the time frame, calendar month and hours per month of an
+// estimation result without changing its costs
+func setPeriod(options models.ConfigOptions, result *models.EstimationResult) error {
+	result.TimeFrame = options.TimeFrame
+	if result.TimeFrame == "" {
+		result.TimeFrame = models.TimeFrameMonthly
+	}
+
+	hoursPerMonth := float64(models.HoursPerMonth)
+	if options.Month != "" {
+		hours, err := models.HoursInMonth(options.Month)
+		if err != nil {
+			return errors.ValidationErrorWithCause("invalid month", err).
+				WithContext("month", options.Month).
+				WithSuggestion("Use the YYYY-MM format, e.g. 2026-02")
+		}
+		hoursPerMonth = hours
+		result.Month = options.Month
+	}
+	result.HoursPerMonth = hoursPerMonth
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package estimators
+
+import (
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+func TestApplyPeriod(t *testing.T) {
+	tests := []struct {
+		name              string
+		options           models.ConfigOptions
+		expectError       bool
+		expectedTimeFrame string
+		expectedHours     float64
+	}{
+		{
+			name:              "standard month",
+			options:           models.ConfigOptions{},
+			expectedTimeFrame: models.TimeFrameMonthly,
+			expectedHours:     730,
+		},
+		{
+			name:              "february",
+			options:           models.ConfigOptions{Month: "2026-02", TimeFrame: models.TimeFrameAnnual},
+			expectedTimeFrame: models.TimeFrameAnnual,
+			expectedHours:     672,
+		},
+		{
+			name:              "31-day month",
+			options:           models.ConfigOptions{Month: "2026-01"},
+			expectedTimeFrame: models.TimeFrameMonthly,
+			expectedHours:     744,
+		},
+		{
+			name:        "invalid month",
+			options:     models.ConfigOptions{Month: "2026-2"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			web := onDemandCost("web", "EC2", "us-east-1", "m5.large", 0.096, 0.096)
+			web.HourlyBilledCost = web.HourlyCost
+			db := onDemandCost("db", "RDS", "us-east-1", "", 0.2, 0)
+			db.HourlyBilledCost = money.NewAmount(0.15) // The rest is storage, billed per GB-month
+			result := resultOf(web, db)
+			result.SavingsPlans = &models.SavingsPlansCoverage{UnusedCommitment: money.NewAmount(0.004)}
+			result.FreeTierCredit = money.NewAmount(7.3)
+			annual := result.TotalAnnualCost
+
+			err := ApplyPeriod(tt.options, result)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.TimeFrame != tt.expectedTimeFrame || result.HoursPerMonth != tt.expectedHours || result.Month != tt.options.Month {
+				t.Errorf("unexpected period: %s, %s, %g hours", result.TimeFrame, result.Month, result.HoursPerMonth)
+			}
+
+			// Only hour-billed costs follow the length of the month
+			expectedMonthly := map[string]float64{
+				"web": 0.096 * tt.expectedHours,
+				"db":  0.05*models.HoursPerMonth + 0.15*tt.expectedHours,
+			}
+			for _, cost := range result.ResourceCosts {
+				if expected := expectedMonthly[cost.ResourceName]; !closeTo(cost.MonthlyCost, expected) {
+					t.Errorf("expected %s monthly cost %.4f, got %s", cost.ResourceName, expected, cost.MonthlyCost)
+				}
+			}
+
+			// A standard month leaves the totals unchanged
+			expectedTotal := 0.296 * models.HoursPerMonth
+			if tt.expectedHours != models.HoursPerMonth {
+				expectedTotal = expectedMonthly["web"] + expectedMonthly["db"] + 0.004*tt.expectedHours
+			}
+			if !closeTo(result.TotalMonthlyCost, expectedTotal) {
+				t.Errorf("expected total monthly cost %.4f, got %s", expectedTotal, result.TotalMonthlyCost)
+			}
+			if !result.FreeTierCredit.Equal(money.NewAmount(7.3)) {
+				t.Errorf("expected the Free Tier credit to be unchanged, got %s", result.FreeTierCredit)
+			}
+			if !result.TotalAnnualCost.Equal(annual) || !annual.Equal(money.NewAmount(0.296).MulFloat(8760)) {
+				t.Errorf("expected annual cost %.6f to be unaffected, got %s", 0.296*8760, result.TotalAnnualCost)
+			}
+		})
+	}
+}
+
+func TestApplyPeriodScheduled(t *testing.T) {
+	schedule, err := models.ParseSchedule(map[string]interface{}{"days": "weekdays", "start": "08:00", "end": "20:00"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// An instance on weekdays, an Elastic IP billed around the clock and a volume
+	instance := money.NewAmount(0.1).MulFloat(schedule.UsageFraction())
+	web := onDemandCost("web", "EC2", "us-east-1", "m5.large", instance.Float64()+0.005+0.01, instance.Float64())
+	web.HourlyBilledCost = instance.Add(money.NewAmount(0.005))
+	web.ScheduledCost = instance
+	web.Schedule = schedule
+	result := resultOf(web)
+
+	if err := ApplyPeriod(models.ConfigOptions{Month: "2026-02"}, result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// February 2026 has 20 weekdays of 12 hours
+	expected := 0.01*models.HoursPerMonth + 0.005*672 + 0.1*20*12
+	if !closeTo(result.ResourceCosts[0].MonthlyCost, expected) {
+		t.Errorf("expected monthly cost %.4f, got %s", expected, result.ResourceCosts[0].MonthlyCost)
+	}
+	if !closeTo(result.TotalMonthlyCost, expected) {
+		t.Errorf("expected total monthly cost %.4f, got %s", expected, result.TotalMonthlyCost)
+	}
+}
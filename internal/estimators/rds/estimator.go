@@ -9,6 +9,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Estimator implements the ResourceEstimator interface for RDS instances
@@ -107,6 +108,30 @@ func (e *Estimator) ValidateResource(resource models.ResourceSpec) error {
 		}
 	}
 
+	// Validate purchase option and reserved term
+	if _, err := aws.ReservedTermFromProperties(resource.Properties); err != nil {
+		return errors.WrapError(err, errors.ValidationErrorType, "invalid purchase option for RDS resource").
+			WithContext("resourceName", resource.Name)
+	}
+	if purchaseOption, _ := resource.GetStringProperty("purchaseOption"); purchaseOption == aws.PurchaseOptionSpot {
+		return errors.ValidationError("spot pricing is only available for EC2").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Use purchaseOption 'OnDemand' or 'Reserved' for RDS resources")
+	}
+
+	// Validate usage schedule
+	schedule, err := resource.GetSchedule()
+	if err != nil {
+		return errors.ValidationErrorWithCause("invalid schedule property", err).
+			WithContext("resourceName", resource.Name).
+			WithSuggestion(models.ScheduleSuggestion)
+	}
+	if purchaseOption, _ := resource.GetStringProperty("purchaseOption"); schedule != nil && purchaseOption == aws.PurchaseOptionReserved {
+		return errors.ValidationError("schedule cannot be combined with reserved pricing").
+			WithContext("resourceName", resource.Name).
+			WithSuggestion("Reserved instances are billed for every hour of the term; remove the schedule or use on-demand pricing")
+	}
+
 	// Validate region
 	if err := e.pricingService.ValidateRegion(resource.Region); err != nil {
 		return errors.WrapError(err, errors.ValidationErrorType, "invalid region for RDS resource").
@@ -156,6 +181,15 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 		}
 	}
 
+	reservedTerm, _ := aws.ReservedTermFromProperties(resource.Properties)
+
+	// The instance is only billed while running; storage is billed 24/7
+	instanceHours := float64(models.HoursPerMonth)
+	schedule, _ := resource.GetSchedule()
+	if schedule != nil {
+		instanceHours = schedule.MonthlyHours()
+	}
+
 	// Get pricing data from AWS
 	products, err := e.pricingService.GetRDSPricing(ctx, instanceClass, engine, resource.Region, multiAZ)
 	if err != nil {
@@ -167,7 +201,7 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	}
 
 	// Calculate costs
-	totalHourlyCost, costBreakdown, err := e.calculateRDSCosts(products, storageGB, storageType, encrypted)
+	totalHourlyCost, costBreakdown, storageCost, reservedPrice, err := e.calculateRDSCosts(products, storageGB, storageType, encrypted, reservedTerm, instanceHours/models.HoursPerMonth)
 	if err != nil {
 		return nil, errors.WrapError(err, errors.APIErrorType, "failed to calculate RDS costs").
 			WithContext("resourceName", resource.Name)
@@ -175,20 +209,35 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 
 	// Create cost estimate
 	estimate := &models.CostEstimate{
-		ResourceName: resource.Name,
-		ResourceType: resource.Type,
-		Region:       resource.Region,
-		HourlyCost:   totalHourlyCost,
-		Currency:     "USD",
-		Timestamp:    time.Now(),
+		ResourceName:     resource.Name,
+		ResourceType:     resource.Type,
+		Region:           resource.Region,
+		HourlyCost:       totalHourlyCost,
+		HourlyBilledCost: totalHourlyCost.Sub(costBreakdown["storage"]), // Storage is billed per GB-month
+		Currency:         "USD",
+		Timestamp:        time.Now(),
 	}
 
-	// Calculate daily and monthly costs
+	// Calculate daily, monthly and annual costs
 	estimate.CalculateCosts()
 
 	// Add assumptions
-	estimate.AddAssumption("24/7 database operation assumed")
-	estimate.AddAssumption("On-demand pricing (no reserved instances)")
+	if schedule != nil {
+		estimate.Schedule, estimate.ScheduledCost = schedule, estimate.HourlyBilledCost
+		estimate.AddAssumption(fmt.Sprintf("Instance runs on a schedule: %s; storage is billed while stopped", schedule))
+		estimate.SetDetail("schedule", schedule.String())
+	} else {
+		estimate.AddAssumption("24/7 database operation assumed")
+	}
+	if reservedPrice != nil {
+		estimate.UpfrontCost = reservedPrice.UpfrontFee
+		estimate.AddAssumption(fmt.Sprintf("Reserved instance pricing (%s); storage is billed on-demand", reservedTerm))
+		if reservedPrice.UpfrontFee.Sign() > 0 {
+			estimate.AddAssumption(fmt.Sprintf("Upfront fee amortized over %.0f hours", reservedTerm.Hours()))
+		}
+	} else {
+		estimate.AddAssumption("On-demand pricing (no reserved instances)")
+	}
 	if !multiAZ {
 		estimate.AddAssumption("Single AZ deployment (set multiAZ: true for Multi-AZ)")
 	} else {
@@ -208,25 +257,82 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	estimate.SetDetail("storageType", storageType)
 	estimate.SetDetail("multiAZ", fmt.Sprintf("%t", multiAZ))
 	estimate.SetDetail("encrypted", fmt.Sprintf("%t", encrypted))
+	if reservedPrice != nil {
+		estimate.SetDetail("purchaseOption", aws.PurchaseOptionReserved)
+		estimate.SetDetail("reservedTerm", reservedTerm.String())
+		estimate.SetDetail("upfrontFee", fmt.Sprintf("USD %s", reservedPrice.UpfrontFee.StringFixed(2)))
+		estimate.SetDetail("recurringPrice", fmt.Sprintf("USD %s/hour", reservedPrice.HourlyPrice.StringFixed(4)))
+		estimate.SetDetail("amortizedUpfront", fmt.Sprintf("USD %s/hour", reservedPrice.AmortizedUpfrontPrice().StringFixed(4)))
+	} else {
+		estimate.SetDetail("purchaseOption", aws.PurchaseOptionOnDemand)
+	}
 
 	// Add cost breakdown details
 	for component, cost := range costBreakdown {
-		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("$%.4f/hour", cost))
+		estimate.SetDetail(fmt.Sprintf("%sCost", component), fmt.Sprintf("USD %s/hour", cost.StringFixed(4)))
+	}
+	if storageCost != nil {
+		estimate.SetDetail("storageTiers", storageCost.String())
+	}
+
+	// The Free Tier covers Single-AZ db.t3.micro instances and General Purpose storage
+	if reservedPrice == nil && !multiAZ && instanceClass == "db.t3.micro" {
+		estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+			Allowance: models.FreeTierRDSMicro,
+			Quantity:  instanceHours,
+			UnitPrice: costBreakdown["instance"],
+		})
+	}
+	if storageCost != nil && len(storageCost.Charges) > 0 && (storageType == "gp2" || storageType == "gp3") {
+		estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+			Allowance: models.FreeTierRDSStorage,
+			Quantity:  float64(storageGB),
+			UnitPrice: storageCost.Charges[0].Tier.PricePerUnit,
+		})
 	}
 
 	return estimate, nil
 }
 
-// calculateRDSCosts calculates RDS costs based on instance and storage pricing
-func (e *Estimator) calculateRDSCosts(products []interfaces.PricingProduct, storageGB int, storageType string, encrypted bool) (float64, map[string]float64, error) {
-	costBreakdown := make(map[string]float64)
+// calculateRDSCosts calculates RDS costs based on instance and storage pricing.
+// When a reserved term is given, the instance uses reserved pricing with its
+// upfront fee amortized over the term; storage is always billed on-demand,
+// across the tiers of its price dimensions. Instance costs are scaled by the
+// share of the month the instance runs.
+func (e *Estimator) calculateRDSCosts(products []interfaces.PricingProduct, storageGB int, storageType string, encrypted bool, reservedTerm *aws.ReservedTerm, usageFraction float64) (money.Amount, map[string]money.Amount, *aws.TieredCost, *aws.ReservedPrice, error) {
+	costBreakdown := make(map[string]money.Amount)
 
 	// Find pricing components
-	var instanceHourPrice, storageHourPrice float64
+	var instanceHourPrice, storageHourPrice money.Amount
+	var storageCost *aws.TieredCost
+	var reservedPrice *aws.ReservedPrice
 
 	for _, product := range products {
 		// Extract pricing based on usage type
 		if usageType, exists := product.Attributes["usageType"]; exists {
+			if reservedTerm != nil && e.isInstanceUsage(usageType) && !e.isStorageUsage(usageType) {
+				reserved, err := e.pricingService.ExtractReservedPrice(product, *reservedTerm)
+				if err != nil {
+					return money.Amount{}, nil, nil, nil, err
+				}
+				reservedPrice = reserved
+				instanceHourPrice = reserved.EffectiveHourlyPrice()
+				costBreakdown["instance"] = instanceHourPrice
+				continue
+			}
+
+			if e.isStorageUsage(usageType) {
+				tieredCost, err := e.pricingService.CalculateTieredCost(product, float64(storageGB))
+				if err != nil {
+					continue // Skip products we can't parse
+				}
+				// Storage pricing is typically per GB-month, convert to hourly
+				storageCost = tieredCost
+				storageHourPrice = tieredCost.Total.DivFloat(models.HoursPerMonth)
+				costBreakdown["storage"] = storageHourPrice
+				continue
+			}
+
 			price, err := e.pricingService.ExtractHourlyPrice(product)
 			if err != nil {
 				continue // Skip products we can't parse
@@ -235,25 +341,26 @@ func (e *Estimator) calculateRDSCosts(products []interfaces.PricingProduct, stor
 			if e.isInstanceUsage(usageType) {
 				instanceHourPrice = price
 				costBreakdown["instance"] = price
-			} else if e.isStorageUsage(usageType) {
-				// Storage pricing is typically per GB-month, convert to hourly
-				storageMonthlyPrice := price * float64(storageGB)
-				storageHourPrice = storageMonthlyPrice / (24 * 30)
-				costBreakdown["storage"] = storageHourPrice
 			}
 		}
 	}
 
+	if reservedTerm != nil && reservedPrice == nil {
+		return money.Amount{}, nil, nil, nil, errors.APIError("no reserved pricing found for RDS instance").
+			WithContext("term", reservedTerm.String()).
+			WithSuggestion("Check that reserved instances are offered for this instance class and engine")
+	}
+
 	// Add encryption cost if applicable (typically small additional cost)
 	if encrypted {
-		encryptionCost := instanceHourPrice * 0.05 // Approximate 5% additional cost
+		encryptionCost := instanceHourPrice.MulFloat(0.05) // Approximate 5% additional cost
 		costBreakdown["encryption"] = encryptionCost
-		instanceHourPrice += encryptionCost
+		instanceHourPrice = instanceHourPrice.Add(encryptionCost)
 	}
 
-	totalCost := instanceHourPrice + storageHourPrice
+	totalCost := instanceHourPrice.MulFloat(usageFraction).Add(storageHourPrice)
 
-	return totalCost, costBreakdown, nil
+	return totalCost, costBreakdown, storageCost, reservedPrice, nil
 }
 
 // Helper functions
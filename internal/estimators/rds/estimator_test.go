@@ -7,6 +7,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // MockAWSClient for testing
@@ -109,6 +110,21 @@ func TestRDSEstimator_ValidateResource(t *testing.T) {
 			expectError: true,
 			errorType:   errors.ValidationErrorType,
 		},
+		{
+			name: "spot purchase option",
+			resource: models.ResourceSpec{
+				Type:   "RDS",
+				Name:   "spot-db",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceClass":  "db.t3.micro",
+					"engine":         "mysql",
+					"purchaseOption": "Spot",
+				},
+			},
+			expectError: true,
+			errorType:   errors.ValidationErrorType,
+		},
 		{
 			name: "missing instanceClass",
 			resource: models.ResourceSpec{
@@ -382,19 +398,24 @@ func TestRDSEstimator_EstimateCost(t *testing.T) {
 				t.Errorf("Expected region %s, got %s", tt.resource.Region, estimate.Region)
 			}
 
-			if estimate.HourlyCost < tt.expectedMinCost {
-				t.Errorf("Expected hourly cost >= %f, got %f", tt.expectedMinCost, estimate.HourlyCost)
+			if estimate.HourlyCost.Cmp(money.NewAmount(tt.expectedMinCost)) < 0 {
+				t.Errorf("Expected hourly cost >= %f, got %s", tt.expectedMinCost, estimate.HourlyCost)
 			}
 
 			// Validate cost calculations
-			expectedDaily := estimate.HourlyCost * 24
-			if estimate.DailyCost != expectedDaily {
-				t.Errorf("Expected daily cost %f, got %f", expectedDaily, estimate.DailyCost)
+			expectedDaily := estimate.HourlyCost.MulFloat(24)
+			if !estimate.DailyCost.Equal(expectedDaily) {
+				t.Errorf("Expected daily cost %s, got %s", expectedDaily, estimate.DailyCost)
+			}
+
+			expectedMonthly := estimate.HourlyCost.MulFloat(models.HoursPerMonth)
+			if !estimate.MonthlyCost.Equal(expectedMonthly) {
+				t.Errorf("Expected monthly cost %s, got %s", expectedMonthly, estimate.MonthlyCost)
 			}
 
-			expectedMonthly := estimate.HourlyCost * 24 * 30
-			if estimate.MonthlyCost != expectedMonthly {
-				t.Errorf("Expected monthly cost %f, got %f", expectedMonthly, estimate.MonthlyCost)
+			// Instance hours are billed per hour, storage per GB-month
+			if estimate.HourlyBilledCost.Sign() <= 0 || estimate.HourlyBilledCost.Cmp(estimate.HourlyCost) >= 0 {
+				t.Errorf("Expected hour-billed cost to exclude storage, got %s of %s", estimate.HourlyBilledCost, estimate.HourlyCost)
 			}
 
 			// Validate assumptions and details
@@ -409,6 +430,224 @@ func TestRDSEstimator_EstimateCost(t *testing.T) {
 	}
 }
 
+func TestRDSEstimator_EstimateCostReserved(t *testing.T) {
+	priceDimension := func(unit, price string) map[string]interface{} {
+		return map[string]interface{}{
+			"unit":         unit,
+			"pricePerUnit": map[string]interface{}{"USD": price},
+		}
+	}
+
+	mockProducts := []interfaces.PricingProduct{
+		{
+			SKU: "RDS001",
+			Attributes: map[string]string{
+				"usageType": "InstanceUsage:db.t3.micro",
+			},
+			Terms: map[string]interface{}{
+				"OnDemand": map[string]interface{}{
+					"RDS001.JRTCKXETXF": map[string]interface{}{
+						"priceDimensions": map[string]interface{}{
+							"RDS001.JRTCKXETXF.6YS6EN2CT7": priceDimension("Hrs", "0.017"),
+						},
+					},
+				},
+				// RDS reserved terms do not list an offering class
+				"Reserved": map[string]interface{}{
+					"RDS001.PARTIAL": map[string]interface{}{
+						"termAttributes": map[string]interface{}{
+							"LeaseContractLength": "1yr",
+							"PurchaseOption":      "Partial Upfront",
+						},
+						"priceDimensions": map[string]interface{}{
+							"RDS001.PARTIAL.UPFRONT":   priceDimension("Quantity", "51"),
+							"RDS001.PARTIAL.RECURRING": priceDimension("Hrs", "0.006"),
+						},
+					},
+				},
+			},
+		},
+		{
+			SKU: "RDS002",
+			Attributes: map[string]string{
+				"usageType": "GP2-Storage",
+			},
+			Terms: map[string]interface{}{
+				"OnDemand": map[string]interface{}{
+					"RDS002.JRTCKXETXF": map[string]interface{}{
+						"priceDimensions": map[string]interface{}{
+							"RDS002.JRTCKXETXF.6YS6EN2CT7": priceDimension("GB-Mo", "0.115"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	estimator := NewEstimator(&MockAWSClient{products: mockProducts})
+	storageHourly := 0.115 * 20 / models.HoursPerMonth
+
+	tests := []struct {
+		name            string
+		paymentOption   string
+		expectError     bool
+		expectedHourly  float64
+		expectedUpfront float64
+	}{
+		{
+			name:            "partial upfront",
+			paymentOption:   "Partial Upfront",
+			expectedHourly:  0.006 + 51.0/8760 + storageHourly,
+			expectedUpfront: 51,
+		},
+		{
+			name:          "payment option not offered",
+			paymentOption: "All Upfront",
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := models.ResourceSpec{
+				Type:   "RDS",
+				Name:   "reserved-db",
+				Region: "us-east-1",
+				Properties: map[string]interface{}{
+					"instanceClass":  "db.t3.micro",
+					"engine":         "mysql",
+					"storageGB":      20,
+					"purchaseOption": "Reserved",
+					"paymentOption":  tt.paymentOption,
+				},
+			}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if diff := estimate.HourlyCost.Float64() - tt.expectedHourly; diff > 0.000001 || diff < -0.000001 {
+				t.Errorf("Expected hourly cost %f, got %s", tt.expectedHourly, estimate.HourlyCost)
+			}
+			if !estimate.UpfrontCost.Equal(money.NewAmount(tt.expectedUpfront)) {
+				t.Errorf("Expected upfront cost %f, got %s", tt.expectedUpfront, estimate.UpfrontCost)
+			}
+			if estimate.Details["purchaseOption"] != "Reserved" {
+				t.Errorf("Expected purchaseOption detail 'Reserved', got '%s'", estimate.Details["purchaseOption"])
+			}
+			if estimate.Details["upfrontFee"] != "USD 51.00" {
+				t.Errorf("Expected upfrontFee detail 'USD 51.00', got '%s'", estimate.Details["upfrontFee"])
+			}
+			if estimate.Details["storageTiers"] != "20 GB-Mo @ USD 0.115 (0+) = USD 2.3000" {
+				t.Errorf("Expected storageTiers detail for 20 GB-Mo, got '%s'", estimate.Details["storageTiers"])
+			}
+		})
+	}
+}
+
+func TestRDSEstimator_EstimateCostScheduled(t *testing.T) {
+	priceDimension := func(unit, price string) map[string]interface{} {
+		return map[string]interface{}{
+			"unit":         unit,
+			"pricePerUnit": map[string]interface{}{"USD": price},
+		}
+	}
+	onDemand := func(sku string, dimension map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				sku + ".JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{sku + ".JRTCKXETXF.6YS6EN2CT7": dimension},
+				},
+			},
+		}
+	}
+
+	mockProducts := []interfaces.PricingProduct{
+		{SKU: "RDS001", Attributes: map[string]string{"usageType": "InstanceUsage:db.t3.medium"}, Terms: onDemand("RDS001", priceDimension("Hrs", "0.068"))},
+		{SKU: "RDS002", Attributes: map[string]string{"usageType": "GP2-Storage"}, Terms: onDemand("RDS002", priceDimension("GB-Mo", "0.115"))},
+	}
+	estimator := NewEstimator(&MockAWSClient{products: mockProducts})
+	storageHourly := 0.115 * 100 / models.HoursPerMonth
+
+	tests := []struct {
+		name           string
+		properties     map[string]interface{}
+		expectError    bool
+		expectedHourly float64
+	}{
+		{
+			name:           "weekday working hours",
+			properties:     map[string]interface{}{"schedule": map[string]interface{}{"days": "weekdays", "start": "09:00", "end": "18:00"}},
+			expectedHourly: 0.068*45/168 + storageHourly,
+		},
+		{
+			name:           "explicit hours per month",
+			properties:     map[string]interface{}{"schedule": map[string]interface{}{"hoursPerMonth": 182.5}},
+			expectedHourly: 0.068*0.25 + storageHourly,
+		},
+		{
+			name:        "reserved instances cannot be scheduled",
+			properties:  map[string]interface{}{"schedule": map[string]interface{}{"hoursPerMonth": 182.5}, "purchaseOption": "Reserved"},
+			expectError: true,
+		},
+		{
+			name:        "schedule is not an object",
+			properties:  map[string]interface{}{"schedule": "weekdays"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := map[string]interface{}{
+				"instanceClass": "db.t3.medium",
+				"engine":        "postgres",
+				"storageGB":     100,
+			}
+			for key, value := range tt.properties {
+				properties[key] = value
+			}
+			resource := models.ResourceSpec{
+				Type:       "RDS",
+				Name:       "dev-db",
+				Region:     "us-east-1",
+				Properties: properties,
+			}
+
+			estimate, err := estimator.EstimateCost(context.Background(), resource)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("Expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// Storage is billed while the instance is stopped
+			if diff := estimate.HourlyCost.Float64() - tt.expectedHourly; diff > 0.000001 || diff < -0.000001 {
+				t.Errorf("Expected hourly cost %f, got %s", tt.expectedHourly, estimate.HourlyCost)
+			}
+			if estimate.Details["schedule"] == "" {
+				t.Error("Expected schedule detail")
+			}
+			for _, assumption := range estimate.Assumptions {
+				if assumption == "24/7 database operation assumed" {
+					t.Error("Unexpected 24/7 assumption for a scheduled database")
+				}
+			}
+		})
+	}
+}
+
 func TestRDSEstimator_GetSupportedInstanceClasses(t *testing.T) {
 	estimator := &Estimator{}
 
@@ -9,6 +9,7 @@ import (
 	"shylock/internal/errors"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Estimator implements the ResourceEstimator interface for S3 storage
@@ -165,32 +166,33 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 			WithSuggestion("Verify the storage class name is correct")
 	}
 
-	// Extract storage price (per GB-month)
-	storagePrice, err := e.pricingService.ExtractHourlyPrice(*storageProduct)
+	// Price storage across the volume tiers (per GB-month)
+	storageCost, err := e.pricingService.CalculateTieredCost(*storageProduct, float64(sizeGB))
 	if err != nil {
 		return nil, errors.WrapError(err, errors.APIErrorType, "failed to extract S3 storage pricing").
 			WithContext("resourceName", resource.Name).
 			WithContext("sku", storageProduct.SKU)
 	}
+	storagePrice := storageCost.Charges[0].Tier.PricePerUnit
 
 	// Calculate storage costs
 	// Note: S3 pricing is typically per GB-month, but we'll convert to hourly for consistency
-	monthlyStorageCost := storagePrice * float64(sizeGB)
-	hourlyStorageCost := monthlyStorageCost / (24 * 30) // Convert monthly to hourly
+	monthlyStorageCost := storageCost.Total
+	hourlyStorageCost := monthlyStorageCost.DivFloat(models.HoursPerMonth) // Convert monthly to hourly
 
 	// Calculate request costs if applicable
-	var hourlyRequestCost float64
+	var hourlyRequestCost money.Amount
+	var requestCost *aws.TieredCost
 	if requestProduct != nil && requestsPerMonth > 0 {
-		requestPrice, err := e.pricingService.ExtractHourlyPrice(*requestProduct)
+		// Request pricing is typically per 1000 requests per month
+		requestCost, err = e.pricingService.CalculateTieredCost(*requestProduct, float64(requestsPerMonth)/1000.0)
 		if err == nil {
-			// Request pricing is typically per 1000 requests per month
-			monthlyRequestCost := (float64(requestsPerMonth) / 1000.0) * requestPrice
-			hourlyRequestCost = monthlyRequestCost / (24 * 30)
+			hourlyRequestCost = requestCost.Total.DivFloat(models.HoursPerMonth)
 		}
 	}
 
 	// Total hourly cost
-	totalHourlyCost := hourlyStorageCost + hourlyRequestCost
+	totalHourlyCost := hourlyStorageCost.Add(hourlyRequestCost)
 
 	// Create cost estimate
 	estimate := &models.CostEstimate{
@@ -202,12 +204,12 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 		Timestamp:    time.Now(),
 	}
 
-	// Calculate daily and monthly costs
+	// Calculate daily, monthly and annual costs
 	estimate.CalculateCosts()
 
 	// Add assumptions
 	estimate.AddAssumption("Storage costs calculated based on allocated size")
-	estimate.AddAssumption("Pricing based on standard S3 rates (no volume discounts)")
+	estimate.AddAssumption("Storage and requests priced across AWS volume tiers")
 	if requestsPerMonth > 0 {
 		estimate.AddAssumption(fmt.Sprintf("Request costs calculated for %d requests per month", requestsPerMonth))
 	} else {
@@ -221,13 +223,21 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	estimate.SetDetail("storageClass", storageClass)
 	estimate.SetDetail("sizeGB", fmt.Sprintf("%d", sizeGB))
 	estimate.SetDetail("requestsPerMonth", fmt.Sprintf("%d", requestsPerMonth))
-	estimate.SetDetail("storagePrice", fmt.Sprintf("$%.6f/GB-month", storagePrice))
+	estimate.SetDetail("storagePrice", fmt.Sprintf("USD %s/GB-month", storagePrice.StringFixed(6)))
 	estimate.SetDetail("storageSKU", storageProduct.SKU)
+	estimate.SetDetail("storageTiers", storageCost.String())
+	if storageClass == "STANDARD" {
+		estimate.FreeTierUsage = append(estimate.FreeTierUsage, models.FreeTierUsage{
+			Allowance: models.FreeTierS3Storage,
+			Quantity:  float64(sizeGB),
+			UnitPrice: storagePrice,
+		})
+	}
 
-	if requestProduct != nil {
-		requestPrice, _ := e.pricingService.ExtractHourlyPrice(*requestProduct)
-		estimate.SetDetail("requestPrice", fmt.Sprintf("$%.6f/1000 requests", requestPrice))
+	if requestCost != nil {
+		estimate.SetDetail("requestPrice", fmt.Sprintf("USD %s/1000 requests", requestCost.Charges[0].Tier.PricePerUnit.StringFixed(6)))
 		estimate.SetDetail("requestSKU", requestProduct.SKU)
+		estimate.SetDetail("requestTiers", requestCost.String())
 	}
 
 	// Add product family info
@@ -236,10 +246,9 @@ func (e *Estimator) EstimateCost(ctx context.Context, resource models.ResourceSp
 	}
 
 	// Break down costs
-	estimate.SetDetail("monthlyStorageCost", fmt.Sprintf("$%.4f", monthlyStorageCost))
-	if hourlyRequestCost > 0 {
-		monthlyRequestCost := hourlyRequestCost * 24 * 30
-		estimate.SetDetail("monthlyRequestCost", fmt.Sprintf("$%.4f", monthlyRequestCost))
+	estimate.SetDetail("monthlyStorageCost", fmt.Sprintf("USD %s", monthlyStorageCost.StringFixed(4)))
+	if requestCost != nil && requestCost.Total.Sign() > 0 {
+		estimate.SetDetail("monthlyRequestCost", fmt.Sprintf("USD %s", requestCost.Total.StringFixed(4)))
 	}
 
 	return estimate, nil
@@ -0,0 +1,155 @@
+package estimators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// DefaultSavingsPlanDiscounts are approximate 1-year No Upfront discounts off
+// on-demand rates, in percent, by plan type and resource type. Configurations
+// can override them with the discounts from an actual Savings Plans quote.
+var DefaultSavingsPlanDiscounts = map[string]map[string]float64{
+	models.SavingsPlanTypeCompute: {
+		"EC2":     27,
+		"Fargate": 20,
+		"Lambda":  12,
+	},
+	models.SavingsPlanTypeEC2Instance: {
+		"EC2": 36,
+	},
+}
+
+// ApplySavingsPlans applies a Savings Plan commitment to an estimation result
+// the way AWS bills it: every hour the commitment is consumed by eligible usage
+// at discounted rates, highest discount first, and the remaining usage is billed
+// on-demand. Resource costs and totals are updated in place, unused commitment
+// is added to the totals, and the coverage is recorded on the result.
+func ApplySavingsPlans(plan *models.SavingsPlans, result *models.EstimationResult) {
+	if plan == nil || result == nil {
+		return
+	}
+
+	type candidate struct {
+		index    int
+		discount float64 // Percent off on-demand rates
+	}
+
+	var candidates []candidate
+	for i, cost := range result.ResourceCosts {
+		if cost.SavingsPlanEligibleCost.Sign() <= 0 || !savingsPlanCovers(plan, cost) {
+			continue
+		}
+		discount := savingsPlanDiscount(plan, cost.ResourceType)
+		if discount <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{index: i, discount: discount})
+	}
+
+	// AWS applies the commitment to the usage with the largest discount first
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].discount != candidates[j].discount {
+			return candidates[i].discount > candidates[j].discount
+		}
+		return result.ResourceCosts[candidates[i].index].ResourceName < result.ResourceCosts[candidates[j].index].ResourceName
+	})
+
+	commitment := money.NewAmount(plan.HourlyCommitment)
+	coverage := &models.SavingsPlansCoverage{
+		Type:             plan.Type,
+		HourlyCommitment: commitment,
+	}
+	remaining := commitment
+
+	for _, c := range candidates {
+		cost := &result.ResourceCosts[c.index]
+		eligible := cost.SavingsPlanEligibleCost
+
+		// The commitment is spent at discounted rates, so it covers more on-demand usage
+		rate := money.NewAmount(100 - c.discount).DivFloat(100)
+		used, covered := eligible.Mul(rate), eligible
+		if remaining.Cmp(used) < 0 {
+			used, covered = remaining, remaining.Div(rate)
+		}
+		remaining = remaining.Sub(used)
+
+		coverage.CoveredOnDemand = coverage.CoveredOnDemand.Add(covered)
+		coverage.UsedCommitment = coverage.UsedCommitment.Add(used)
+		coverage.UncoveredOnDemand = coverage.UncoveredOnDemand.Add(eligible.Sub(covered))
+
+		if covered.Sign() <= 0 {
+			continue
+		}
+
+		cost.HourlyCost = cost.HourlyCost.Sub(covered.Sub(used))
+		cost.HourlyBilledCost = money.Max(money.Amount{}, cost.HourlyBilledCost.Sub(covered.Sub(used)))
+		cost.ScheduledCost = money.Max(money.Amount{}, cost.ScheduledCost.Sub(covered.Sub(used)))
+		cost.SavingsPlanEligibleCost = eligible.Sub(covered)
+		cost.CalculateCosts()
+		cost.AddAssumption(fmt.Sprintf("%s Savings Plan covers USD %s/hour of on-demand usage at a %.0f%% discount",
+			plan.Type, covered.StringFixed(4), c.discount))
+		cost.SetDetail("savingsPlanCoverage", fmt.Sprintf("%.1f%%", percentOf(covered, eligible)))
+	}
+
+	coverage.UnusedCommitment = remaining
+	if commitment.Sign() > 0 {
+		coverage.Utilization = percentOf(coverage.UsedCommitment, commitment)
+	}
+	if eligible := coverage.CoveredOnDemand.Add(coverage.UncoveredOnDemand); eligible.Sign() > 0 {
+		coverage.Coverage = percentOf(coverage.CoveredOnDemand, eligible)
+	}
+	coverage.NetSavings = coverage.CoveredOnDemand.Sub(coverage.UsedCommitment).Sub(coverage.UnusedCommitment)
+
+	recalculateTotals(result, coverage.UnusedCommitment)
+	result.SavingsPlans = coverage
+}
+
+// savingsPlanCovers reports whether a plan applies to a resource. Compute plans
+// apply to any region and instance family; EC2 Instance plans are limited to
+// one family in one region.
+func savingsPlanCovers(plan *models.SavingsPlans, cost models.CostEstimate) bool {
+	if plan.Type != models.SavingsPlanTypeEC2Instance {
+		return true
+	}
+	if cost.ResourceType != "EC2" || cost.Region != plan.Region {
+		return false
+	}
+	family, _, _ := strings.Cut(cost.Details["instanceType"], ".")
+	return family == plan.InstanceFamily
+}
+
+// savingsPlanDiscount returns the discount in percent for a resource type,
+// preferring the configured discount over the default
+func savingsPlanDiscount(plan *models.SavingsPlans, resourceType string) float64 {
+	if discount, exists := plan.Discounts[resourceType]; exists {
+		return discount
+	}
+	return DefaultSavingsPlanDiscounts[plan.Type][resourceType]
+}
+
+// percentOf returns part as a percentage of whole
+func percentOf(part, whole money.Amount) float64 {
+	return part.Div(whole).MulFloat(100).Float64()
+}
+
+// recalculateTotals sums the resource costs and adds the unused commitment,
+// which is billed whether or not it is used
+func recalculateTotals(result *models.EstimationResult, unusedCommitment money.Amount) {
+	unused := models.CostEstimate{HourlyCost: unusedCommitment}
+	unused.CalculateCosts()
+
+	result.TotalHourlyCost = unused.HourlyCost
+	result.TotalDailyCost = unused.DailyCost
+	result.TotalMonthlyCost = unused.MonthlyCost
+	result.TotalAnnualCost = unused.AnnualCost
+	for _, cost := range result.ResourceCosts {
+		result.TotalHourlyCost = result.TotalHourlyCost.Add(cost.HourlyCost)
+		result.TotalDailyCost = result.TotalDailyCost.Add(cost.DailyCost)
+		result.TotalMonthlyCost = result.TotalMonthlyCost.Add(cost.MonthlyCost)
+		result.TotalAnnualCost = result.TotalAnnualCost.Add(cost.AnnualCost)
+	}
+}
@@ -0,0 +1,233 @@
+package estimators
+
+import (
+	"math"
+	"testing"
+
+	"shylock/internal/models"
+	"shylock/internal/money"
+)
+
+// onDemandCost builds a priced resource with the given hourly and Savings Plan eligible costs
+func onDemandCost(name, resourceType, region, instanceType string, hourly, eligible float64) models.CostEstimate {
+	cost := models.CostEstimate{
+		ResourceName:            name,
+		ResourceType:            resourceType,
+		Region:                  region,
+		HourlyCost:              money.NewAmount(hourly),
+		SavingsPlanEligibleCost: money.NewAmount(eligible),
+	}
+	if instanceType != "" {
+		cost.SetDetail("instanceType", instanceType)
+	}
+	cost.CalculateCosts()
+	return cost
+}
+
+func resultOf(costs ...models.CostEstimate) *models.EstimationResult {
+	result := &models.EstimationResult{ResourceCosts: costs}
+	for _, cost := range costs {
+		result.TotalHourlyCost = result.TotalHourlyCost.Add(cost.HourlyCost)
+		result.TotalDailyCost = result.TotalDailyCost.Add(cost.DailyCost)
+		result.TotalMonthlyCost = result.TotalMonthlyCost.Add(cost.MonthlyCost)
+		result.TotalAnnualCost = result.TotalAnnualCost.Add(cost.AnnualCost)
+	}
+	return result
+}
+
+func TestApplySavingsPlans(t *testing.T) {
+	tests := []struct {
+		name             string
+		plan             *models.SavingsPlans
+		result           *models.EstimationResult
+		expectedHourly   map[string]float64
+		expectedTotal    float64
+		expectedCoverage *models.SavingsPlansCoverage
+	}{
+		{
+			name: "commitment consumed by the largest discount first",
+			plan: &models.SavingsPlans{Type: models.SavingsPlanTypeCompute, HourlyCommitment: 1},
+			result: resultOf(
+				onDemandCost("fn", "Lambda", "us-east-1", "", 0.5, 0.3),
+				onDemandCost("web", "EC2", "us-east-1", "m5.large", 2, 2),
+			),
+			expectedHourly: map[string]float64{
+				"web": 2 - (1/0.73 - 1),
+				"fn":  0.5,
+			},
+			expectedTotal: 2 - (1/0.73 - 1) + 0.5,
+			expectedCoverage: &models.SavingsPlansCoverage{
+				Type:              models.SavingsPlanTypeCompute,
+				HourlyCommitment:  money.NewAmount(1),
+				CoveredOnDemand:   money.NewAmount(1 / 0.73),
+				UsedCommitment:    money.NewAmount(1),
+				UncoveredOnDemand: money.NewAmount(2.3 - 1/0.73),
+				Utilization:       100,
+				Coverage:          1 / 0.73 / 2.3 * 100,
+				NetSavings:        money.NewAmount(1/0.73 - 1),
+			},
+		},
+		{
+			name: "unused commitment is added to the total",
+			plan: &models.SavingsPlans{Type: models.SavingsPlanTypeCompute, HourlyCommitment: 2},
+			result: resultOf(
+				onDemandCost("web", "EC2", "us-east-1", "m5.large", 1, 1),
+			),
+			expectedHourly: map[string]float64{"web": 0.73},
+			expectedTotal:  2,
+			expectedCoverage: &models.SavingsPlansCoverage{
+				Type:             models.SavingsPlanTypeCompute,
+				HourlyCommitment: money.NewAmount(2),
+				CoveredOnDemand:  money.NewAmount(1),
+				UsedCommitment:   money.NewAmount(0.73),
+				UnusedCommitment: money.NewAmount(1.27),
+				Utilization:      36.5,
+				Coverage:         100,
+				NetSavings:       money.NewAmount(-1),
+			},
+		},
+		{
+			name: "compute plan covers Fargate vCPU and memory",
+			plan: &models.SavingsPlans{Type: models.SavingsPlanTypeCompute, HourlyCommitment: 0.8},
+			result: resultOf(
+				onDemandCost("service", "Fargate", "us-east-1", "", 1.5, 1),
+			),
+			expectedHourly: map[string]float64{"service": 1.3},
+			expectedTotal:  1.3,
+			expectedCoverage: &models.SavingsPlansCoverage{
+				Type:             models.SavingsPlanTypeCompute,
+				HourlyCommitment: money.NewAmount(0.8),
+				CoveredOnDemand:  money.NewAmount(1),
+				UsedCommitment:   money.NewAmount(0.8),
+				Utilization:      100,
+				Coverage:         100,
+				NetSavings:       money.NewAmount(0.2),
+			},
+		},
+		{
+			name: "EC2 instance plan covers one family in one region",
+			plan: &models.SavingsPlans{
+				Type:             models.SavingsPlanTypeEC2Instance,
+				HourlyCommitment: 5,
+				InstanceFamily:   "m5",
+				Region:           "us-east-1",
+				Discounts:        map[string]float64{"EC2": 50},
+			},
+			result: resultOf(
+				onDemandCost("covered", "EC2", "us-east-1", "m5.large", 1, 1),
+				onDemandCost("other-family", "EC2", "us-east-1", "c5.large", 1, 1),
+				onDemandCost("other-region", "EC2", "us-west-2", "m5.large", 1, 1),
+				onDemandCost("fn", "Lambda", "us-east-1", "", 1, 1),
+			),
+			expectedHourly: map[string]float64{
+				"covered":      0.5,
+				"other-family": 1,
+				"other-region": 1,
+				"fn":           1,
+			},
+			expectedTotal: 0.5 + 3 + 4.5,
+			expectedCoverage: &models.SavingsPlansCoverage{
+				Type:             models.SavingsPlanTypeEC2Instance,
+				HourlyCommitment: money.NewAmount(5),
+				CoveredOnDemand:  money.NewAmount(1),
+				UsedCommitment:   money.NewAmount(0.5),
+				UnusedCommitment: money.NewAmount(4.5),
+				Utilization:      10,
+				Coverage:         100,
+				NetSavings:       money.NewAmount(-4),
+			},
+		},
+		{
+			name: "reserved resources are not eligible",
+			plan: &models.SavingsPlans{Type: models.SavingsPlanTypeCompute, HourlyCommitment: 1},
+			result: resultOf(
+				onDemandCost("reserved", "EC2", "us-east-1", "m5.large", 0.6, 0),
+			),
+			expectedHourly: map[string]float64{"reserved": 0.6},
+			expectedTotal:  1.6,
+			expectedCoverage: &models.SavingsPlansCoverage{
+				Type:             models.SavingsPlanTypeCompute,
+				HourlyCommitment: money.NewAmount(1),
+				UnusedCommitment: money.NewAmount(1),
+				NetSavings:       money.NewAmount(-1),
+			},
+		},
+		{
+			name: "no savings plan",
+			plan: nil,
+			result: resultOf(
+				onDemandCost("web", "EC2", "us-east-1", "m5.large", 1, 1),
+			),
+			expectedHourly: map[string]float64{"web": 1},
+			expectedTotal:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplySavingsPlans(tt.plan, tt.result)
+
+			for _, cost := range tt.result.ResourceCosts {
+				if !closeTo(cost.HourlyCost, tt.expectedHourly[cost.ResourceName]) {
+					t.Errorf("expected %s hourly cost %.6f, got %s", cost.ResourceName, tt.expectedHourly[cost.ResourceName], cost.HourlyCost)
+				}
+				if !cost.MonthlyCost.Equal(cost.HourlyCost.MulFloat(models.HoursPerMonth)) {
+					t.Errorf("expected %s monthly cost to be recalculated, got %s", cost.ResourceName, cost.MonthlyCost)
+				}
+			}
+
+			if !closeTo(tt.result.TotalHourlyCost, tt.expectedTotal) {
+				t.Errorf("expected total hourly cost %.6f, got %s", tt.expectedTotal, tt.result.TotalHourlyCost)
+			}
+			if !closeTo(tt.result.TotalMonthlyCost, tt.expectedTotal*models.HoursPerMonth) {
+				t.Errorf("expected total monthly cost %.6f, got %s", tt.expectedTotal*models.HoursPerMonth, tt.result.TotalMonthlyCost)
+			}
+
+			coverage := tt.result.SavingsPlans
+			if tt.expectedCoverage == nil {
+				if coverage != nil {
+					t.Errorf("expected no coverage, got %+v", coverage)
+				}
+				return
+			}
+			if coverage == nil {
+				t.Fatal("expected coverage but got nil")
+			}
+
+			expected := tt.expectedCoverage
+			if coverage.Type != expected.Type || !coverage.HourlyCommitment.Equal(expected.HourlyCommitment) {
+				t.Errorf("expected %s plan with commitment %s, got %s with %s",
+					expected.Type, expected.HourlyCommitment, coverage.Type, coverage.HourlyCommitment)
+			}
+			amounts := []struct {
+				field            string
+				expected, actual money.Amount
+			}{
+				{"CoveredOnDemand", expected.CoveredOnDemand, coverage.CoveredOnDemand},
+				{"UsedCommitment", expected.UsedCommitment, coverage.UsedCommitment},
+				{"UnusedCommitment", expected.UnusedCommitment, coverage.UnusedCommitment},
+				{"UncoveredOnDemand", expected.UncoveredOnDemand, coverage.UncoveredOnDemand},
+				{"NetSavings", expected.NetSavings, coverage.NetSavings},
+			}
+			for _, amount := range amounts {
+				if !closeTo(amount.actual, amount.expected.Float64()) {
+					t.Errorf("expected %s %s, got %s", amount.field, amount.expected, amount.actual)
+				}
+			}
+			for _, percent := range [][2]float64{
+				{expected.Utilization, coverage.Utilization},
+				{expected.Coverage, coverage.Coverage},
+			} {
+				if math.Abs(percent[1]-percent[0]) > 1e-9 {
+					t.Errorf("expected %.6f%%, got %.6f%%", percent[0], percent[1])
+				}
+			}
+		})
+	}
+}
+
+// closeTo reports whether an amount is within floating point noise of an
+// expected value, for amounts such as 1/0.73 that no decimal can hold
+func closeTo(actual money.Amount, expected float64) bool {
+	return math.Abs(actual.Float64()-expected) < 1e-9
+}
@@ -0,0 +1,368 @@
+package cloudformation
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"shylock/internal/errors"
+	"shylock/internal/importers"
+	"shylock/internal/models"
+)
+
+// template mirrors the sections of a CloudFormation template used for import.
+// JSON templates and `cdk synth` output are valid YAML, so one parser reads both.
+type template struct {
+	Parameters map[string]interface{}
+	Mappings   map[string]interface{}
+	Conditions map[string]interface{}
+	Resources  map[string]interface{}
+}
+
+// translator converts CloudFormation resource properties into estimator properties.
+// Only the listed properties are resolved, so unrelated properties that refer to
+// other resources (such as a Lambda function's Role) do not prevent import.
+type translator struct {
+	resourceType string
+	properties   []string
+	translate    func(properties map[string]interface{}) (map[string]interface{}, error)
+}
+
+// translators maps supported CloudFormation resource types to Shylock resource types
+var translators = map[string]translator{
+	"AWS::EC2::Instance": {
+		resourceType: "EC2",
+		properties:   []string{"InstanceType", "Tenancy"},
+		translate:    translateInstance,
+	},
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": {
+		resourceType: "ALB",
+		properties:   []string{"Type"},
+		translate:    translateLoadBalancer,
+	},
+	"AWS::RDS::DBInstance": {
+		resourceType: "RDS",
+		properties:   []string{"DBInstanceClass", "Engine", "AllocatedStorage", "MultiAZ", "StorageEncrypted", "StorageType"},
+		translate:    translateDBInstance,
+	},
+	"AWS::Lambda::Function": {
+		resourceType: "Lambda",
+		properties:   []string{"MemorySize", "Architectures"},
+		translate:    translateLambdaFunction,
+	},
+	"AWS::S3::Bucket": {
+		resourceType: "S3",
+		translate:    translateS3Bucket,
+	},
+}
+
+// ignoredResourceTypes are resources with no cost that are not reported as skipped
+var ignoredResourceTypes = map[string]bool{
+	"AWS::CDK::Metadata": true,
+}
+
+// Importer translates CloudFormation templates into estimation configurations
+type Importer struct {
+	region string
+}
+
+// NewImporter creates a CloudFormation template importer. Templates do not
+// name their region, so every resource is estimated in the given region.
+func NewImporter(region string) *Importer {
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &Importer{region: region}
+}
+
+// ImportFile reads a CloudFormation YAML or JSON template and translates its resources
+func (i *Importer) ImportFile(path string) (*importers.Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read CloudFormation template", err).
+			WithContext("templateFile", path).
+			WithSuggestion("For CDK apps, synthesize the template with 'cdk synth > template.yaml'")
+	}
+
+	result, err := i.Import(data)
+	if err != nil {
+		return nil, errors.WrapError(err, "", "failed to import CloudFormation template").
+			WithContext("templateFile", path)
+	}
+
+	return result, nil
+}
+
+// Import translates a CloudFormation template into an estimation configuration
+func (i *Importer) Import(data []byte) (*importers.Result, error) {
+	tmpl, err := parseTemplate(data)
+	if err != nil {
+		return nil, errors.ConfigErrorWithCause("invalid CloudFormation template", err).
+			WithSuggestion("Check that the template is valid YAML or JSON")
+	}
+
+	if len(tmpl.Resources) == 0 {
+		return nil, errors.ConfigError("CloudFormation template contains no resources").
+			WithSuggestion("Templates must have a Resources section")
+	}
+
+	r := newResolver(tmpl, i.region)
+	result := importers.NewResult()
+
+	// Sort logical IDs so the configuration order is stable
+	logicalIDs := make([]string, 0, len(tmpl.Resources))
+	for logicalID := range tmpl.Resources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+
+	for _, logicalID := range logicalIDs {
+		definition, _ := tmpl.Resources[logicalID].(map[string]interface{})
+		resourceType, _ := definition["Type"].(string)
+
+		if ignoredResourceTypes[resourceType] {
+			continue
+		}
+
+		translator, supported := translators[resourceType]
+		if !supported {
+			result.Skip(logicalID, resourceType, "resource type is not supported")
+			continue
+		}
+
+		// Resources whose condition is false are not created
+		if condition, ok := definition["Condition"].(string); ok {
+			created, err := r.condition(condition)
+			if err != nil {
+				result.Skip(logicalID, resourceType, err.Error())
+				continue
+			}
+			if !created {
+				continue
+			}
+		}
+
+		resourceProperties, err := r.resolveProperties(definition["Properties"], translator.properties)
+		if err != nil {
+			result.Skip(logicalID, resourceType, err.Error())
+			continue
+		}
+
+		properties, err := translator.translate(resourceProperties)
+		if err != nil {
+			result.Skip(logicalID, resourceType, err.Error())
+			continue
+		}
+
+		result.AddResource(models.ResourceSpec{
+			Type:       translator.resourceType,
+			Name:       logicalID,
+			Region:     i.region,
+			Properties: properties,
+		})
+	}
+
+	if len(result.Config.Resources) == 0 {
+		return nil, errors.ValidationError("no supported resources found in CloudFormation template").
+			WithContext("skippedResources", len(result.Skipped)).
+			WithSuggestion(fmt.Sprintf("Supported resource types: %s", strings.Join(SupportedResourceTypes(), ", ")))
+	}
+
+	return result, nil
+}
+
+// SupportedResourceTypes returns the CloudFormation resource types that can be imported
+func SupportedResourceTypes() []string {
+	types := make([]string, 0, len(translators))
+	for cfnType := range translators {
+		types = append(types, cfnType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// parseTemplate decodes a YAML or JSON template, expanding short-form intrinsic functions
+func parseTemplate(data []byte) (*template, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeNode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("template must be a mapping")
+	}
+
+	section := func(name string) map[string]interface{} {
+		values, _ := root[name].(map[string]interface{})
+		return values
+	}
+
+	return &template{
+		Parameters: section("Parameters"),
+		Mappings:   section("Mappings"),
+		Conditions: section("Conditions"),
+		Resources:  section("Resources"),
+	}, nil
+}
+
+// translateInstance maps AWS::EC2::Instance properties to EC2 properties
+func translateInstance(properties map[string]interface{}) (map[string]interface{}, error) {
+	instanceType := "m1.small" // CloudFormation default
+	if value, ok := properties["InstanceType"].(string); ok && value != "" {
+		instanceType = value
+	}
+
+	result := map[string]interface{}{
+		"instanceType": instanceType,
+	}
+
+	tenancies := map[string]string{
+		"default":   "Shared",
+		"dedicated": "Dedicated",
+		"host":      "Host",
+	}
+	if tenancy, ok := properties["Tenancy"].(string); ok && tenancies[tenancy] != "" {
+		result["tenancy"] = tenancies[tenancy]
+	}
+
+	return result, nil
+}
+
+// translateLoadBalancer maps AWS::ElasticLoadBalancingV2::LoadBalancer properties to ALB properties
+func translateLoadBalancer(properties map[string]interface{}) (map[string]interface{}, error) {
+	lbType := "application" // CloudFormation default
+	if value, ok := properties["Type"].(string); ok && value != "" {
+		lbType = value
+	}
+
+	if lbType != "application" && lbType != "network" {
+		return nil, fmt.Errorf("load balancer type '%s' is not supported", lbType)
+	}
+
+	return map[string]interface{}{
+		"type": lbType,
+	}, nil
+}
+
+// translateDBInstance maps AWS::RDS::DBInstance properties to RDS properties
+func translateDBInstance(properties map[string]interface{}) (map[string]interface{}, error) {
+	instanceClass, err := requiredString(properties, "DBInstanceClass")
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := requiredString(properties, "Engine")
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"instanceClass": instanceClass,
+		"engine":        strings.ToLower(engine),
+	}
+
+	// AllocatedStorage is a string in the resource specification but is
+	// commonly written as a number
+	if value, exists := properties["AllocatedStorage"]; exists {
+		storage, err := toInt(value)
+		if err != nil {
+			return nil, fmt.Errorf("AllocatedStorage: %w", err)
+		}
+		if storage > 0 {
+			result["storageGB"] = storage
+		}
+	}
+	if multiAZ, ok := toBool(properties["MultiAZ"]); ok {
+		result["multiAZ"] = multiAZ
+	}
+	if encrypted, ok := toBool(properties["StorageEncrypted"]); ok {
+		result["encrypted"] = encrypted
+	}
+	if storageType, ok := properties["StorageType"].(string); ok && storageType != "" {
+		result["storageType"] = storageType
+	}
+
+	return result, nil
+}
+
+// translateLambdaFunction maps AWS::Lambda::Function properties to Lambda properties
+func translateLambdaFunction(properties map[string]interface{}) (map[string]interface{}, error) {
+	memoryMB := 128 // CloudFormation default
+	if value, exists := properties["MemorySize"]; exists {
+		memory, err := toInt(value)
+		if err != nil {
+			return nil, fmt.Errorf("MemorySize: %w", err)
+		}
+		memoryMB = memory
+	}
+
+	result := map[string]interface{}{
+		"memoryMB": memoryMB,
+	}
+
+	if architectures, ok := properties["Architectures"].([]interface{}); ok && len(architectures) > 0 {
+		if architecture, ok := architectures[0].(string); ok {
+			result["architecture"] = architecture
+		}
+	}
+
+	return result, nil
+}
+
+// translateS3Bucket maps AWS::S3::Bucket properties to S3 properties. Buckets
+// have no storage class of their own, so objects are assumed to be STANDARD.
+func translateS3Bucket(properties map[string]interface{}) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"storageClass": "STANDARD",
+	}, nil
+}
+
+// requiredString returns a string property that must be present in the template
+func requiredString(properties map[string]interface{}, key string) (string, error) {
+	value, ok := properties[key].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%s is required", key)
+	}
+	return value, nil
+}
+
+// toInt converts a template number, which may be written as a string, to an int
+func toInt(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a number", v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", value)
+	}
+}
+
+// toBool converts a template boolean, which may be written as a string
+func toBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	default:
+		return false, false
+	}
+}
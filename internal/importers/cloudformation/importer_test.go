@@ -0,0 +1,296 @@
+package cloudformation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+)
+
+const testTemplate = `AWSTemplateFormatVersion: "2010-09-09"
+Parameters:
+  Environment:
+    Type: String
+    Default: prod
+    AllowedValues: [dev, prod]
+  DatabaseEngine:
+    Type: String
+    Default: Postgres
+  KeyName:
+    Type: AWS::EC2::KeyPair::KeyName
+Mappings:
+  SizeMap:
+    prod:
+      WebInstance: m5.large
+      DatabaseClass: db.r5.large
+    dev:
+      WebInstance: t3.micro
+      DatabaseClass: db.t3.micro
+Conditions:
+  IsProduction: !Equals [!Ref Environment, prod]
+  IsDevelopment: !Not [!Condition IsProduction]
+Resources:
+  WebServer:
+    Type: AWS::EC2::Instance
+    Properties:
+      InstanceType: !FindInMap [SizeMap, !Ref Environment, WebInstance]
+      Tenancy: !If [IsProduction, dedicated, default]
+      KeyName: !Ref KeyName
+  Database:
+    Type: AWS::RDS::DBInstance
+    Properties:
+      DBInstanceClass: !FindInMap [SizeMap, !Ref Environment, DatabaseClass]
+      Engine: !Ref DatabaseEngine
+      AllocatedStorage: "100"
+      MultiAZ: !If [IsProduction, true, false]
+      StorageEncrypted: "true"
+      StorageType: gp2
+  ApiFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      MemorySize: 512
+      Architectures: [arm64]
+      Role: !GetAtt ApiRole.Arn
+      Description: !Sub "API for ${Environment} in ${AWS::Region}"
+  PublicLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: !If [IsDevelopment, application, network]
+  Assets:
+    Type: AWS::S3::Bucket
+  DevInstance:
+    Type: AWS::EC2::Instance
+    Condition: IsDevelopment
+    Properties:
+      InstanceType: t3.nano
+  Bastion:
+    Type: AWS::EC2::Instance
+    Properties:
+      InstanceType: !Ref KeyName
+  GatewayLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: gateway
+  ApiRole:
+    Type: AWS::IAM::Role
+    Properties:
+      AssumeRolePolicyDocument: {}
+`
+
+func TestImport(t *testing.T) {
+	result, err := NewImporter("eu-west-1").Import([]byte(testTemplate))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resources := make(map[string]models.ResourceSpec)
+	for _, resource := range result.Config.Resources {
+		resources[resource.Name] = resource
+	}
+
+	tests := []struct {
+		name         string
+		resourceType string
+		properties   map[string]interface{}
+	}{
+		{
+			name:         "WebServer",
+			resourceType: "EC2",
+			properties:   map[string]interface{}{"instanceType": "m5.large", "tenancy": "Dedicated"},
+		},
+		{
+			name:         "Database",
+			resourceType: "RDS",
+			properties: map[string]interface{}{
+				"instanceClass": "db.r5.large",
+				"engine":        "postgres",
+				"storageGB":     100,
+				"multiAZ":       true,
+				"encrypted":     true,
+				"storageType":   "gp2",
+			},
+		},
+		{
+			name:         "ApiFunction",
+			resourceType: "Lambda",
+			properties:   map[string]interface{}{"memoryMB": 512, "architecture": "arm64"},
+		},
+		{
+			name:         "PublicLoadBalancer",
+			resourceType: "ALB",
+			properties:   map[string]interface{}{"type": "network"},
+		},
+		{
+			name:         "Assets",
+			resourceType: "S3",
+			properties:   map[string]interface{}{"storageClass": "STANDARD"},
+		},
+	}
+
+	if len(result.Config.Resources) != len(tests) {
+		t.Errorf("expected %d resources, got %+v", len(tests), result.Config.Resources)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, exists := resources[tt.name]
+			if !exists {
+				t.Fatalf("resource %s not imported", tt.name)
+			}
+			if resource.Type != tt.resourceType {
+				t.Errorf("expected type %s, got %s", tt.resourceType, resource.Type)
+			}
+			if resource.Region != "eu-west-1" {
+				t.Errorf("expected region eu-west-1, got %s", resource.Region)
+			}
+			if len(resource.Properties) != len(tt.properties) {
+				t.Errorf("expected properties %v, got %v", tt.properties, resource.Properties)
+			}
+			for key, expected := range tt.properties {
+				if resource.Properties[key] != expected {
+					t.Errorf("expected %s=%v, got %v", key, expected, resource.Properties[key])
+				}
+			}
+		})
+	}
+
+	skipped := make(map[string]models.SkippedResource)
+	for _, skip := range result.Skipped {
+		skipped[skip.Name] = skip
+	}
+
+	expectedSkipped := map[string]string{
+		"Bastion":             "InstanceType: parameter KeyName has no default value",
+		"GatewayLoadBalancer": "load balancer type 'gateway' is not supported",
+		"ApiRole":             "resource type is not supported",
+	}
+	if len(result.Skipped) != len(expectedSkipped) {
+		t.Errorf("expected %d skipped resources, got %+v", len(expectedSkipped), result.Skipped)
+	}
+	for name, reason := range expectedSkipped {
+		if skipped[name].Reason != reason {
+			t.Errorf("expected %s to be skipped with %q, got %+v", name, reason, skipped[name])
+		}
+	}
+
+	if _, exists := resources["DevInstance"]; exists {
+		t.Error("expected resource with a false condition to be excluded")
+	}
+}
+
+func TestImportJSONTemplate(t *testing.T) {
+	// Shape of `cdk synth --json` output, using long-form intrinsic functions
+	templateJSON := `{
+  "Parameters": {
+    "FunctionMemory": {"Type": "Number", "Default": 1024},
+    "BootstrapVersion": {"Type": "AWS::SSM::Parameter::Value<String>", "Default": "/cdk-bootstrap/hnb659fds/version"}
+  },
+  "Conditions": {
+    "LargeMemory": {"Fn::Or": [
+      {"Fn::Equals": [{"Ref": "FunctionMemory"}, 1024]},
+      {"Fn::Equals": [{"Ref": "FunctionMemory"}, 2048]}
+    ]}
+  },
+  "Resources": {
+    "HandlerServiceRole": {"Type": "AWS::IAM::Role", "Properties": {}},
+    "Handler886CB40B": {
+      "Type": "AWS::Lambda::Function",
+      "Properties": {
+        "MemorySize": {"Fn::If": ["LargeMemory", {"Ref": "FunctionMemory"}, 128]},
+        "Architectures": {"Fn::If": ["LargeMemory", ["arm64"], {"Ref": "AWS::NoValue"}]},
+        "Role": {"Fn::GetAtt": ["HandlerServiceRole", "Arn"]}
+      }
+    },
+    "CDKMetadata": {"Type": "AWS::CDK::Metadata", "Properties": {"Analytics": "v2:deflate64:abc"}}
+  }
+}`
+
+	result, err := NewImporter("").Import([]byte(templateJSON))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Config.Resources) != 1 {
+		t.Fatalf("expected 1 resource, got %+v", result.Config.Resources)
+	}
+	resource := result.Config.Resources[0]
+	if resource.Region != "us-east-1" {
+		t.Errorf("expected default region us-east-1, got %s", resource.Region)
+	}
+	if resource.Properties["memoryMB"] != 1024 || resource.Properties["architecture"] != "arm64" {
+		t.Errorf("unexpected properties %v", resource.Properties)
+	}
+
+	if len(result.Skipped) != 1 || result.Skipped[0].Name != "HandlerServiceRole" {
+		t.Errorf("expected only the IAM role to be skipped, got %+v", result.Skipped)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		errorType errors.ErrorType
+	}{
+		{
+			name:      "invalid YAML",
+			data:      "Resources:\n  Web: [",
+			errorType: errors.ConfigErrorType,
+		},
+		{
+			name:      "not a mapping",
+			data:      "- Resources",
+			errorType: errors.ConfigErrorType,
+		},
+		{
+			name:      "no resources",
+			data:      "Parameters: {}",
+			errorType: errors.ConfigErrorType,
+		},
+		{
+			name:      "no supported resources",
+			data:      "Resources:\n  Role:\n    Type: AWS::IAM::Role",
+			errorType: errors.ValidationErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewImporter("").Import([]byte(tt.data))
+			if !errors.IsErrorType(err, tt.errorType) {
+				t.Errorf("expected %s error, got %v", tt.errorType, err)
+			}
+		})
+	}
+}
+
+func TestImportFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "template.yaml")
+	if err := os.WriteFile(path, []byte(testTemplate), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	if _, err := NewImporter("").ImportFile(path); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err := NewImporter("").ImportFile(filepath.Join(dir, "missing.yaml"))
+	if !errors.IsErrorType(err, errors.FileErrorType) {
+		t.Errorf("expected file error, got %v", err)
+	}
+}
+
+func TestSupportedResourceTypes(t *testing.T) {
+	types := SupportedResourceTypes()
+	if len(types) != len(translators) {
+		t.Errorf("expected %d types, got %d", len(translators), len(types))
+	}
+	for i := 1; i < len(types); i++ {
+		if types[i-1] > types[i] {
+			t.Errorf("expected sorted types, got %v", types)
+		}
+	}
+}
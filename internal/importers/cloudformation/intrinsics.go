@@ -0,0 +1,503 @@
+package cloudformation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// noValue is the resolved value of AWS::NoValue, which removes the property it is assigned to
+type noValue struct{}
+
+// shortFormFunctions maps YAML short-form intrinsic tags to their full function names
+var shortFormFunctions = map[string]string{
+	"!Ref":         "Ref",
+	"!Condition":   "Condition",
+	"!Base64":      "Fn::Base64",
+	"!Cidr":        "Fn::Cidr",
+	"!FindInMap":   "Fn::FindInMap",
+	"!GetAtt":      "Fn::GetAtt",
+	"!GetAZs":      "Fn::GetAZs",
+	"!ImportValue": "Fn::ImportValue",
+	"!Join":        "Fn::Join",
+	"!Select":      "Fn::Select",
+	"!Split":       "Fn::Split",
+	"!Sub":         "Fn::Sub",
+	"!Transform":   "Fn::Transform",
+	"!And":         "Fn::And",
+	"!Equals":      "Fn::Equals",
+	"!If":          "Fn::If",
+	"!Not":         "Fn::Not",
+	"!Or":          "Fn::Or",
+}
+
+// subVariablePattern matches ${Name} variables in Fn::Sub strings, excluding ${!Literal}
+var subVariablePattern = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+
+// decodeNode converts a YAML node into generic Go values, expanding
+// short-form intrinsic function tags into their full JSON form
+func decodeNode(node *yaml.Node) (interface{}, error) {
+	var value interface{}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return decodeNode(node.Content[0])
+
+	case yaml.AliasNode:
+		return decodeNode(node.Alias)
+
+	case yaml.MappingNode:
+		mapping := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			item, err := decodeNode(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			mapping[node.Content[i].Value] = item
+		}
+		value = mapping
+
+	case yaml.SequenceNode:
+		sequence := make([]interface{}, 0, len(node.Content))
+		for _, child := range node.Content {
+			item, err := decodeNode(child)
+			if err != nil {
+				return nil, err
+			}
+			sequence = append(sequence, item)
+		}
+		value = sequence
+
+	case yaml.ScalarNode:
+		if _, isFunction := shortFormFunctions[node.Tag]; isFunction {
+			value = node.Value
+		} else if err := node.Decode(&value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+	}
+
+	function, isFunction := shortFormFunctions[node.Tag]
+	if !isFunction {
+		return value, nil
+	}
+
+	// !GetAtt Resource.Attribute is shorthand for [Resource, Attribute]
+	if function == "Fn::GetAtt" {
+		if attribute, ok := value.(string); ok {
+			parts := strings.SplitN(attribute, ".", 2)
+			sequence := make([]interface{}, len(parts))
+			for i, part := range parts {
+				sequence[i] = part
+			}
+			value = sequence
+		}
+	}
+
+	return map[string]interface{}{function: value}, nil
+}
+
+// resolver evaluates intrinsic functions against a template's parameters,
+// mappings and conditions
+type resolver struct {
+	region     string
+	parameters map[string]interface{}
+	declared   map[string]interface{}
+	mappings   map[string]interface{}
+	conditions map[string]interface{}
+	evaluated  map[string]bool
+	evaluating map[string]bool
+}
+
+// newResolver creates a resolver, taking parameter values from their defaults
+func newResolver(tmpl *template, region string) *resolver {
+	r := &resolver{
+		region:     region,
+		parameters: make(map[string]interface{}),
+		declared:   tmpl.Parameters,
+		mappings:   tmpl.Mappings,
+		conditions: tmpl.Conditions,
+		evaluated:  make(map[string]bool),
+		evaluating: make(map[string]bool),
+	}
+
+	for name, definition := range tmpl.Parameters {
+		parameter, ok := definition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value, hasDefault := parameter["Default"]
+		if !hasDefault {
+			continue
+		}
+
+		// List parameters default to a comma-delimited string
+		if parameterType, _ := parameter["Type"].(string); parameterType == "CommaDelimitedList" || strings.HasPrefix(parameterType, "List<") {
+			if list, ok := value.(string); ok {
+				items := make([]interface{}, 0)
+				for _, item := range strings.Split(list, ",") {
+					items = append(items, strings.TrimSpace(item))
+				}
+				value = items
+			}
+		}
+
+		r.parameters[name] = value
+	}
+
+	return r
+}
+
+// resolve evaluates all intrinsic functions in a value
+func (r *resolver) resolve(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 1 {
+			for key, argument := range v {
+				if key == "Ref" || strings.HasPrefix(key, "Fn::") {
+					return r.resolveFunction(key, argument)
+				}
+			}
+		}
+
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			item, err := r.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			if _, removed := item.(noValue); !removed {
+				resolved[key] = item
+			}
+		}
+		return resolved, nil
+
+	case []interface{}:
+		resolved := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			item, err := r.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			if _, removed := item.(noValue); !removed {
+				resolved = append(resolved, item)
+			}
+		}
+		return resolved, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// resolveProperties resolves the named properties of a resource, omitting
+// properties that are absent or set to AWS::NoValue
+func (r *resolver) resolveProperties(definition interface{}, names []string) (map[string]interface{}, error) {
+	properties, _ := definition.(map[string]interface{})
+	resolved := make(map[string]interface{}, len(names))
+
+	for _, name := range names {
+		value, exists := properties[name]
+		if !exists {
+			continue
+		}
+
+		value, err := r.resolve(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if _, removed := value.(noValue); !removed {
+			resolved[name] = value
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolveFunction evaluates a single intrinsic function
+func (r *resolver) resolveFunction(function string, argument interface{}) (interface{}, error) {
+	switch function {
+	case "Ref":
+		name, ok := argument.(string)
+		if !ok {
+			return nil, fmt.Errorf("Ref requires a name")
+		}
+		return r.ref(name)
+
+	case "Fn::If":
+		arguments, err := r.arguments(function, argument, 3)
+		if err != nil {
+			return nil, err
+		}
+		condition, ok := arguments[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("Fn::If requires a condition name")
+		}
+		result, err := r.condition(condition)
+		if err != nil {
+			return nil, err
+		}
+		if result {
+			return r.resolve(arguments[1])
+		}
+		return r.resolve(arguments[2])
+
+	case "Fn::FindInMap":
+		arguments, err := r.resolvedArguments(function, argument, 3)
+		if err != nil {
+			return nil, err
+		}
+		return r.findInMap(arguments)
+
+	case "Fn::Select":
+		arguments, err := r.resolvedArguments(function, argument, 2)
+		if err != nil {
+			return nil, err
+		}
+		index, err := toInt(arguments[0])
+		if err != nil {
+			return nil, fmt.Errorf("Fn::Select index: %w", err)
+		}
+		list, ok := arguments[1].([]interface{})
+		if !ok || index < 0 || index >= len(list) {
+			return nil, fmt.Errorf("Fn::Select index %d is out of range", index)
+		}
+		return list[index], nil
+
+	case "Fn::Join":
+		arguments, err := r.resolvedArguments(function, argument, 2)
+		if err != nil {
+			return nil, err
+		}
+		delimiter, ok := arguments[0].(string)
+		list, isList := arguments[1].([]interface{})
+		if !ok || !isList {
+			return nil, fmt.Errorf("Fn::Join requires a delimiter and a list")
+		}
+		parts := make([]string, len(list))
+		for i, item := range list {
+			parts[i] = fmt.Sprintf("%v", item)
+		}
+		return strings.Join(parts, delimiter), nil
+
+	case "Fn::Sub":
+		return r.sub(argument)
+
+	case "Fn::Equals", "Fn::And", "Fn::Or", "Fn::Not":
+		return r.evaluateCondition(map[string]interface{}{function: argument})
+
+	default:
+		// Fn::GetAtt, Fn::ImportValue and friends depend on deployed resources
+		return nil, fmt.Errorf("%s cannot be resolved before deployment", function)
+	}
+}
+
+// ref resolves a parameter or pseudo parameter reference
+func (r *resolver) ref(name string) (interface{}, error) {
+	switch name {
+	case "AWS::Region":
+		return r.region, nil
+	case "AWS::NoValue":
+		return noValue{}, nil
+	case "AWS::Partition":
+		return "aws", nil
+	case "AWS::URLSuffix":
+		return "amazonaws.com", nil
+	}
+
+	if value, exists := r.parameters[name]; exists {
+		return value, nil
+	}
+
+	if strings.HasPrefix(name, "AWS::") {
+		return nil, fmt.Errorf("pseudo parameter %s cannot be resolved before deployment", name)
+	}
+	if _, isParameter := r.declared[name]; isParameter {
+		return nil, fmt.Errorf("parameter %s has no default value", name)
+	}
+	return nil, fmt.Errorf("Ref %s refers to a resource that cannot be resolved before deployment", name)
+}
+
+// findInMap looks up a value in the template's Mappings section
+func (r *resolver) findInMap(arguments []interface{}) (interface{}, error) {
+	keys := make([]string, len(arguments))
+	for i, argument := range arguments {
+		key, ok := argument.(string)
+		if !ok {
+			return nil, fmt.Errorf("Fn::FindInMap keys must be strings")
+		}
+		keys[i] = key
+	}
+
+	mapping, ok := r.mappings[keys[0]].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("mapping %s not found", keys[0])
+	}
+	topLevel, ok := mapping[keys[1]].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in mapping %s", keys[1], keys[0])
+	}
+	value, exists := topLevel[keys[2]]
+	if !exists {
+		return nil, fmt.Errorf("key %s not found in mapping %s.%s", keys[2], keys[0], keys[1])
+	}
+
+	return value, nil
+}
+
+// sub substitutes ${Name} variables in an Fn::Sub string
+func (r *resolver) sub(argument interface{}) (interface{}, error) {
+	text, isString := argument.(string)
+	variables := make(map[string]interface{})
+
+	if !isString {
+		arguments, err := r.arguments("Fn::Sub", argument, 2)
+		if err != nil {
+			return nil, err
+		}
+		if text, isString = arguments[0].(string); !isString {
+			return nil, fmt.Errorf("Fn::Sub requires a string")
+		}
+		if values, ok := arguments[1].(map[string]interface{}); ok {
+			for name, value := range values {
+				resolved, err := r.resolve(value)
+				if err != nil {
+					return nil, err
+				}
+				variables[name] = resolved
+			}
+		}
+	}
+
+	var subErr error
+	result := subVariablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := match[2 : len(match)-1]
+		value, exists := variables[name]
+		if !exists {
+			var err error
+			if value, err = r.ref(name); err != nil {
+				subErr = fmt.Errorf("Fn::Sub variable %s: %w", name, err)
+				return match
+			}
+		}
+		return fmt.Sprintf("%v", value)
+	})
+	if subErr != nil {
+		return nil, subErr
+	}
+
+	return strings.ReplaceAll(result, "${!", "${"), nil
+}
+
+// condition evaluates a named condition from the Conditions section
+func (r *resolver) condition(name string) (bool, error) {
+	if result, done := r.evaluated[name]; done {
+		return result, nil
+	}
+
+	definition, exists := r.conditions[name]
+	if !exists {
+		return false, fmt.Errorf("condition %s not found", name)
+	}
+	if r.evaluating[name] {
+		return false, fmt.Errorf("condition %s refers to itself", name)
+	}
+
+	r.evaluating[name] = true
+	result, err := r.evaluateCondition(definition)
+	delete(r.evaluating, name)
+	if err != nil {
+		return false, fmt.Errorf("condition %s: %w", name, err)
+	}
+
+	r.evaluated[name] = result
+	return result, nil
+}
+
+// evaluateCondition evaluates a condition function to a boolean
+func (r *resolver) evaluateCondition(definition interface{}) (bool, error) {
+	function, ok := definition.(map[string]interface{})
+	if !ok || len(function) != 1 {
+		if result, isBool := definition.(bool); isBool {
+			return result, nil
+		}
+		return false, fmt.Errorf("invalid condition definition")
+	}
+
+	for name, argument := range function {
+		switch name {
+		case "Condition":
+			conditionName, ok := argument.(string)
+			if !ok {
+				return false, fmt.Errorf("Condition requires a name")
+			}
+			return r.condition(conditionName)
+
+		case "Fn::Equals":
+			arguments, err := r.resolvedArguments(name, argument, 2)
+			if err != nil {
+				return false, err
+			}
+			return fmt.Sprintf("%v", arguments[0]) == fmt.Sprintf("%v", arguments[1]), nil
+
+		case "Fn::Not":
+			arguments, err := r.arguments(name, argument, 1)
+			if err != nil {
+				return false, err
+			}
+			result, err := r.evaluateCondition(arguments[0])
+			return !result, err
+
+		case "Fn::And", "Fn::Or":
+			arguments, ok := argument.([]interface{})
+			if !ok || len(arguments) < 2 {
+				return false, fmt.Errorf("%s requires at least two conditions", name)
+			}
+			for _, item := range arguments {
+				result, err := r.evaluateCondition(item)
+				if err != nil {
+					return false, err
+				}
+				if name == "Fn::And" && !result {
+					return false, nil
+				}
+				if name == "Fn::Or" && result {
+					return true, nil
+				}
+			}
+			return name == "Fn::And", nil
+		}
+	}
+
+	return false, fmt.Errorf("unsupported condition function")
+}
+
+// arguments returns the unresolved argument list of a function
+func (r *resolver) arguments(function string, argument interface{}, count int) ([]interface{}, error) {
+	arguments, ok := argument.([]interface{})
+	if !ok || len(arguments) != count {
+		return nil, fmt.Errorf("%s requires %d arguments", function, count)
+	}
+	return arguments, nil
+}
+
+// resolvedArguments returns the argument list of a function with each argument resolved
+func (r *resolver) resolvedArguments(function string, argument interface{}, count int) ([]interface{}, error) {
+	arguments, err := r.arguments(function, argument, count)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]interface{}, count)
+	for i, item := range arguments {
+		if resolved[i], err = r.resolve(item); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,149 @@
+package cloudformation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestResolver(t *testing.T, source string) *resolver {
+	t.Helper()
+	tmpl, err := parseTemplate([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	return newResolver(tmpl, "ap-southeast-2")
+}
+
+const testResolverTemplate = `
+Parameters:
+  Stage: {Type: String, Default: beta}
+  Subnets: {Type: CommaDelimitedList, Default: "a, b, c"}
+  Count: {Type: Number, Default: 3}
+  Required: {Type: String}
+Mappings:
+  Regions:
+    ap-southeast-2: {Ami: ami-123}
+Conditions:
+  IsBeta: !Equals [!Ref Stage, beta]
+  IsGamma: !Equals [gamma, !Ref Stage]
+  Either: !Or [!Condition IsBeta, !Condition IsGamma]
+  Both: !And [!Condition IsBeta, !Condition IsGamma]
+  Loop: !Not [!Condition Loop]
+Resources: {}
+`
+
+func TestResolve(t *testing.T) {
+	r := newTestResolver(t, testResolverTemplate)
+
+	tests := []struct {
+		name     string
+		source   string
+		expected interface{}
+	}{
+		{name: "parameter default", source: `!Ref Stage`, expected: "beta"},
+		{name: "number parameter", source: `!Ref Count`, expected: 3},
+		{name: "list parameter", source: `!Ref Subnets`, expected: []interface{}{"a", "b", "c"}},
+		{name: "region", source: `!Ref AWS::Region`, expected: "ap-southeast-2"},
+		{name: "find in map", source: `!FindInMap [Regions, !Ref "AWS::Region", Ami]`, expected: "ami-123"},
+		{name: "if true", source: `!If [Either, yes, no]`, expected: "yes"},
+		{name: "if false", source: `!If [Both, yes, no]`, expected: "no"},
+		{name: "select", source: `!Select [1, !Ref Subnets]`, expected: "b"},
+		{name: "join", source: `!Join ["-", [!Ref Stage, !Ref Count]]`, expected: "beta-3"},
+		{name: "sub", source: `!Sub "${Stage}.${AWS::Region}.${!Literal}"`, expected: "beta.ap-southeast-2.${Literal}"},
+		{name: "sub with variables", source: `!Sub ["${Name}-${Stage}", {Name: api}]`, expected: "api-beta"},
+		{name: "no value removed", source: `[a, !Ref AWS::NoValue, b]`, expected: []interface{}{"a", "b"}},
+		{name: "nested values", source: `{Size: !Ref Count, Tags: [!Ref Stage]}`, expected: map[string]interface{}{"Size": 3, "Tags": []interface{}{"beta"}}},
+		{name: "plain scalar", source: `t3.micro`, expected: "t3.micro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := parseValue(t, tt.source)
+			resolved, err := r.resolve(value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(resolved, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	r := newTestResolver(t, testResolverTemplate)
+
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "parameter without default", source: `!Ref Required`, expected: "parameter Required has no default value"},
+		{name: "resource reference", source: `!Ref WebServer`, expected: "Ref WebServer refers to a resource that cannot be resolved before deployment"},
+		{name: "get attribute", source: `!GetAtt Role.Arn`, expected: "Fn::GetAtt cannot be resolved before deployment"},
+		{name: "import value", source: `{"Fn::ImportValue": shared}`, expected: "Fn::ImportValue cannot be resolved before deployment"},
+		{name: "account id", source: `!Ref AWS::AccountId`, expected: "pseudo parameter AWS::AccountId cannot be resolved before deployment"},
+		{name: "missing mapping key", source: `!FindInMap [Regions, us-east-1, Ami]`, expected: "key us-east-1 not found in mapping Regions"},
+		{name: "unknown condition", source: `!If [Missing, a, b]`, expected: "condition Missing not found"},
+		{name: "self-referencing condition", source: `!If [Loop, a, b]`, expected: "condition Loop: condition Loop refers to itself"},
+		{name: "select out of range", source: `!Select [5, [a]]`, expected: "Fn::Select index 5 is out of range"},
+		{name: "wrong argument count", source: `!If [IsBeta, a]`, expected: "Fn::If requires 3 arguments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.resolve(parseValue(t, tt.source))
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("expected error %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestResolvePropertiesIgnoresUnrequested(t *testing.T) {
+	r := newTestResolver(t, testResolverTemplate)
+	definition := parseValue(t, `{InstanceType: !Ref Stage, Tenancy: !Ref AWS::NoValue, IamInstanceProfile: !GetAtt Profile.Arn}`)
+
+	properties, err := r.resolveProperties(definition, []string{"InstanceType", "Tenancy", "Missing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(properties, map[string]interface{}{"InstanceType": "beta"}) {
+		t.Errorf("unexpected properties %v", properties)
+	}
+
+	if _, err := r.resolveProperties(definition, []string{"IamInstanceProfile"}); err == nil {
+		t.Error("expected error for requested property that cannot be resolved")
+	}
+}
+
+func TestDecodeShortForm(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected interface{}
+	}{
+		{source: `!Ref Name`, expected: map[string]interface{}{"Ref": "Name"}},
+		{source: `!GetAtt Role.Arn`, expected: map[string]interface{}{"Fn::GetAtt": []interface{}{"Role", "Arn"}}},
+		{source: `!Sub "${A}"`, expected: map[string]interface{}{"Fn::Sub": "${A}"}},
+		{source: `!Select [0, !GetAZs ""]`, expected: map[string]interface{}{"Fn::Select": []interface{}{0, map[string]interface{}{"Fn::GetAZs": ""}}}},
+		{source: `!Ref 123`, expected: map[string]interface{}{"Ref": "123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			if value := parseValue(t, tt.source); !reflect.DeepEqual(value, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, value)
+			}
+		})
+	}
+}
+
+// parseValue decodes a single YAML value through the template parser
+func parseValue(t *testing.T, source string) interface{} {
+	t.Helper()
+	tmpl, err := parseTemplate([]byte("Resources:\n  Value: " + source))
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", source, err)
+	}
+	return tmpl.Resources["Value"]
+}
@@ -0,0 +1,75 @@
+package importers
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"shylock/internal/config"
+	"shylock/internal/models"
+)
+
+// ConfigVersion is the configuration version assigned to imported configurations
+const ConfigVersion = "1.0"
+
+// Importer translates an infrastructure-as-code file into an estimation configuration
+type Importer interface {
+	// ImportFile reads and translates the file at path
+	ImportFile(path string) (*Result, error)
+}
+
+// Result holds a configuration translated from infrastructure-as-code along
+// with the resources that could not be translated
+type Result struct {
+	Config  *models.EstimationConfig
+	Skipped []models.SkippedResource
+}
+
+// NewResult creates an empty import result with the standard configuration defaults
+func NewResult() *Result {
+	return &Result{
+		Config: &models.EstimationConfig{
+			Version:   ConfigVersion,
+			Resources: []models.ResourceSpec{},
+			Options: models.ConfigOptions{
+				Currency:  "USD",
+				TimeFrame: "monthly",
+			},
+		},
+	}
+}
+
+// AddResource adds a translated resource after validating it with the
+// configuration parser, recording it as skipped if it is not estimable
+func (r *Result) AddResource(resource models.ResourceSpec) {
+	single := &models.EstimationConfig{
+		Version:   ConfigVersion,
+		Resources: []models.ResourceSpec{resource},
+	}
+	if err := config.NewParser().ValidateConfig(single); err != nil {
+		r.Skip(resource.Name, resource.Type, fmt.Sprintf("translated properties are not valid: %s", rootCause(err)))
+		return
+	}
+
+	r.Config.Resources = append(r.Config.Resources, resource)
+}
+
+// Skip records a resource that could not be translated
+func (r *Result) Skip(name, resourceType, reason string) {
+	r.Skipped = append(r.Skipped, models.SkippedResource{
+		Name:   name,
+		Type:   resourceType,
+		Reason: reason,
+	})
+}
+
+// rootCause returns the message of the innermost wrapped error, which carries
+// the specific validation failure without the wrapping context
+func rootCause(err error) string {
+	for {
+		cause := stderrors.Unwrap(err)
+		if cause == nil {
+			return err.Error()
+		}
+		err = cause
+	}
+}
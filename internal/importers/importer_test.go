@@ -0,0 +1,89 @@
+package importers
+
+import (
+	"strings"
+	"testing"
+
+	"shylock/internal/models"
+)
+
+func TestNewResult(t *testing.T) {
+	result := NewResult()
+
+	if result.Config.Version != ConfigVersion {
+		t.Errorf("expected version %s, got %s", ConfigVersion, result.Config.Version)
+	}
+	if result.Config.Options.Currency != "USD" || result.Config.Options.TimeFrame != "monthly" {
+		t.Errorf("expected default options, got %+v", result.Config.Options)
+	}
+	if len(result.Config.Resources) != 0 || len(result.Skipped) != 0 {
+		t.Error("expected empty result")
+	}
+}
+
+func TestAddResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		resource      models.ResourceSpec
+		expectSkipped bool
+		reason        string
+	}{
+		{
+			name: "valid resource",
+			resource: models.ResourceSpec{
+				Type:       "EC2",
+				Name:       "web",
+				Region:     "us-east-1",
+				Properties: map[string]interface{}{"instanceType": "t3.micro"},
+			},
+		},
+		{
+			name: "invalid property value",
+			resource: models.ResourceSpec{
+				Type:       "RDS",
+				Name:       "db",
+				Region:     "us-east-1",
+				Properties: map[string]interface{}{"instanceClass": "db.t3.micro", "engine": "aurora-mysql"},
+			},
+			expectSkipped: true,
+			reason:        "invalid engine 'aurora-mysql'",
+		},
+		{
+			name: "missing required property",
+			resource: models.ResourceSpec{
+				Type:       "S3",
+				Name:       "bucket",
+				Region:     "us-east-1",
+				Properties: map[string]interface{}{"sizeGB": 10},
+			},
+			expectSkipped: true,
+			reason:        "missing required property 'storageClass'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewResult()
+			result.AddResource(tt.resource)
+
+			if tt.expectSkipped {
+				if len(result.Config.Resources) != 0 || len(result.Skipped) != 1 {
+					t.Fatalf("expected resource to be skipped, got %+v", result)
+				}
+				skipped := result.Skipped[0]
+				if skipped.Name != tt.resource.Name || skipped.Type != tt.resource.Type {
+					t.Errorf("unexpected skipped resource: %+v", skipped)
+				}
+				if !strings.Contains(skipped.Reason, tt.reason) {
+					t.Errorf("expected reason to contain %q, got %q", tt.reason, skipped.Reason)
+				}
+				// The reason should carry the specific failure, not the wrapping context
+				if strings.Contains(skipped.Reason, "[VALIDATION]") {
+					t.Errorf("expected concise reason, got %q", skipped.Reason)
+				}
+			} else if len(result.Config.Resources) != 1 || len(result.Skipped) != 0 {
+				t.Errorf("expected resource to be added, got %+v", result)
+			}
+		})
+	}
+}
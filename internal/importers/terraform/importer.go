@@ -0,0 +1,328 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"shylock/internal/errors"
+	"shylock/internal/importers"
+	"shylock/internal/models"
+)
+
+// plan mirrors the parts of `terraform show -json` output used for import.
+// Plans carry resources in planned_values; state files carry them in values.
+type plan struct {
+	FormatVersion string                  `json:"format_version"`
+	PlannedValues *stateValues            `json:"planned_values"`
+	Values        *stateValues            `json:"values"`
+	Variables     map[string]planVariable `json:"variables"`
+	Configuration *planConfiguration      `json:"configuration"`
+}
+
+type stateValues struct {
+	RootModule module `json:"root_module"`
+}
+
+type module struct {
+	Address      string     `json:"address"`
+	Resources    []resource `json:"resources"`
+	ChildModules []module   `json:"child_modules"`
+}
+
+type resource struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+type planVariable struct {
+	Value interface{} `json:"value"`
+}
+
+type planConfiguration struct {
+	ProviderConfig map[string]providerConfig `json:"provider_config"`
+}
+
+type providerConfig struct {
+	Name        string                `json:"name"`
+	Alias       string                `json:"alias"`
+	Expressions map[string]expression `json:"expressions"`
+}
+
+type expression struct {
+	ConstantValue interface{} `json:"constant_value"`
+	References    []string    `json:"references"`
+}
+
+// translator converts Terraform resource values into estimator properties
+type translator struct {
+	resourceType string
+	translate    func(values map[string]interface{}) (map[string]interface{}, error)
+}
+
+// translators maps supported Terraform resource types to Shylock resource types
+var translators = map[string]translator{
+	"aws_instance":        {resourceType: "EC2", translate: translateInstance},
+	"aws_lb":              {resourceType: "ALB", translate: translateLoadBalancer},
+	"aws_alb":             {resourceType: "ALB", translate: translateLoadBalancer},
+	"aws_db_instance":     {resourceType: "RDS", translate: translateDBInstance},
+	"aws_lambda_function": {resourceType: "Lambda", translate: translateLambdaFunction},
+	"aws_s3_bucket":       {resourceType: "S3", translate: translateS3Bucket},
+}
+
+// Importer translates Terraform plan JSON into estimation configurations
+type Importer struct {
+	defaultRegion string
+}
+
+// NewImporter creates a Terraform plan importer. The default region is used
+// for resources whose region cannot be determined from the plan.
+func NewImporter(defaultRegion string) *Importer {
+	if defaultRegion == "" {
+		defaultRegion = "us-east-1"
+	}
+	return &Importer{defaultRegion: defaultRegion}
+}
+
+// ImportFile reads a `terraform show -json` file and translates its resources
+func (i *Importer) ImportFile(path string) (*importers.Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read Terraform plan", err).
+			WithContext("planFile", path).
+			WithSuggestion("Generate the plan JSON with 'terraform show -json plan.tfplan > plan.json'")
+	}
+
+	result, err := i.Import(data)
+	if err != nil {
+		return nil, errors.WrapError(err, "", "failed to import Terraform plan").
+			WithContext("planFile", path)
+	}
+
+	return result, nil
+}
+
+// Import translates Terraform plan or state JSON into an estimation configuration
+func (i *Importer) Import(data []byte) (*importers.Result, error) {
+	var tfPlan plan
+	if err := json.Unmarshal(data, &tfPlan); err != nil {
+		return nil, errors.ConfigErrorWithCause("invalid Terraform plan JSON", err).
+			WithSuggestion("Generate the plan JSON with 'terraform show -json plan.tfplan > plan.json'")
+	}
+
+	values := tfPlan.PlannedValues
+	if values == nil {
+		values = tfPlan.Values
+	}
+	if values == nil {
+		return nil, errors.ConfigError("Terraform JSON contains no planned values").
+			WithSuggestion("Use the output of 'terraform show -json' for a saved plan or state")
+	}
+
+	providerRegion := i.providerRegion(&tfPlan)
+	result := importers.NewResult()
+
+	for _, res := range collectResources(values.RootModule) {
+		// Data sources are read-only lookups with no cost
+		if res.Mode == "data" {
+			continue
+		}
+
+		translator, supported := translators[res.Type]
+		if !supported {
+			result.Skip(res.Address, res.Type, "resource type is not supported")
+			continue
+		}
+
+		properties, err := translator.translate(res.Values)
+		if err != nil {
+			result.Skip(res.Address, res.Type, err.Error())
+			continue
+		}
+
+		region := providerRegion
+		if resourceRegion, ok := res.Values["region"].(string); ok && resourceRegion != "" {
+			region = resourceRegion
+		}
+
+		result.AddResource(models.ResourceSpec{
+			Type:       translator.resourceType,
+			Name:       res.Address,
+			Region:     region,
+			Properties: properties,
+		})
+	}
+
+	if len(result.Config.Resources) == 0 {
+		return nil, errors.ValidationError("no supported resources found in Terraform plan").
+			WithContext("skippedResources", len(result.Skipped)).
+			WithSuggestion(fmt.Sprintf("Supported resource types: %s", strings.Join(SupportedResourceTypes(), ", ")))
+	}
+
+	return result, nil
+}
+
+// SupportedResourceTypes returns the Terraform resource types that can be imported
+func SupportedResourceTypes() []string {
+	types := make([]string, 0, len(translators))
+	for tfType := range translators {
+		types = append(types, tfType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// providerRegion resolves the default AWS provider region, following a
+// single variable reference if the region is not a constant
+func (i *Importer) providerRegion(tfPlan *plan) string {
+	if tfPlan.Configuration == nil {
+		return i.defaultRegion
+	}
+
+	provider, exists := tfPlan.Configuration.ProviderConfig["aws"]
+	if !exists {
+		return i.defaultRegion
+	}
+
+	regionExpr, exists := provider.Expressions["region"]
+	if !exists {
+		return i.defaultRegion
+	}
+
+	if region, ok := regionExpr.ConstantValue.(string); ok && region != "" {
+		return region
+	}
+
+	for _, reference := range regionExpr.References {
+		if name, isVar := strings.CutPrefix(reference, "var."); isVar {
+			if region, ok := tfPlan.Variables[name].Value.(string); ok && region != "" {
+				return region
+			}
+		}
+	}
+
+	return i.defaultRegion
+}
+
+// collectResources flattens the resources of a module and its child modules
+func collectResources(mod module) []resource {
+	resources := append([]resource{}, mod.Resources...)
+	for _, child := range mod.ChildModules {
+		resources = append(resources, collectResources(child)...)
+	}
+	return resources
+}
+
+// translateInstance maps aws_instance values to EC2 properties
+func translateInstance(values map[string]interface{}) (map[string]interface{}, error) {
+	instanceType, err := requiredString(values, "instance_type")
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{
+		"instanceType": instanceType,
+	}
+
+	tenancies := map[string]string{
+		"default":   "Shared",
+		"dedicated": "Dedicated",
+		"host":      "Host",
+	}
+	if tenancy, ok := values["tenancy"].(string); ok && tenancies[tenancy] != "" {
+		properties["tenancy"] = tenancies[tenancy]
+	}
+
+	return properties, nil
+}
+
+// translateLoadBalancer maps aws_lb values to ALB properties
+func translateLoadBalancer(values map[string]interface{}) (map[string]interface{}, error) {
+	lbType := "application" // Terraform default
+	if value, ok := values["load_balancer_type"].(string); ok && value != "" {
+		lbType = value
+	}
+
+	if lbType != "application" && lbType != "network" {
+		return nil, fmt.Errorf("load balancer type '%s' is not supported", lbType)
+	}
+
+	return map[string]interface{}{
+		"type": lbType,
+	}, nil
+}
+
+// translateDBInstance maps aws_db_instance values to RDS properties
+func translateDBInstance(values map[string]interface{}) (map[string]interface{}, error) {
+	instanceClass, err := requiredString(values, "instance_class")
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := requiredString(values, "engine")
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{
+		"instanceClass": instanceClass,
+		"engine":        engine,
+	}
+
+	if storage, ok := values["allocated_storage"].(float64); ok && storage > 0 {
+		properties["storageGB"] = int(storage)
+	}
+	if multiAZ, ok := values["multi_az"].(bool); ok {
+		properties["multiAZ"] = multiAZ
+	}
+	if encrypted, ok := values["storage_encrypted"].(bool); ok {
+		properties["encrypted"] = encrypted
+	}
+	if storageType, ok := values["storage_type"].(string); ok && storageType != "" {
+		properties["storageType"] = storageType
+	}
+
+	return properties, nil
+}
+
+// translateLambdaFunction maps aws_lambda_function values to Lambda properties
+func translateLambdaFunction(values map[string]interface{}) (map[string]interface{}, error) {
+	memoryMB := 128 // Terraform default
+	if memory, ok := values["memory_size"].(float64); ok && memory > 0 {
+		memoryMB = int(memory)
+	}
+
+	properties := map[string]interface{}{
+		"memoryMB": memoryMB,
+	}
+
+	if architectures, ok := values["architectures"].([]interface{}); ok && len(architectures) > 0 {
+		if architecture, ok := architectures[0].(string); ok {
+			properties["architecture"] = architecture
+		}
+	}
+
+	return properties, nil
+}
+
+// translateS3Bucket maps aws_s3_bucket values to S3 properties. Buckets have
+// no storage class of their own, so objects are assumed to be STANDARD.
+func translateS3Bucket(values map[string]interface{}) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"storageClass": "STANDARD",
+	}, nil
+}
+
+// requiredString returns a string attribute that must be known at plan time
+func requiredString(values map[string]interface{}, key string) (string, error) {
+	value, ok := values[key].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%s is not known until apply", key)
+	}
+	return value, nil
+}
@@ -0,0 +1,348 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"shylock/internal/errors"
+	"shylock/internal/models"
+)
+
+const testPlan = `{
+  "format_version": "1.2",
+  "terraform_version": "1.9.0",
+  "variables": {
+    "aws_region": {"value": "eu-west-1"}
+  },
+  "planned_values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_instance.web[0]",
+          "mode": "managed",
+          "type": "aws_instance",
+          "name": "web",
+          "index": 0,
+          "values": {"instance_type": "t3.micro", "tenancy": "default"}
+        },
+        {
+          "address": "aws_lb.public",
+          "mode": "managed",
+          "type": "aws_lb",
+          "name": "public",
+          "values": {"load_balancer_type": "network"}
+        },
+        {
+          "address": "aws_db_instance.main",
+          "mode": "managed",
+          "type": "aws_db_instance",
+          "name": "main",
+          "values": {
+            "instance_class": "db.t3.medium",
+            "engine": "postgres",
+            "allocated_storage": 100,
+            "multi_az": true,
+            "storage_encrypted": true,
+            "storage_type": "gp3"
+          }
+        },
+        {
+          "address": "aws_lambda_function.api",
+          "mode": "managed",
+          "type": "aws_lambda_function",
+          "name": "api",
+          "values": {"memory_size": 512, "architectures": ["arm64"], "region": "us-west-2"}
+        },
+        {
+          "address": "aws_s3_bucket.assets",
+          "mode": "managed",
+          "type": "aws_s3_bucket",
+          "name": "assets",
+          "values": {"bucket": "assets"}
+        },
+        {
+          "address": "aws_instance.pending",
+          "mode": "managed",
+          "type": "aws_instance",
+          "name": "pending",
+          "values": {}
+        },
+        {
+          "address": "aws_db_instance.aurora",
+          "mode": "managed",
+          "type": "aws_db_instance",
+          "name": "aurora",
+          "values": {"instance_class": "db.r6g.large", "engine": "aurora-mysql"}
+        },
+        {
+          "address": "aws_iam_role.lambda",
+          "mode": "managed",
+          "type": "aws_iam_role",
+          "name": "lambda",
+          "values": {}
+        },
+        {
+          "address": "data.aws_ami.ubuntu",
+          "mode": "data",
+          "type": "aws_ami",
+          "name": "ubuntu",
+          "values": {}
+        }
+      ],
+      "child_modules": [
+        {
+          "address": "module.workers",
+          "resources": [
+            {
+              "address": "module.workers.aws_instance.worker",
+              "mode": "managed",
+              "type": "aws_instance",
+              "name": "worker",
+              "values": {"instance_type": "c5.large", "tenancy": "dedicated"}
+            }
+          ]
+        }
+      ]
+    }
+  },
+  "configuration": {
+    "provider_config": {
+      "aws": {
+        "name": "aws",
+        "expressions": {
+          "region": {"references": ["var.aws_region"]}
+        }
+      }
+    }
+  }
+}`
+
+func TestImport(t *testing.T) {
+	result, err := NewImporter("").Import([]byte(testPlan))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resources := make(map[string]models.ResourceSpec)
+	for _, resource := range result.Config.Resources {
+		resources[resource.Name] = resource
+	}
+
+	tests := []struct {
+		name         string
+		resourceType string
+		region       string
+		properties   map[string]interface{}
+	}{
+		{
+			name:         "aws_instance.web[0]",
+			resourceType: "EC2",
+			region:       "eu-west-1",
+			properties:   map[string]interface{}{"instanceType": "t3.micro", "tenancy": "Shared"},
+		},
+		{
+			name:         "aws_lb.public",
+			resourceType: "ALB",
+			region:       "eu-west-1",
+			properties:   map[string]interface{}{"type": "network"},
+		},
+		{
+			name:         "aws_db_instance.main",
+			resourceType: "RDS",
+			region:       "eu-west-1",
+			properties: map[string]interface{}{
+				"instanceClass": "db.t3.medium",
+				"engine":        "postgres",
+				"storageGB":     100,
+				"multiAZ":       true,
+				"encrypted":     true,
+				"storageType":   "gp3",
+			},
+		},
+		{
+			name:         "aws_lambda_function.api",
+			resourceType: "Lambda",
+			region:       "us-west-2",
+			properties:   map[string]interface{}{"memoryMB": 512, "architecture": "arm64"},
+		},
+		{
+			name:         "aws_s3_bucket.assets",
+			resourceType: "S3",
+			region:       "eu-west-1",
+			properties:   map[string]interface{}{"storageClass": "STANDARD"},
+		},
+		{
+			name:         "module.workers.aws_instance.worker",
+			resourceType: "EC2",
+			region:       "eu-west-1",
+			properties:   map[string]interface{}{"instanceType": "c5.large", "tenancy": "Dedicated"},
+		},
+	}
+
+	if len(result.Config.Resources) != len(tests) {
+		t.Errorf("expected %d resources, got %d", len(tests), len(result.Config.Resources))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, exists := resources[tt.name]
+			if !exists {
+				t.Fatalf("resource %s not imported", tt.name)
+			}
+			if resource.Type != tt.resourceType {
+				t.Errorf("expected type %s, got %s", tt.resourceType, resource.Type)
+			}
+			if resource.Region != tt.region {
+				t.Errorf("expected region %s, got %s", tt.region, resource.Region)
+			}
+			if len(resource.Properties) != len(tt.properties) {
+				t.Errorf("expected properties %v, got %v", tt.properties, resource.Properties)
+			}
+			for key, expected := range tt.properties {
+				if resource.Properties[key] != expected {
+					t.Errorf("expected %s=%v, got %v", key, expected, resource.Properties[key])
+				}
+			}
+		})
+	}
+
+	skipped := make(map[string]models.SkippedResource)
+	for _, skip := range result.Skipped {
+		skipped[skip.Name] = skip
+	}
+
+	expectedSkipped := []string{"aws_instance.pending", "aws_db_instance.aurora", "aws_iam_role.lambda"}
+	if len(result.Skipped) != len(expectedSkipped) {
+		t.Errorf("expected %d skipped resources, got %+v", len(expectedSkipped), result.Skipped)
+	}
+	for _, name := range expectedSkipped {
+		if skip, exists := skipped[name]; !exists || skip.Reason == "" {
+			t.Errorf("expected %s to be skipped with a reason, got %+v", name, skip)
+		}
+	}
+
+	if result.Config.Version == "" || result.Config.Options.Currency != "USD" {
+		t.Errorf("expected configuration defaults, got %+v", result.Config)
+	}
+}
+
+func TestImportRegionResolution(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		defaultRegion string
+		expected      string
+	}{
+		{
+			name:          "constant provider region",
+			configuration: `{"provider_config": {"aws": {"expressions": {"region": {"constant_value": "ap-southeast-2"}}}}}`,
+			expected:      "ap-southeast-2",
+		},
+		{
+			name:          "no provider configuration",
+			configuration: `null`,
+			defaultRegion: "ca-central-1",
+			expected:      "ca-central-1",
+		},
+		{
+			name:          "unresolvable reference",
+			configuration: `{"provider_config": {"aws": {"expressions": {"region": {"references": ["local.region"]}}}}}`,
+			expected:      "us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planJSON := `{
+  "planned_values": {"root_module": {"resources": [
+    {"address": "aws_instance.web", "mode": "managed", "type": "aws_instance", "values": {"instance_type": "t3.micro"}}
+  ]}},
+  "configuration": ` + tt.configuration + `
+}`
+			result, err := NewImporter(tt.defaultRegion).Import([]byte(planJSON))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if region := result.Config.Resources[0].Region; region != tt.expected {
+				t.Errorf("expected region %s, got %s", tt.expected, region)
+			}
+		})
+	}
+}
+
+func TestImportState(t *testing.T) {
+	stateJSON := `{"values": {"root_module": {"resources": [
+    {"address": "aws_s3_bucket.logs", "mode": "managed", "type": "aws_s3_bucket", "values": {}}
+  ]}}}`
+
+	result, err := NewImporter("").Import([]byte(stateJSON))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Config.Resources) != 1 || result.Config.Resources[0].Type != "S3" {
+		t.Errorf("expected S3 resource from state values, got %+v", result.Config.Resources)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		errorType errors.ErrorType
+	}{
+		{
+			name:      "invalid JSON",
+			data:      `{"planned_values": `,
+			errorType: errors.ConfigErrorType,
+		},
+		{
+			name:      "no planned values",
+			data:      `{"format_version": "1.2"}`,
+			errorType: errors.ConfigErrorType,
+		},
+		{
+			name:      "no supported resources",
+			data:      `{"planned_values": {"root_module": {"resources": [{"address": "aws_iam_role.x", "mode": "managed", "type": "aws_iam_role", "values": {}}]}}}`,
+			errorType: errors.ValidationErrorType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewImporter("").Import([]byte(tt.data))
+			if !errors.IsErrorType(err, tt.errorType) {
+				t.Errorf("expected %s error, got %v", tt.errorType, err)
+			}
+		})
+	}
+}
+
+func TestImportFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "plan.json")
+	if err := os.WriteFile(path, []byte(testPlan), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	if _, err := NewImporter("").ImportFile(path); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err := NewImporter("").ImportFile(filepath.Join(dir, "missing.json"))
+	if !errors.IsErrorType(err, errors.FileErrorType) {
+		t.Errorf("expected file error, got %v", err)
+	}
+}
+
+func TestSupportedResourceTypes(t *testing.T) {
+	types := SupportedResourceTypes()
+	if len(types) != len(translators) {
+		t.Errorf("expected %d types, got %d", len(translators), len(types))
+	}
+	for i := 1; i < len(types); i++ {
+		if types[i-1] > types[i] {
+			t.Errorf("expected sorted types, got %v", types)
+		}
+	}
+}
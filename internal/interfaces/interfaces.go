@@ -2,8 +2,10 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // ResourceEstimator defines the interface for estimating costs of AWS resources
@@ -30,6 +32,14 @@ type AWSPricingClient interface {
 	GetRegions(ctx context.Context, serviceCode string) ([]string, error)
 }
 
+// SpotPriceSource defines the interface for retrieving EC2 Spot price history.
+// Spot prices are not published through the AWS Pricing API.
+type SpotPriceSource interface {
+	// GetSpotPrices returns the price history for an instance type and product
+	// description (e.g. "Linux/UNIX") across the availability zones of a region
+	GetSpotPrices(ctx context.Context, instanceType, region, productDescription string) ([]SpotPrice, error)
+}
+
 // ConfigParser defines the interface for parsing configuration files
 type ConfigParser interface {
 	// ParseConfig reads and parses a configuration file
@@ -72,6 +82,15 @@ type PricingProduct struct {
 	Terms         map[string]interface{} `json:"terms"`
 }
 
+// SpotPrice represents a single EC2 Spot price history entry
+type SpotPrice struct {
+	AvailabilityZone   string       `json:"availabilityZone"`
+	InstanceType       string       `json:"instanceType"`
+	ProductDescription string       `json:"productDescription"`
+	Price              money.Amount `json:"price"`
+	Timestamp          time.Time    `json:"timestamp"`
+}
+
 // ServiceInfo represents information about an AWS service
 type ServiceInfo struct {
 	ServiceCode string            `json:"serviceCode"`
@@ -2,7 +2,10 @@ package models
 
 import (
 	"fmt"
+	"sort"
 	"time"
+
+	"shylock/internal/money"
 )
 
 // ResourceSpec represents a single AWS resource configuration
@@ -22,33 +25,160 @@ type EstimationConfig struct {
 
 // ConfigOptions represents optional configuration settings
 type ConfigOptions struct {
-	DefaultRegion string `json:"defaultRegion,omitempty"`
-	Currency      string `json:"currency,omitempty"`
-	TimeFrame     string `json:"timeFrame,omitempty"`
+	DefaultRegion string        `json:"defaultRegion,omitempty"`
+	Currency      string        `json:"currency,omitempty"`
+	TimeFrame     string        `json:"timeFrame,omitempty"` // Totals emphasized in the output: hourly, daily, monthly or annual
+	Month         string        `json:"month,omitempty"`     // Calendar month monthly costs cover, e.g. "2026-02"
+	Budget        *Budget       `json:"budget,omitempty"`
+	SavingsPlans  *SavingsPlans `json:"savingsPlans,omitempty"`
+	ApplyFreeTier bool          `json:"applyFreeTier,omitempty"` // Subtract the account-wide AWS Free Tier allowances
+}
+
+// Budget defines monthly cost ceilings checked after estimation
+type Budget struct {
+	MaxMonthly    float64            `json:"maxMonthly,omitempty"`    // Ceiling for the total monthly cost
+	ResourceTypes map[string]float64 `json:"resourceTypes,omitempty"` // Resource type -> monthly ceiling
+	Resources     map[string]float64 `json:"resources,omitempty"`     // Resource name -> monthly ceiling
+}
+
+// Budget violation scopes
+const (
+	BudgetScopeTotal        = "total"
+	BudgetScopeResourceType = "resourceType"
+	BudgetScopeResource     = "resource"
+)
+
+// BudgetViolation represents a monthly cost that exceeds a budget ceiling
+type BudgetViolation struct {
+	Scope     string       `json:"scope"`
+	Target    string       `json:"target,omitempty"` // Resource type or name; empty for the total
+	Threshold money.Amount `json:"threshold"`
+	Actual    money.Amount `json:"actual"`
+	Overage   money.Amount `json:"overage"`
+}
+
+// Savings Plan types
+const (
+	SavingsPlanTypeCompute     = "Compute"
+	SavingsPlanTypeEC2Instance = "EC2Instance"
+)
+
+// SavingsPlans describes a Savings Plan commitment applied after estimation
+type SavingsPlans struct {
+	Type             string             `json:"type"`                     // Compute or EC2Instance
+	HourlyCommitment float64            `json:"hourlyCommitment"`         // Committed spend per hour, at Savings Plan rates
+	InstanceFamily   string             `json:"instanceFamily,omitempty"` // EC2Instance plans only, e.g. "m5"
+	Region           string             `json:"region,omitempty"`         // EC2Instance plans only
+	Discounts        map[string]float64 `json:"discounts,omitempty"`      // Resource type -> discount off on-demand, in percent
+}
+
+// SavingsPlansCoverage summarizes how a Savings Plan commitment was applied.
+// All amounts are hourly.
+type SavingsPlansCoverage struct {
+	Type              string       `json:"type"`
+	HourlyCommitment  money.Amount `json:"hourlyCommitment"`
+	CoveredOnDemand   money.Amount `json:"coveredOnDemand"`   // On-demand value of the usage the plan covers
+	UsedCommitment    money.Amount `json:"usedCommitment"`    // Commitment consumed at Savings Plan rates
+	UnusedCommitment  money.Amount `json:"unusedCommitment"`  // Commitment paid for but not used
+	UncoveredOnDemand money.Amount `json:"uncoveredOnDemand"` // Eligible usage billed at on-demand rates
+	Utilization       float64      `json:"utilization"`       // Percent of the commitment used
+	Coverage          float64      `json:"coverage"`          // Percent of eligible on-demand spend covered
+	NetSavings        money.Amount `json:"netSavings"`        // Savings after paying for unused commitment
+}
+
+// ExchangeRate records the exchange rate an estimation result was converted with
+type ExchangeRate struct {
+	From          string  `json:"from"`
+	To            string  `json:"to"`
+	Rate          float64 `json:"rate"`             // Units of To per unit of From
+	EffectiveDate string  `json:"effectiveDate"`    // Date the rate was published
+	Source        string  `json:"source,omitempty"` // Exchange rates file
+}
+
+// Free Tier allowances. Each is shared by every resource in an account.
+const (
+	FreeTierEC2MicroLinux   = "EC2MicroLinux"   // Linux t2.micro and t3.micro instance hours
+	FreeTierEC2MicroWindows = "EC2MicroWindows" // Windows t2.micro and t3.micro instance hours
+	FreeTierLambdaRequests  = "LambdaRequests"  // Lambda requests
+	FreeTierLambdaCompute   = "LambdaCompute"   // Lambda GB-seconds
+	FreeTierS3Storage       = "S3Storage"       // S3 Standard GB-months
+	FreeTierRDSMicro        = "RDSMicro"        // db.t3.micro Single-AZ instance hours
+	FreeTierRDSStorage      = "RDSStorage"      // RDS General Purpose storage GB-months
+	FreeTierDataTransfer    = "DataTransfer"    // GB of data transfer out to the internet
+)
+
+// FreeTierUsage is monthly usage of a resource that a Free Tier allowance can cover
+type FreeTierUsage struct {
+	Allowance           string       // One of the FreeTier allowances
+	Quantity            float64      // Monthly usage, in the unit of the allowance
+	UnitPrice           money.Amount // On-demand price per unit
+	SavingsPlanEligible bool         // Whether the usage is part of SavingsPlanEligibleCost
 }
 
+// Hours used for monthly and annual costs. AWS bills and prices monthly
+// usage on a 730-hour month, a twelfth of a 365-day year.
+const (
+	HoursPerYear  = 365 * 24
+	HoursPerMonth = HoursPerYear / 12
+)
+
+// Time frames the output can emphasize
+const (
+	TimeFrameHourly  = "hourly"
+	TimeFrameDaily   = "daily"
+	TimeFrameMonthly = "monthly"
+	TimeFrameAnnual  = "annual"
+)
+
+// TimeFrames lists the supported time frames, shortest first
+var TimeFrames = []string{TimeFrameHourly, TimeFrameDaily, TimeFrameMonthly, TimeFrameAnnual}
+
 // CostEstimate represents the cost estimation result for a resource
 type CostEstimate struct {
-	ResourceName string            `json:"resourceName"`
-	ResourceType string            `json:"resourceType"`
-	Region       string            `json:"region"`
-	HourlyCost   float64           `json:"hourlyCost"`
-	DailyCost    float64           `json:"dailyCost"`
-	MonthlyCost  float64           `json:"monthlyCost"`
-	Currency     string            `json:"currency"`
-	Assumptions  []string          `json:"assumptions,omitempty"`
-	Details      map[string]string `json:"details,omitempty"`
-	Timestamp    time.Time         `json:"timestamp"`
+	ResourceName            string            `json:"resourceName"`
+	ResourceType            string            `json:"resourceType"`
+	Region                  string            `json:"region"`
+	HourlyCost              money.Amount      `json:"hourlyCost"`
+	DailyCost               money.Amount      `json:"dailyCost"`
+	MonthlyCost             money.Amount      `json:"monthlyCost"`
+	AnnualCost              money.Amount      `json:"annualCost"`
+	UpfrontCost             money.Amount      `json:"upfrontCost,omitzero"` // One-time fees, already amortized into the hourly cost
+	SavingsPlanEligibleCost money.Amount      `json:"-"`                    // Hourly cost billed at on-demand rates that a Savings Plan can cover
+	HourlyBilledCost        money.Amount      `json:"-"`                    // Part of the hourly cost billed per hour of running time rather than per unit of monthly usage
+	ScheduledCost           money.Amount      `json:"-"`                    // Part of HourlyBilledCost billed only while Schedule runs
+	Schedule                *Schedule         `json:"-"`                    // When the scheduled cost runs; nil for resources running 24/7
+	FreeTierUsage           []FreeTierUsage   `json:"-"`                    // Usage the Free Tier can cover when it is applied
+	Currency                string            `json:"currency"`
+	Assumptions             []string          `json:"assumptions,omitempty"`
+	Details                 map[string]string `json:"details,omitempty"`
+	Timestamp               time.Time         `json:"timestamp"`
 }
 
 // EstimationResult represents the complete estimation result
 type EstimationResult struct {
-	TotalHourlyCost  float64        `json:"totalHourlyCost"`
-	TotalDailyCost   float64        `json:"totalDailyCost"`
-	TotalMonthlyCost float64        `json:"totalMonthlyCost"`
-	Currency         string         `json:"currency"`
-	ResourceCosts    []CostEstimate `json:"resourceCosts"`
-	GeneratedAt      time.Time      `json:"generatedAt"`
+	TotalHourlyCost  money.Amount          `json:"totalHourlyCost"`
+	TotalDailyCost   money.Amount          `json:"totalDailyCost"`
+	TotalMonthlyCost money.Amount          `json:"totalMonthlyCost"`
+	TotalAnnualCost  money.Amount          `json:"totalAnnualCost"`
+	TotalUpfrontCost money.Amount          `json:"totalUpfrontCost,omitzero"`
+	Currency         string                `json:"currency"`
+	TimeFrame        string                `json:"timeFrame,omitempty"`     // Totals emphasized in the output
+	Month            string                `json:"month,omitempty"`         // Calendar month monthly costs cover; empty for a standard month
+	HoursPerMonth    float64               `json:"hoursPerMonth,omitempty"` // Hours in the month monthly costs cover
+	ResourceCosts    []CostEstimate        `json:"resourceCosts"`
+	Skipped          []SkippedResource     `json:"skipped,omitempty"`
+	BudgetViolations []BudgetViolation     `json:"budgetViolations,omitempty"`
+	SavingsPlans     *SavingsPlansCoverage `json:"savingsPlans,omitempty"`
+	FreeTierCredit   money.Amount          `json:"freeTierCredit,omitzero"` // Monthly Free Tier credit, already subtracted from the totals
+	ExchangeRate     *ExchangeRate         `json:"exchangeRate,omitempty"`  // Conversion from USD prices; unset for USD
+	GeneratedAt      time.Time             `json:"generatedAt"`
+}
+
+// SkippedResource represents an imported resource that could not be estimated
+type SkippedResource struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
 }
 
 // Validate performs basic validation on ResourceSpec
@@ -86,6 +216,57 @@ func (c *EstimationConfig) Validate() error {
 	return nil
 }
 
+// Check compares an estimation result against the budget and returns every
+// ceiling that is exceeded: the total first, then resource types and
+// resources in name order
+func (b *Budget) Check(result *EstimationResult) []BudgetViolation {
+	if b == nil || result == nil {
+		return nil
+	}
+
+	var violations []BudgetViolation
+	check := func(scope, target string, ceiling float64, actual money.Amount) {
+		threshold := money.NewAmount(ceiling)
+		if threshold.Sign() > 0 && actual.Cmp(threshold) > 0 {
+			violations = append(violations, BudgetViolation{
+				Scope:     scope,
+				Target:    target,
+				Threshold: threshold,
+				Actual:    actual,
+				Overage:   actual.Sub(threshold),
+			})
+		}
+	}
+
+	check(BudgetScopeTotal, "", b.MaxMonthly, result.TotalMonthlyCost)
+
+	typeCosts := make(map[string]money.Amount)
+	resourceCosts := make(map[string]money.Amount)
+	for _, cost := range result.ResourceCosts {
+		typeCosts[cost.ResourceType] = typeCosts[cost.ResourceType].Add(cost.MonthlyCost)
+		resourceCosts[cost.ResourceName] = resourceCosts[cost.ResourceName].Add(cost.MonthlyCost)
+	}
+
+	for _, resourceType := range sortedKeys(b.ResourceTypes) {
+		check(BudgetScopeResourceType, resourceType, b.ResourceTypes[resourceType], typeCosts[resourceType])
+	}
+	for _, name := range sortedKeys(b.Resources) {
+		check(BudgetScopeResource, name, b.Resources[name], resourceCosts[name])
+	}
+
+	return violations
+}
+
+// sortedKeys returns the keys of a ceiling map in sorted order
+func sortedKeys(ceilings map[string]float64) []string {
+	keys := make([]string, 0, len(ceilings))
+	for key := range ceilings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // GetProperty safely retrieves a property from ResourceSpec
 func (r *ResourceSpec) GetProperty(key string) (interface{}, bool) {
 	value, exists := r.Properties[key]
@@ -125,10 +306,76 @@ func (r *ResourceSpec) GetIntProperty(key string) (int, error) {
 	}
 }
 
-// CalculateCosts calculates daily and monthly costs from hourly cost
+// GetFloatProperty retrieves a numeric property that may be fractional
+func (r *ResourceSpec) GetFloatProperty(key string) (float64, error) {
+	value, exists := r.Properties[key]
+	if !exists {
+		return 0, fmt.Errorf("property '%s' not found", key)
+	}
+
+	switch v := value.(type) {
+	case int:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("property '%s' is not a number", key)
+	}
+}
+
+// CalculateCosts calculates daily, monthly and annual costs from hourly cost
 func (c *CostEstimate) CalculateCosts() {
-	c.DailyCost = c.HourlyCost * 24
-	c.MonthlyCost = c.HourlyCost * 24 * 30 // Approximate month
+	c.DailyCost = c.HourlyCost.MulFloat(24)
+	c.MonthlyCost = c.HourlyCost.MulFloat(HoursPerMonth)
+	c.AnnualCost = c.HourlyCost.MulFloat(HoursPerYear)
+}
+
+// Cost returns the cost for a time frame, or the monthly cost for an unknown one
+func (c *CostEstimate) Cost(timeFrame string) money.Amount {
+	switch timeFrame {
+	case TimeFrameHourly:
+		return c.HourlyCost
+	case TimeFrameDaily:
+		return c.DailyCost
+	case TimeFrameAnnual:
+		return c.AnnualCost
+	default:
+		return c.MonthlyCost
+	}
+}
+
+// TotalCost returns the total cost for a time frame, or the monthly total for an unknown one
+func (r *EstimationResult) TotalCost(timeFrame string) money.Amount {
+	switch timeFrame {
+	case TimeFrameHourly:
+		return r.TotalHourlyCost
+	case TimeFrameDaily:
+		return r.TotalDailyCost
+	case TimeFrameAnnual:
+		return r.TotalAnnualCost
+	default:
+		return r.TotalMonthlyCost
+	}
+}
+
+// EmphasizedTimeFrame returns the time frame the output emphasizes, monthly by default
+func (r *EstimationResult) EmphasizedTimeFrame() string {
+	for _, timeFrame := range TimeFrames {
+		if r.TimeFrame == timeFrame {
+			return timeFrame
+		}
+	}
+	return TimeFrameMonthly
+}
+
+// HoursInMonth returns the number of hours in a calendar month given as
+// YYYY-MM, e.g. 672 for "2026-02"
+func HoursInMonth(month string) (float64, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return 0, fmt.Errorf("month '%s' is not in YYYY-MM format", month)
+	}
+	return start.AddDate(0, 1, 0).Sub(start).Hours(), nil
 }
 
 // AddAssumption adds an assumption to the cost estimate
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"testing"
 	"time"
+
+	"shylock/internal/money"
 )
 
 func TestResourceSpecValidation(t *testing.T) {
@@ -252,22 +254,77 @@ func TestCostEstimateCalculations(t *testing.T) {
 		ResourceName: "test-resource",
 		ResourceType: "EC2",
 		Region:       "us-east-1",
-		HourlyCost:   0.0116, // t3.micro hourly cost
+		HourlyCost:   money.NewAmount(0.0116), // t3.micro hourly cost
 		Currency:     "USD",
 		Timestamp:    time.Now(),
 	}
 
 	estimate.CalculateCosts()
 
-	expectedDaily := 0.0116 * 24
-	expectedMonthly := 0.0116 * 24 * 30
+	// Costs are exact, with no floating point drift
+	expectedDaily := "0.2784"
+	expectedMonthly := "8.468"
+	expectedAnnual := "101.616"
+
+	if estimate.DailyCost.String() != expectedDaily {
+		t.Errorf("expected daily cost %s, got %s", expectedDaily, estimate.DailyCost)
+	}
+
+	if estimate.MonthlyCost.String() != expectedMonthly {
+		t.Errorf("expected monthly cost %s, got %s", expectedMonthly, estimate.MonthlyCost)
+	}
+
+	if estimate.AnnualCost.String() != expectedAnnual {
+		t.Errorf("expected annual cost %s, got %s", expectedAnnual, estimate.AnnualCost)
+	}
+
+	if !estimate.Cost(TimeFrameAnnual).Equal(estimate.AnnualCost) || !estimate.Cost("").Equal(estimate.MonthlyCost) {
+		t.Errorf("expected Cost to select the time frame")
+	}
+}
+
+func TestHoursInMonth(t *testing.T) {
+	tests := []struct {
+		month       string
+		expected    float64
+		expectError bool
+	}{
+		{month: "2026-02", expected: 672},
+		{month: "2024-02", expected: 696}, // Leap year
+		{month: "2026-04", expected: 720},
+		{month: "2026-03", expected: 744},
+		{month: "2026-13", expectError: true},
+		{month: "Feb 2026", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.month, func(t *testing.T) {
+			hours, err := HoursInMonth(tt.month)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error for month %s", tt.month)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hours != tt.expected {
+				t.Errorf("expected %g hours, got %g", tt.expected, hours)
+			}
+		})
+	}
+}
 
-	if estimate.DailyCost != expectedDaily {
-		t.Errorf("expected daily cost %.4f, got %.4f", expectedDaily, estimate.DailyCost)
+func TestEmphasizedTimeFrame(t *testing.T) {
+	result := &EstimationResult{TotalMonthlyCost: money.NewAmount(730), TotalAnnualCost: money.NewAmount(8760)}
+	if result.EmphasizedTimeFrame() != TimeFrameMonthly || !result.TotalCost(result.EmphasizedTimeFrame()).Equal(money.NewAmount(730)) {
+		t.Errorf("expected monthly emphasis by default")
 	}
 
-	if estimate.MonthlyCost != expectedMonthly {
-		t.Errorf("expected monthly cost %.4f, got %.4f", expectedMonthly, estimate.MonthlyCost)
+	result.TimeFrame = TimeFrameAnnual
+	if result.EmphasizedTimeFrame() != TimeFrameAnnual || !result.TotalCost(result.EmphasizedTimeFrame()).Equal(money.NewAmount(8760)) {
+		t.Errorf("expected annual emphasis")
 	}
 }
 
@@ -340,6 +397,24 @@ func TestResourceSpecPropertyAccess(t *testing.T) {
 		t.Errorf("expected 3, got %d", floatAsInt)
 	}
 
+	// Test float property
+	floatVal, err := resource.GetFloatProperty("floatProp")
+	if err != nil {
+		t.Errorf("failed to get float property: %v", err)
+	}
+	if floatVal != 3.14 {
+		t.Errorf("expected 3.14, got %g", floatVal)
+	}
+
+	// Test int as float property
+	intAsFloat, err := resource.GetFloatProperty("intProp")
+	if err != nil {
+		t.Errorf("failed to get int as float property: %v", err)
+	}
+	if intAsFloat != 42 {
+		t.Errorf("expected 42, got %g", intAsFloat)
+	}
+
 	// Test non-existent property
 	_, err = resource.GetStringProperty("nonexistent")
 	if err == nil {
@@ -352,3 +427,51 @@ func TestResourceSpecPropertyAccess(t *testing.T) {
 		t.Error("expected error for wrong property type")
 	}
 }
+
+func TestBudgetCheck(t *testing.T) {
+	result := &EstimationResult{
+		TotalMonthlyCost: money.NewAmount(600),
+		ResourceCosts: []CostEstimate{
+			{ResourceName: "web-1", ResourceType: "EC2", MonthlyCost: money.NewAmount(150)},
+			{ResourceName: "web-2", ResourceType: "EC2", MonthlyCost: money.NewAmount(150)},
+			{ResourceName: "db", ResourceType: "RDS", MonthlyCost: money.NewAmount(300)},
+		},
+	}
+
+	budget := &Budget{
+		MaxMonthly:    500,
+		ResourceTypes: map[string]float64{"RDS": 400, "EC2": 250},
+		Resources:     map[string]float64{"web-2": 100, "db": 300},
+	}
+
+	violations := budget.Check(result)
+
+	expected := []struct {
+		scope, target              string
+		threshold, actual, overage float64
+	}{
+		{scope: BudgetScopeTotal, threshold: 500, actual: 600, overage: 100},
+		{scope: BudgetScopeResourceType, target: "EC2", threshold: 250, actual: 300, overage: 50},
+		{scope: BudgetScopeResource, target: "web-2", threshold: 100, actual: 150, overage: 50},
+	}
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %+v", len(expected), violations)
+	}
+	for i, want := range expected {
+		got := violations[i]
+		if got.Scope != want.scope || got.Target != want.target || !got.Threshold.Equal(money.NewAmount(want.threshold)) ||
+			!got.Actual.Equal(money.NewAmount(want.actual)) || !got.Overage.Equal(money.NewAmount(want.overage)) {
+			t.Errorf("violation %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+
+	// A cost equal to the ceiling is within budget
+	if violations := (&Budget{MaxMonthly: 600}).Check(result); len(violations) != 0 {
+		t.Errorf("expected no violations at the ceiling, got %+v", violations)
+	}
+
+	var noBudget *Budget
+	if violations := noBudget.Check(result); violations != nil {
+		t.Errorf("expected no violations without a budget, got %+v", violations)
+	}
+}
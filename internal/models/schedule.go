@@ -0,0 +1,311 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hoursPerWeek is the number of hours in a week
+const hoursPerWeek = 7 * 24
+
+// ScheduleSuggestion shows the two forms of a valid schedule property
+const ScheduleSuggestion = `Use {"days": "weekdays", "start": "08:00", "end": "20:00"} or {"hoursPerMonth": 200}`
+
+// Schedule describes when a resource runs, for resources that are stopped
+// outside working hours. It is either a weekly window, such as weekdays from
+// 08:00 to 20:00 in a time zone, or an explicit number of hours per month.
+type Schedule struct {
+	Days          []time.Weekday // Days the window starts on
+	Start         int            // Minutes after midnight
+	End           int            // Minutes after midnight; before Start for windows that cross midnight
+	TimeZone      string         // IANA time zone the window is expressed in
+	HoursPerMonth float64        // Explicit hours per month; zero for a weekly window
+}
+
+var scheduleDayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var scheduleDayGroups = map[string][]time.Weekday{
+	"daily":    {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday},
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+}
+
+// GetSchedule retrieves the optional schedule property. It returns nil when
+// the resource has no schedule and runs 24/7.
+func (r *ResourceSpec) GetSchedule() (*Schedule, error) {
+	value, exists := r.Properties["schedule"]
+	if !exists {
+		return nil, nil
+	}
+
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("property 'schedule' is not an object")
+	}
+
+	return ParseSchedule(properties)
+}
+
+// ParseSchedule parses a schedule object, e.g.
+// {"days": "weekdays", "start": "08:00", "end": "20:00", "timeZone": "Europe/London"}
+// or {"hoursPerMonth": 200}
+func ParseSchedule(properties map[string]interface{}) (*Schedule, error) {
+	if value, exists := properties["hoursPerMonth"]; exists {
+		for _, key := range []string{"days", "start", "end", "timeZone"} {
+			if _, conflict := properties[key]; conflict {
+				return nil, fmt.Errorf("schedule cannot combine hoursPerMonth with %s", key)
+			}
+		}
+
+		hours, ok := value.(float64)
+		if intHours, isInt := value.(int); isInt {
+			hours, ok = float64(intHours), true
+		}
+		if !ok {
+			return nil, fmt.Errorf("schedule hoursPerMonth is not a number")
+		}
+		if hours <= 0 || hours > HoursPerMonth {
+			return nil, fmt.Errorf("schedule hoursPerMonth must be between 0 and %d, got %g", HoursPerMonth, hours)
+		}
+		return &Schedule{HoursPerMonth: hours}, nil
+	}
+
+	for key := range properties {
+		switch key {
+		case "days", "start", "end", "timeZone":
+		default:
+			return nil, fmt.Errorf("unknown schedule property '%s'", key)
+		}
+	}
+
+	schedule := &Schedule{
+		Days:     scheduleDayGroups["daily"],
+		End:      24 * 60,
+		TimeZone: "UTC",
+	}
+
+	if value, exists := properties["days"]; exists {
+		days, err := parseScheduleDays(value)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Days = days
+	}
+
+	_, hasStart := properties["start"]
+	_, hasEnd := properties["end"]
+	if hasStart != hasEnd {
+		return nil, fmt.Errorf("schedule needs both start and end")
+	}
+	if hasStart {
+		var err error
+		if schedule.Start, err = parseScheduleTime(properties["start"]); err != nil {
+			return nil, fmt.Errorf("invalid schedule start: %w", err)
+		}
+		if schedule.End, err = parseScheduleTime(properties["end"]); err != nil {
+			return nil, fmt.Errorf("invalid schedule end: %w", err)
+		}
+		if schedule.Start == schedule.End {
+			return nil, fmt.Errorf("schedule start and end must differ")
+		}
+	}
+
+	if value, exists := properties["timeZone"]; exists {
+		timeZone, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("schedule timeZone is not a string")
+		}
+		if _, err := time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("unknown schedule timeZone '%s'", timeZone)
+		}
+		schedule.TimeZone = timeZone
+	}
+
+	return schedule, nil
+}
+
+// parseScheduleDays parses "daily", "weekdays", "weekends" or a list of day
+// names such as ["Mon", "Wed", "Fri"]
+func parseScheduleDays(value interface{}) ([]time.Weekday, error) {
+	if group, ok := value.(string); ok {
+		days, exists := scheduleDayGroups[strings.ToLower(group)]
+		if !exists {
+			return nil, fmt.Errorf("invalid schedule days '%s'. Valid options: daily, weekdays, weekends, or a list of days", group)
+		}
+		return days, nil
+	}
+
+	names, ok := value.([]interface{})
+	if !ok || len(names) == 0 {
+		return nil, fmt.Errorf("schedule days must be daily, weekdays, weekends, or a list of days")
+	}
+
+	seen := make(map[time.Weekday]bool)
+	days := make([]time.Weekday, 0, len(names))
+	for _, name := range names {
+		dayName, _ := name.(string)
+		day, exists := scheduleDayNames[strings.ToLower(dayName)]
+		if !exists {
+			return nil, fmt.Errorf("invalid schedule day '%v'", name)
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+// parseScheduleTime parses "HH:MM" into minutes after midnight; "24:00" is
+// accepted as the end of the day
+func parseScheduleTime(value interface{}) (int, error) {
+	clock, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("time is not a string")
+	}
+
+	hourStr, minuteStr, found := strings.Cut(clock, ":")
+	hour, hourErr := strconv.Atoi(hourStr)
+	minute, minuteErr := strconv.Atoi(minuteStr)
+	if !found || hourErr != nil || minuteErr != nil || hour < 0 || minute < 0 || minute > 59 ||
+		hour > 24 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("'%s' is not a time in HH:MM format", clock)
+	}
+	return hour*60 + minute, nil
+}
+
+// WindowHours returns the length of the daily window in hours
+func (s *Schedule) WindowHours() float64 {
+	minutes := s.End - s.Start
+	if minutes <= 0 {
+		minutes += 24 * 60 // The window crosses midnight
+	}
+	return float64(minutes) / 60
+}
+
+// MonthlyHours returns the hours the resource runs in a month
+func (s *Schedule) MonthlyHours() float64 {
+	if s.HoursPerMonth > 0 {
+		return s.HoursPerMonth
+	}
+	return s.UsageFraction() * HoursPerMonth
+}
+
+// UsageFraction returns the share of the month the resource runs, between 0 and 1
+func (s *Schedule) UsageFraction() float64 {
+	if s.HoursPerMonth > 0 {
+		return s.HoursPerMonth / HoursPerMonth
+	}
+	return s.WindowHours() * float64(len(s.Days)) / hoursPerWeek
+}
+
+// HoursInMonth returns the hours the resource runs in a calendar month given
+// as YYYY-MM. Months are billed in UTC; a weekly window runs on the days of the
+// month it falls on in its time zone, so both the month's length and daylight
+// saving time changes count. An explicit number of hours per month is scaled
+// by the length of the month.
+func (s *Schedule) HoursInMonth(month string) (float64, error) {
+	monthHours, err := HoursInMonth(month)
+	if err != nil {
+		return 0, err
+	}
+	if s.HoursPerMonth > 0 {
+		return s.HoursPerMonth * monthHours / HoursPerMonth, nil
+	}
+
+	start, _ := time.Parse("2006-01", month)
+	end := start.AddDate(0, 1, 0)
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return 0, fmt.Errorf("unknown schedule timeZone '%s'", s.TimeZone)
+	}
+
+	runs := make(map[time.Weekday]bool, len(s.Days))
+	for _, day := range s.Days {
+		runs[day] = true
+	}
+	endMinutes := s.End
+	if endMinutes <= s.Start {
+		endMinutes += 24 * 60 // The window crosses midnight
+	}
+
+	// Start the day before, whose window may cross midnight into the month
+	first := start.In(location)
+	var hours float64
+	for i := -1; ; i++ {
+		day := time.Date(first.Year(), first.Month(), first.Day()+i, 0, 0, 0, 0, location)
+		if !day.Before(end) {
+			break
+		}
+		if !runs[day.Weekday()] {
+			continue
+		}
+		// Clock times in the zone, so a window on a daylight saving day is an hour shorter or longer
+		from := time.Date(day.Year(), day.Month(), day.Day(), 0, s.Start, 0, 0, location)
+		to := time.Date(day.Year(), day.Month(), day.Day(), 0, endMinutes, 0, 0, location)
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		if to.After(from) {
+			hours += to.Sub(from).Hours()
+		}
+	}
+	return hours, nil
+}
+
+// String describes the schedule, e.g.
+// "weekdays 08:00-20:00 Europe/London, 257 hours per month"
+func (s *Schedule) String() string {
+	if s.HoursPerMonth > 0 {
+		return fmt.Sprintf("%g hours per month", s.HoursPerMonth)
+	}
+	return fmt.Sprintf("%s %s-%s %s, %.0f hours per month", describeScheduleDays(s.Days),
+		formatScheduleTime(s.Start), formatScheduleTime(s.End), s.TimeZone, s.MonthlyHours())
+}
+
+func describeScheduleDays(days []time.Weekday) string {
+	for _, group := range []string{"daily", "weekdays", "weekends"} {
+		if sameDays(days, scheduleDayGroups[group]) {
+			return group
+		}
+	}
+
+	names := make([]string, len(days))
+	for i, day := range days {
+		names[i] = day.String()[:3]
+	}
+	return strings.Join(names, ",")
+}
+
+func sameDays(a, b []time.Weekday) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[time.Weekday]bool, len(a))
+	for _, day := range a {
+		set[day] = true
+	}
+	for _, day := range b {
+		if !set[day] {
+			return false
+		}
+	}
+	return true
+}
+
+func formatScheduleTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,210 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name             string
+		properties       map[string]interface{}
+		expectError      string
+		expectedFraction float64
+		expectedString   string
+	}{
+		{
+			name:             "weekdays working hours",
+			properties:       map[string]interface{}{"days": "weekdays", "start": "08:00", "end": "20:00", "timeZone": "Europe/London"},
+			expectedFraction: 12.0 * 5 / 168,
+			expectedString:   "weekdays 08:00-20:00 Europe/London, 261 hours per month",
+		},
+		{
+			name:             "list of days crossing midnight",
+			properties:       map[string]interface{}{"days": []interface{}{"Fri", "saturday"}, "start": "22:00", "end": "02:00"},
+			expectedFraction: 4.0 * 2 / 168,
+			expectedString:   "Fri,Sat 22:00-02:00 UTC, 35 hours per month",
+		},
+		{
+			name:             "whole days",
+			properties:       map[string]interface{}{"days": "weekends"},
+			expectedFraction: 2.0 / 7,
+			expectedString:   "weekends 00:00-24:00 UTC, 209 hours per month",
+		},
+		{
+			name:             "explicit hours per month",
+			properties:       map[string]interface{}{"hoursPerMonth": float64(200)},
+			expectedFraction: 200.0 / HoursPerMonth,
+			expectedString:   "200 hours per month",
+		},
+		{
+			name:        "hours per month combined with a window",
+			properties:  map[string]interface{}{"hoursPerMonth": 200, "days": "weekdays"},
+			expectError: "cannot combine hoursPerMonth with days",
+		},
+		{
+			name:        "hours per month above a month",
+			properties:  map[string]interface{}{"hoursPerMonth": 1000},
+			expectError: "hoursPerMonth must be between",
+		},
+		{
+			name:        "unknown property",
+			properties:  map[string]interface{}{"days": "weekdays", "hours": 8},
+			expectError: "unknown schedule property 'hours'",
+		},
+		{
+			name:        "invalid day group",
+			properties:  map[string]interface{}{"days": "workdays"},
+			expectError: "invalid schedule days 'workdays'",
+		},
+		{
+			name:        "invalid day name",
+			properties:  map[string]interface{}{"days": []interface{}{"Mon", "Funday"}},
+			expectError: "invalid schedule day 'Funday'",
+		},
+		{
+			name:        "start without end",
+			properties:  map[string]interface{}{"start": "08:00"},
+			expectError: "needs both start and end",
+		},
+		{
+			name:        "invalid time",
+			properties:  map[string]interface{}{"start": "8am", "end": "20:00"},
+			expectError: "invalid schedule start",
+		},
+		{
+			name:        "empty window",
+			properties:  map[string]interface{}{"start": "08:00", "end": "08:00"},
+			expectError: "start and end must differ",
+		},
+		{
+			name:        "unknown time zone",
+			properties:  map[string]interface{}{"timeZone": "Mars/Olympus_Mons"},
+			expectError: "unknown schedule timeZone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.properties)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if math.Abs(schedule.UsageFraction()-tt.expectedFraction) > 1e-9 {
+				t.Errorf("expected usage fraction %.6f, got %.6f", tt.expectedFraction, schedule.UsageFraction())
+			}
+			if math.Abs(schedule.MonthlyHours()-tt.expectedFraction*HoursPerMonth) > 1e-6 {
+				t.Errorf("expected %.2f hours per month, got %.2f", tt.expectedFraction*HoursPerMonth, schedule.MonthlyHours())
+			}
+			if schedule.String() != tt.expectedString {
+				t.Errorf("expected %q, got %q", tt.expectedString, schedule.String())
+			}
+		})
+	}
+}
+
+func TestGetSchedule(t *testing.T) {
+	resource := ResourceSpec{Properties: map[string]interface{}{}}
+	if schedule, err := resource.GetSchedule(); schedule != nil || err != nil {
+		t.Errorf("expected no schedule, got %v, %v", schedule, err)
+	}
+
+	resource.Properties["schedule"] = "weekdays"
+	if _, err := resource.GetSchedule(); err == nil {
+		t.Error("expected error for a schedule that is not an object")
+	}
+
+	resource.Properties["schedule"] = map[string]interface{}{"hoursPerMonth": 100}
+	schedule, err := resource.GetSchedule()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schedule.MonthlyHours() != 100 {
+		t.Errorf("expected 100 hours per month, got %g", schedule.MonthlyHours())
+	}
+}
+
+func TestScheduleHoursInMonth(t *testing.T) {
+	tests := []struct {
+		name          string
+		properties    map[string]interface{}
+		month         string
+		expectError   bool
+		expectedHours float64
+	}{
+		{
+			name:          "hours per month scale with the month",
+			properties:    map[string]interface{}{"hoursPerMonth": 365},
+			month:         "2026-02",
+			expectedHours: 365.0 * 672 / 730,
+		},
+		{
+			// February 2026 has 20 weekdays and March 2026 has 22; months are
+			// billed in UTC
+			name:          "weekdays in February",
+			properties:    map[string]interface{}{"days": "weekdays", "start": "08:00", "end": "20:00"},
+			month:         "2026-02",
+			expectedHours: 20 * 12,
+		},
+		{
+			// The month ends at 09:00 on Wednesday 1 April in Tokyo
+			name:          "weekdays in March",
+			properties:    map[string]interface{}{"days": "weekdays", "start": "08:00", "end": "20:00", "timeZone": "Asia/Tokyo"},
+			month:         "2026-03",
+			expectedHours: 22*12 + 1,
+		},
+		{
+			// Clocks go forward an hour at 01:00 on 29 March 2026 in London,
+			// so the month ends at 01:00 on 1 April
+			name:          "daylight saving time change",
+			properties:    map[string]interface{}{"start": "00:00", "end": "06:00", "timeZone": "Europe/London"},
+			month:         "2026-03",
+			expectedHours: 31*6 - 1 + 1,
+		},
+		{
+			// The window from 31 January runs into February, and the one on
+			// 28 February into March
+			name:          "windows crossing the month boundary",
+			properties:    map[string]interface{}{"start": "22:00", "end": "06:00"},
+			month:         "2026-02",
+			expectedHours: 6 + 27*8 + 2,
+		},
+		{
+			name:        "invalid month",
+			properties:  map[string]interface{}{"days": "weekdays"},
+			month:       "February",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.properties)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			hours, err := schedule.HoursInMonth(tt.month)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error, got %g hours", hours)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(hours-tt.expectedHours) > 1e-9 {
+				t.Errorf("expected %g hours, got %g", tt.expectedHours, hours)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DivisionDecimals is the number of decimals a quotient is rounded to, half
+// to even. Division is the only arithmetic that rounds; sums and products of
+// amounts are exact.
+const DivisionDecimals = 16
+
+// MaxExponent bounds the exponent of a parsed amount, e.g. "1.5E-7". Prices
+// need nowhere near it, and an unbounded exponent would make parsing
+// arbitrarily slow.
+const MaxExponent = 30
+
+// JSONDecimals is the number of decimals amounts are rounded to, half away
+// from zero, in JSON output
+const JSONDecimals = 10
+
+// Amount is an exact decimal amount of money. Prices are parsed into amounts
+// without loss and sums are exact, so a total always equals the sum of its
+// line items. Amounts are only rounded to a display precision when they are
+// formatted. The zero value is zero.
+type Amount struct {
+	coef  *big.Int // Value is coef / 10^scale; nil is zero
+	scale int32
+}
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+)
+
+// NewAmount returns the amount with the shortest decimal representation of a
+// float, so that NewAmount(0.1) is exactly one tenth. It panics on NaN and
+// infinities, which are not amounts.
+func NewAmount(value float64) Amount {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		panic(fmt.Sprintf("money: %g is not an amount", value))
+	}
+	amount, err := ParseAmount(strconv.FormatFloat(value, 'f', -1, 64))
+	if err != nil {
+		panic(err) // FormatFloat always produces a valid decimal
+	}
+	return amount
+}
+
+// ParseAmount parses a decimal string such as "0.0000166667", "-12", or
+// "1.5E-7" exactly. Exponents outside ±MaxExponent are rejected.
+func ParseAmount(s string) (Amount, error) {
+	text := strings.TrimSpace(s)
+
+	var exponent int64
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		var err error
+		if exponent, err = strconv.ParseInt(text[i+1:], 10, 32); err != nil {
+			return Amount{}, fmt.Errorf("invalid amount '%s'", s)
+		}
+		if exponent < -MaxExponent || exponent > MaxExponent {
+			return Amount{}, fmt.Errorf("invalid amount '%s': exponent must be between -%d and %d", s, MaxExponent, MaxExponent)
+		}
+		text = text[:i]
+	}
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	digits := whole + fraction
+	if digits == "" || digits == "-" || digits == "+" || strings.ContainsAny(digits[1:], "+-") {
+		return Amount{}, fmt.Errorf("invalid amount '%s'", s)
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Amount{}, fmt.Errorf("invalid amount '%s'", s)
+	}
+
+	scale := int64(len(fraction)) - exponent
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	if scale > math.MaxInt32 {
+		return Amount{}, fmt.Errorf("invalid amount '%s'", s)
+	}
+	return Amount{coef: coef, scale: int32(scale)}, nil
+}
+
+// Sum returns the exact sum of amounts
+func Sum(amounts ...Amount) Amount {
+	var total Amount
+	for _, amount := range amounts {
+		total = total.Add(amount)
+	}
+	return total
+}
+
+// Min returns the smaller of two amounts
+func Min(a, b Amount) Amount {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+// Max returns the larger of two amounts
+func Max(a, b Amount) Amount {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// RoundItems rounds line items to the given number of decimals so that they
+// add up to their exact sum rounded the same way. Each item is rounded half
+// away from zero, then the difference is made up one unit of the last decimal
+// at a time on the items rounding moved furthest (the largest remainder
+// method), so the total of a report always matches the sum of its rows.
+func RoundItems(items []Amount, decimals int) []Amount {
+	decimals = max(decimals, 0)
+	rounded := make([]Amount, len(items))
+	for i, item := range items {
+		rounded[i] = item.Round(decimals)
+	}
+
+	// Units of the last decimal the rounded items are short of the rounded sum
+	shortfall := Sum(items...).Round(decimals).Sub(Sum(rounded...))
+	steps := shortfall.Mul(Amount{coef: pow10(int64(decimals))}).Round(0).int().Int64()
+	if steps == 0 {
+		return rounded
+	}
+
+	// Order items by how much rounding took off them, most first
+	order := make([]int, len(items))
+	remainders := make([]Amount, len(items))
+	for i := range items {
+		order[i] = i
+		remainders[i] = items[i].Sub(rounded[i])
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].Cmp(remainders[order[j]]) > 0
+	})
+
+	unit := Amount{coef: big.NewInt(1), scale: int32(decimals)}
+	for step := int64(0); step < steps; step++ {
+		i := order[step]
+		rounded[i] = rounded[i].Add(unit)
+	}
+	for step := int64(0); step < -steps; step++ {
+		i := order[len(order)-1-int(step)]
+		rounded[i] = rounded[i].Sub(unit)
+	}
+	return rounded
+}
+
+// Add returns a + b
+func (a Amount) Add(b Amount) Amount {
+	x, y, scale := align(a, b)
+	return Amount{coef: new(big.Int).Add(x, y), scale: scale}
+}
+
+// Sub returns a - b
+func (a Amount) Sub(b Amount) Amount {
+	x, y, scale := align(a, b)
+	return Amount{coef: new(big.Int).Sub(x, y), scale: scale}
+}
+
+// Mul returns a × b
+func (a Amount) Mul(b Amount) Amount {
+	return Amount{coef: new(big.Int).Mul(a.int(), b.int()), scale: a.scale + b.scale}
+}
+
+// MulFloat returns a × value, using the shortest decimal representation of value
+func (a Amount) MulFloat(value float64) Amount {
+	return a.Mul(NewAmount(value))
+}
+
+// Div returns a ÷ b rounded half to even to DivisionDecimals decimals. It
+// panics when b is zero.
+func (a Amount) Div(b Amount) Amount {
+	if b.IsZero() {
+		panic("money: division by zero")
+	}
+
+	// a/b × 10^DivisionDecimals = a.coef × 10^(DivisionDecimals + b.scale - a.scale) / b.coef
+	numerator, denominator := new(big.Int).Set(a.int()), new(big.Int).Set(b.int())
+	if shift := int64(DivisionDecimals) + int64(b.scale) - int64(a.scale); shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	if c := twice.CmpAbs(denominator); c > 0 || (c == 0 && quotient.Bit(0) == 1) {
+		if numerator.Sign()*denominator.Sign() < 0 {
+			quotient.Sub(quotient, bigOne)
+		} else {
+			quotient.Add(quotient, bigOne)
+		}
+	}
+	return Amount{coef: quotient, scale: DivisionDecimals}
+}
+
+// DivFloat returns a ÷ value, using the shortest decimal representation of value
+func (a Amount) DivFloat(value float64) Amount {
+	return a.Div(NewAmount(value))
+}
+
+// Neg returns -a
+func (a Amount) Neg() Amount {
+	return Amount{coef: new(big.Int).Neg(a.int()), scale: a.scale}
+}
+
+// Abs returns |a|
+func (a Amount) Abs() Amount {
+	return Amount{coef: new(big.Int).Abs(a.int()), scale: a.scale}
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a
+func (a Amount) Sign() int {
+	return a.int().Sign()
+}
+
+// IsZero reports whether a is zero
+func (a Amount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Cmp compares a and b and returns -1, 0 or +1
+func (a Amount) Cmp(b Amount) int {
+	x, y, _ := align(a, b)
+	return x.Cmp(y)
+}
+
+// Equal reports whether a and b are the same amount, regardless of how many
+// decimals they carry
+func (a Amount) Equal(b Amount) bool {
+	return a.Cmp(b) == 0
+}
+
+// Round returns a rounded half away from zero to the given number of decimals
+func (a Amount) Round(decimals int) Amount {
+	decimals = max(decimals, 0)
+	if int64(a.scale) <= int64(decimals) {
+		return a
+	}
+
+	divisor := pow10(int64(a.scale) - int64(decimals))
+	quotient, remainder := new(big.Int).QuoRem(a.int(), divisor, new(big.Int))
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	if twice.Cmp(divisor) >= 0 {
+		if a.Sign() < 0 {
+			quotient.Sub(quotient, bigOne)
+		} else {
+			quotient.Add(quotient, bigOne)
+		}
+	}
+	return Amount{coef: quotient, scale: int32(decimals)}
+}
+
+// Float64 returns the nearest float to a, for ratios and percentages
+func (a Amount) Float64() float64 {
+	value, _ := strconv.ParseFloat(a.String(), 64)
+	return value
+}
+
+// String returns a as an exact decimal without trailing zeros, e.g. "2.3"
+func (a Amount) String() string {
+	text := a.StringFixed(int(a.scale))
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	}
+	return text
+}
+
+// StringFixed returns a rounded half away from zero to the given number of
+// decimals, with exactly that many decimals, e.g. "2.3000"
+func (a Amount) StringFixed(decimals int) string {
+	decimals = max(decimals, 0)
+	rounded := a.Round(decimals)
+
+	digits := new(big.Int).Abs(rounded.int()).String()
+	// Pad the digits to the requested decimals, which can exceed the amount's own
+	digits += strings.Repeat("0", decimals-int(rounded.scale))
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	sign := ""
+	if rounded.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+	return sign + digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+}
+
+// MarshalJSON encodes a as a JSON number rounded to JSONDecimals decimals
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.Round(JSONDecimals).String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string exactly
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	amount, err := ParseAmount(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// int returns the coefficient of a, treating nil as zero
+func (a Amount) int() *big.Int {
+	if a.coef == nil {
+		return new(big.Int)
+	}
+	return a.coef
+}
+
+// align returns the coefficients of a and b at their common scale
+func align(a, b Amount) (*big.Int, *big.Int, int32) {
+	x, y := a.int(), b.int()
+	switch {
+	case a.scale < b.scale:
+		x = new(big.Int).Mul(x, pow10(int64(b.scale-a.scale)))
+		return x, y, b.scale
+	case b.scale < a.scale:
+		y = new(big.Int).Mul(y, pow10(int64(a.scale-b.scale)))
+		return x, y, a.scale
+	default:
+		return x, y, a.scale
+	}
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
@@ -0,0 +1,206 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{input: "0.0000166667", expected: "0.0000166667"},
+		{input: "0.0960000000", expected: "0.096"},
+		{input: "-12", expected: "-12"},
+		{input: "+3.5", expected: "3.5"},
+		{input: ".25", expected: "0.25"},
+		{input: "1.5E-7", expected: "0.00000015"},
+		{input: "2e3", expected: "2000"},
+		{input: "", expectError: true},
+		{input: "-", expectError: true},
+		{input: "1.2.3", expectError: true},
+		{input: "1-2", expectError: true},
+		{input: "cheap", expectError: true},
+		{input: "1e", expectError: true},
+		{input: "1e30", expected: "1000000000000000000000000000000"},
+		{input: "1e-30", expected: "0.000000000000000000000000000001"},
+		{input: "1e31", expectError: true},
+		{input: "1e-31", expectError: true},
+		{input: "1e999999999", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			amount, err := ParseAmount(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error, got %s", amount)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if amount.String() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, amount)
+			}
+		})
+	}
+}
+
+func TestNewAmount(t *testing.T) {
+	if got := NewAmount(0.1).Add(NewAmount(0.2)); !got.Equal(NewAmount(0.3)) {
+		t.Errorf("expected 0.1 + 0.2 to be exactly 0.3, got %s", got)
+	}
+	if got := NewAmount(1e-20).String(); got != "0.00000000000000000001" {
+		t.Errorf("expected 1e-20 as a decimal, got %s", got)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	price := NewAmount(0.0000166667)
+
+	tests := []struct {
+		name     string
+		amount   Amount
+		expected string
+	}{
+		{name: "sum of a thousand prices is exact", amount: Sum(repeat(price, 1000)...), expected: "0.0166667"},
+		{name: "product is exact", amount: price.MulFloat(400000), expected: "6.66668"},
+		{name: "difference", amount: NewAmount(2.3).Sub(NewAmount(2.31)), expected: "-0.01"},
+		{name: "division rounds to sixteen decimals", amount: NewAmount(2.3).DivFloat(730), expected: "0.0031506849315068"},
+		{name: "division rounds half to even", amount: NewAmount(1).Div(NewAmount(8e16)), expected: "0"},
+		{name: "negative division rounds away from zero", amount: NewAmount(-2).DivFloat(3), expected: "-0.6666666666666667"},
+		{name: "negation", amount: NewAmount(5).Neg(), expected: "-5"},
+		{name: "absolute value", amount: NewAmount(-5).Abs(), expected: "5"},
+		{name: "minimum", amount: Min(NewAmount(1), NewAmount(0.5)), expected: "0.5"},
+		{name: "maximum", amount: Max(NewAmount(1), Amount{}), expected: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.amount.String() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, tt.amount)
+			}
+		})
+	}
+}
+
+func TestAmountComparison(t *testing.T) {
+	var zero Amount
+	if !zero.IsZero() || zero.Sign() != 0 || zero.String() != "0" {
+		t.Errorf("expected the zero value to be zero, got %s", zero)
+	}
+	if !NewAmount(2.5).Equal(NewAmount(2.50)) || !NewAmount(2.5).Equal(Amount{}.Add(NewAmount(2.5000))) {
+		t.Error("expected equal amounts to compare equal regardless of decimals")
+	}
+	if NewAmount(1).Cmp(NewAmount(2)) != -1 || NewAmount(-1).Sign() != -1 {
+		t.Error("unexpected comparison")
+	}
+	if NewAmount(0.096).Float64() != 0.096 {
+		t.Errorf("expected 0.096, got %g", NewAmount(0.096).Float64())
+	}
+}
+
+func TestAmountRound(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		decimals int
+		expected string
+	}{
+		{amount: 2.34565, decimals: 4, expected: "2.3457"},
+		{amount: -2.34565, decimals: 4, expected: "-2.3457"},
+		{amount: 2.5, decimals: 0, expected: "3"},
+		{amount: 0.00004, decimals: 4, expected: "0.0000"},
+		{amount: -0.00004, decimals: 4, expected: "0.0000"},
+		{amount: 12, decimals: 2, expected: "12.00"},
+		{amount: 0.5, decimals: 4, expected: "0.5000"},
+	}
+
+	for _, tt := range tests {
+		if got := NewAmount(tt.amount).StringFixed(tt.decimals); got != tt.expected {
+			t.Errorf("expected %g to %d decimals to be %s, got %s", tt.amount, tt.decimals, tt.expected, got)
+		}
+	}
+}
+
+func TestRoundItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []float64
+		decimals int
+		expected []string
+	}{
+		{
+			name:     "rounded items already add up",
+			items:    []float64{1.25, 2.5},
+			decimals: 1,
+			expected: []string{"1.3", "2.5"},
+		},
+		{
+			name:     "items rounded down are topped up, largest remainder first",
+			items:    []float64{0.33333, 0.33333, 0.33334},
+			decimals: 2,
+			expected: []string{"0.33", "0.33", "0.34"},
+		},
+		{
+			name:     "items rounded up are trimmed, largest rounding first",
+			items:    []float64{0.005, 0.004, 0.005},
+			decimals: 2,
+			expected: []string{"0.01", "0", "0"},
+		},
+		{
+			name:     "ties are trimmed from the last item",
+			items:    []float64{0.00005, 0.00005, 0.00005, 0.00005},
+			decimals: 4,
+			expected: []string{"0.0001", "0.0001", "0", "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]Amount, len(tt.items))
+			for i, item := range tt.items {
+				items[i] = NewAmount(item)
+			}
+
+			rounded := RoundItems(items, tt.decimals)
+			for i, expected := range tt.expected {
+				if rounded[i].String() != expected {
+					t.Errorf("expected item %d to be %s, got %s", i, expected, rounded[i])
+				}
+			}
+			if total := Sum(items...).Round(tt.decimals); !Sum(rounded...).Equal(total) {
+				t.Errorf("expected rounded items to add up to %s, got %s", total, Sum(rounded...))
+			}
+		})
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]Amount{"cost": NewAmount(2.3).DivFloat(730).MulFloat(730)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"cost":2.3}` {
+		t.Errorf("expected the cost rounded to %d decimals, got %s", JSONDecimals, data)
+	}
+
+	var decoded struct{ Cost Amount }
+	if err := json.Unmarshal([]byte(`{"Cost": 0.0000166667}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Cost.String() != "0.0000166667" {
+		t.Errorf("expected an exact amount, got %s", decoded.Cost)
+	}
+}
+
+func repeat(amount Amount, n int) []Amount {
+	amounts := make([]Amount, n)
+	for i := range amounts {
+		amounts[i] = amount
+	}
+	return amounts
+}
@@ -0,0 +1,67 @@
+// Package money converts costs between currencies using a local exchange rate
+// table and formats amounts for display.
+package money
+
+import "strings"
+
+// USD is the currency AWS publishes prices in, and the currency every
+// estimate is priced in before conversion
+const USD = "USD"
+
+var symbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+}
+
+// minorUnits is the number of decimals in each currency's smallest unit
+var minorUnits = map[string]int{
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+}
+
+// Symbol returns the symbol of a currency, e.g. "€" for EUR. Currencies
+// without a known symbol use their code followed by a space.
+func Symbol(currency string) string {
+	if currency == "" {
+		currency = USD
+	}
+	if symbol, exists := symbols[currency]; exists {
+		return symbol
+	}
+	return currency + " "
+}
+
+// Decimals returns the number of decimals costs are shown with in a
+// currency. Hourly usage is priced in fractions of the smallest unit, so
+// costs keep two more decimals than the currency has: four for dollars,
+// euros and pounds, and two for yen.
+func Decimals(currency string) int {
+	if currency == "" {
+		currency = USD
+	}
+	units, exists := minorUnits[currency]
+	if !exists {
+		units = 2
+	}
+	return units + 2
+}
+
+// Format formats an amount with the symbol and precision of a currency,
+// e.g. "€12.3400" or "-¥1840.50". Amounts are rounded half away from zero.
+func Format(amount Amount, currency string) string {
+	return FormatDecimals(amount, currency, Decimals(currency))
+}
+
+// FormatDecimals formats an amount with the symbol of a currency and the given
+// number of decimals
+func FormatDecimals(amount Amount, currency string, decimals int) string {
+	text := amount.StringFixed(decimals)
+	if digits, negative := strings.CutPrefix(text, "-"); negative {
+		return "-" + Symbol(currency) + digits
+	}
+	return Symbol(currency) + text
+}
@@ -0,0 +1,35 @@
+package money
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		expected string
+	}{
+		{name: "dollars", amount: 12.34, currency: "USD", expected: "$12.3400"},
+		{name: "default currency", amount: 1, currency: "", expected: "$1.0000"},
+		{name: "euros", amount: 0.0123456, currency: "EUR", expected: "€0.0123"},
+		{name: "pounds", amount: 5, currency: "GBP", expected: "£5.0000"},
+		{name: "yen keep two decimals", amount: 1840.505, currency: "JPY", expected: "¥1840.51"},
+		{name: "negative amount", amount: -3.5, currency: "EUR", expected: "-€3.5000"},
+		{name: "negative amount that rounds to zero", amount: -0.00001, currency: "USD", expected: "$0.0000"},
+		{name: "unknown currency", amount: 2, currency: "CHF", expected: "CHF 2.0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(NewAmount(tt.amount), tt.currency); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatDecimals(t *testing.T) {
+	if got := FormatDecimals(NewAmount(1234.5), "GBP", 2); got != "£1234.50" {
+		t.Errorf("expected £1234.50, got %q", got)
+	}
+}
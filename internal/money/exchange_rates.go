@@ -0,0 +1,90 @@
+package money
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"time"
+
+	"shylock/internal/errors"
+)
+
+// currencyCode matches ISO 4217 currency codes
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ExchangeRates is a table of exchange rates from US dollars, loaded from a
+// local rates file such as
+//
+//	{"base": "USD", "effectiveDate": "2026-10-01", "rates": {"EUR": 0.92, "GBP": 0.79}}
+type ExchangeRates struct {
+	Base          string             `json:"base"`
+	EffectiveDate string             `json:"effectiveDate"` // Date the rates were published, YYYY-MM-DD
+	Rates         map[string]float64 `json:"rates"`         // Units of each currency per US dollar
+	Source        string             `json:"-"`             // File the rates were loaded from
+}
+
+// LoadExchangeRates loads and validates an exchange rates file
+func LoadExchangeRates(path string) (*ExchangeRates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.FileErrorWithCause("failed to read exchange rates", err).
+			WithContext("exchangeRates", path)
+	}
+
+	var rates ExchangeRates
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, errors.FileErrorWithCause("failed to parse exchange rates", err).
+			WithContext("exchangeRates", path).
+			WithSuggestion(`Use the format {"base": "USD", "effectiveDate": "2026-10-01", "rates": {"EUR": 0.92}}`)
+	}
+	rates.Source = path
+
+	if rates.Base == "" {
+		rates.Base = USD
+	}
+	if rates.Base != USD {
+		return nil, errors.FileError("exchange rates must be based on USD").
+			WithContext("exchangeRates", path).
+			WithContext("base", rates.Base).
+			WithSuggestion("AWS publishes prices in USD, so give the rates as units of each currency per US dollar")
+	}
+
+	if _, err := time.Parse("2006-01-02", rates.EffectiveDate); err != nil {
+		return nil, errors.FileErrorWithCause("invalid exchange rates effective date", err).
+			WithContext("exchangeRates", path).
+			WithContext("effectiveDate", rates.EffectiveDate).
+			WithSuggestion("Record the date the rates were published as YYYY-MM-DD")
+	}
+
+	if len(rates.Rates) == 0 {
+		return nil, errors.FileError("exchange rates file contains no rates").
+			WithContext("exchangeRates", path)
+	}
+	for currency, rate := range rates.Rates {
+		if !currencyCode.MatchString(currency) || rate <= 0 {
+			return nil, errors.FileError("invalid exchange rate").
+				WithContext("exchangeRates", path).
+				WithContext("currency", currency).
+				WithContext("rate", rate).
+				WithSuggestion("Key rates by ISO 4217 currency code, e.g. EUR, with a positive rate")
+		}
+	}
+
+	return &rates, nil
+}
+
+// Rate returns the units of a currency per US dollar
+func (r *ExchangeRates) Rate(currency string) (float64, error) {
+	if currency == USD {
+		return 1, nil
+	}
+
+	rate, exists := r.Rates[currency]
+	if !exists {
+		return 0, errors.ValidationError("no exchange rate for currency").
+			WithContext("currency", currency).
+			WithContext("exchangeRates", r.Source).
+			WithSuggestion("Add a " + currency + " rate to the exchange rates file")
+	}
+	return rate, nil
+}
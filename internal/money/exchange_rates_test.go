@@ -0,0 +1,99 @@
+package money
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"shylock/internal/errors"
+)
+
+const testExchangeRates = `{"base": "USD", "effectiveDate": "2026-10-01", "rates": {"EUR": 0.92, "GBP": 0.79, "JPY": 149.5}}`
+
+func writeExchangeRates(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write exchange rates: %v", err)
+	}
+	return path
+}
+
+func TestLoadExchangeRates(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		missing     bool
+		expectError bool
+	}{
+		{name: "valid rates", content: testExchangeRates},
+		{name: "base defaults to USD", content: `{"effectiveDate": "2026-10-01", "rates": {"EUR": 0.92}}`},
+		{name: "missing file", missing: true, expectError: true},
+		{name: "invalid JSON", content: `{"rates": {`, expectError: true},
+		{name: "other base", content: `{"base": "EUR", "effectiveDate": "2026-10-01", "rates": {"USD": 1.09}}`, expectError: true},
+		{name: "missing effective date", content: `{"rates": {"EUR": 0.92}}`, expectError: true},
+		{name: "invalid effective date", content: `{"effectiveDate": "01/10/2026", "rates": {"EUR": 0.92}}`, expectError: true},
+		{name: "no rates", content: `{"effectiveDate": "2026-10-01", "rates": {}}`, expectError: true},
+		{name: "zero rate", content: `{"effectiveDate": "2026-10-01", "rates": {"EUR": 0}}`, expectError: true},
+		{name: "invalid currency code", content: `{"effectiveDate": "2026-10-01", "rates": {"euro": 0.92}}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "missing.json")
+			if !tt.missing {
+				path = writeExchangeRates(t, tt.content)
+			}
+
+			rates, err := LoadExchangeRates(path)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.FileErrorType) {
+					t.Errorf("expected file error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rates.Base != USD || rates.EffectiveDate != "2026-10-01" || rates.Source != path {
+				t.Errorf("unexpected rates %+v", rates)
+			}
+		})
+	}
+}
+
+func TestExchangeRatesRate(t *testing.T) {
+	rates, err := LoadExchangeRates(writeExchangeRates(t, testExchangeRates))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		currency    string
+		expected    float64
+		expectError bool
+	}{
+		{currency: "EUR", expected: 0.92},
+		{currency: "JPY", expected: 149.5},
+		{currency: "USD", expected: 1},
+		{currency: "CHF", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency, func(t *testing.T) {
+			rate, err := rates.Rate(tt.currency)
+			if tt.expectError {
+				if !errors.IsErrorType(err, errors.ValidationErrorType) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rate != tt.expected {
+				t.Errorf("expected rate %g, got %g", tt.expected, rate)
+			}
+		})
+	}
+}
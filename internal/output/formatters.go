@@ -11,6 +11,7 @@ import (
 
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Formatter defines the interface for output formatters
@@ -59,6 +60,8 @@ func (f *TableFormatter) FormatType() string {
 // Format formats the estimation result as a table
 func (f *TableFormatter) Format(result *models.EstimationResult) (string, error) {
 	options := DefaultFormatOptions()
+	options.Currency = result.Currency
+	options.Precision = money.Decimals(result.Currency)
 	return f.FormatWithOptions(result, options)
 }
 
@@ -70,14 +73,10 @@ func (f *TableFormatter) FormatWithOptions(result *models.EstimationResult, opti
 	output.WriteString("AWS Cost Estimation Results\n")
 	output.WriteString("===========================\n")
 	output.WriteString(fmt.Sprintf("Generated: %s\n", result.GeneratedAt.Format("2006-01-02 15:04:05 MST")))
-	output.WriteString(fmt.Sprintf("Currency: %s\n\n", result.Currency))
+	output.WriteString(fmt.Sprintf("Currency: %s\n\n", currencyLabel(result)))
 
 	// Summary section
-	output.WriteString("💰 Cost Summary\n")
-	output.WriteString("---------------\n")
-	output.WriteString(fmt.Sprintf("Hourly Cost:  $%.*f\n", options.Precision, result.TotalHourlyCost))
-	output.WriteString(fmt.Sprintf("Daily Cost:   $%.*f\n", options.Precision, result.TotalDailyCost))
-	output.WriteString(fmt.Sprintf("Monthly Cost: $%.*f\n\n", options.Precision, result.TotalMonthlyCost))
+	f.writeSummary(&output, result, options)
 
 	if len(result.ResourceCosts) == 0 {
 		output.WriteString("No resources found in estimation.\n")
@@ -102,16 +101,16 @@ func (f *TableFormatter) FormatWithOptions(result *models.EstimationResult, opti
 	maxNameWidth, maxTypeWidth, maxRegionWidth := f.calculateColumnWidths(sortedCosts)
 
 	// Print header
-	headerFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%12s %%12s %%12s\n",
+	headerFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%12s %%12s %%12s %%14s\n",
 		maxNameWidth, maxTypeWidth, maxRegionWidth)
-	output.WriteString(fmt.Sprintf(headerFormat, "Resource Name", "Type", "Region", "Hourly", "Daily", "Monthly"))
+	output.WriteString(fmt.Sprintf(headerFormat, "Resource Name", "Type", "Region", "Hourly", "Daily", "Monthly", "Annual"))
 
 	// Print separator
-	separator := strings.Repeat("-", maxNameWidth+maxTypeWidth+maxRegionWidth+36+6)
+	separator := strings.Repeat("-", maxNameWidth+maxTypeWidth+maxRegionWidth+50+7)
 	output.WriteString(separator + "\n")
 
 	// Print resource rows
-	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds $%%10.*f $%%10.*f $%%10.*f\n",
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%11s %%11s %%11s %%13s\n",
 		maxNameWidth, maxTypeWidth, maxRegionWidth)
 
 	for _, cost := range sortedCosts {
@@ -119,9 +118,10 @@ func (f *TableFormatter) FormatWithOptions(result *models.EstimationResult, opti
 			cost.ResourceName,
 			cost.ResourceType,
 			cost.Region,
-			options.Precision, cost.HourlyCost,
-			options.Precision, cost.DailyCost,
-			options.Precision, cost.MonthlyCost))
+			formatAmount(cost.HourlyCost, result.Currency, options),
+			formatAmount(cost.DailyCost, result.Currency, options),
+			formatAmount(cost.MonthlyCost, result.Currency, options),
+			formatAmount(cost.AnnualCost, result.Currency, options)))
 	}
 
 	// Show detailed information if verbose
@@ -140,14 +140,10 @@ func (f *TableFormatter) formatGrouped(result *models.EstimationResult, costs []
 	output.WriteString("AWS Cost Estimation Results\n")
 	output.WriteString("===========================\n")
 	output.WriteString(fmt.Sprintf("Generated: %s\n", result.GeneratedAt.Format("2006-01-02 15:04:05 MST")))
-	output.WriteString(fmt.Sprintf("Currency: %s\n\n", result.Currency))
+	output.WriteString(fmt.Sprintf("Currency: %s\n\n", currencyLabel(result)))
 
 	// Summary section
-	output.WriteString("💰 Cost Summary\n")
-	output.WriteString("---------------\n")
-	output.WriteString(fmt.Sprintf("Hourly Cost:  $%.*f\n", options.Precision, result.TotalHourlyCost))
-	output.WriteString(fmt.Sprintf("Daily Cost:   $%.*f\n", options.Precision, result.TotalDailyCost))
-	output.WriteString(fmt.Sprintf("Monthly Cost: $%.*f\n\n", options.Precision, result.TotalMonthlyCost))
+	f.writeSummary(&output, result, options)
 
 	// Group resources
 	groups := f.groupResources(costs, options.GroupBy)
@@ -155,18 +151,19 @@ func (f *TableFormatter) formatGrouped(result *models.EstimationResult, costs []
 	output.WriteString("📊 Resource Breakdown (Grouped)\n")
 	output.WriteString("-------------------------------\n")
 
+	timeFrame := result.EmphasizedTimeFrame()
 	for groupName, groupCosts := range groups {
 		// Group header
-		groupTotal := f.calculateGroupTotal(groupCosts)
-		output.WriteString(fmt.Sprintf("\n🏷️  %s (%d resources) - Monthly: $%.*f\n",
-			groupName, len(groupCosts), options.Precision, groupTotal))
+		groupTotal := f.calculateGroupTotal(groupCosts, timeFrame)
+		output.WriteString(fmt.Sprintf("\n🏷️  %s (%d resources) - %s: %s\n",
+			groupName, len(groupCosts), timeFrameLabel(timeFrame), formatAmount(groupTotal, result.Currency, options)))
 		output.WriteString(strings.Repeat("-", 50) + "\n")
 
 		// Calculate column widths for this group
 		maxNameWidth, maxTypeWidth, maxRegionWidth := f.calculateColumnWidths(groupCosts)
 
 		// Print group resources
-		rowFormat := fmt.Sprintf("  %%-%ds %%-%ds %%-%ds $%%8.*f $%%8.*f $%%8.*f\n",
+		rowFormat := fmt.Sprintf("  %%-%ds %%-%ds %%-%ds %%9s %%9s %%9s\n",
 			maxNameWidth-2, maxTypeWidth, maxRegionWidth)
 
 		for _, cost := range groupCosts {
@@ -174,9 +171,9 @@ func (f *TableFormatter) formatGrouped(result *models.EstimationResult, costs []
 				cost.ResourceName,
 				cost.ResourceType,
 				cost.Region,
-				options.Precision, cost.HourlyCost,
-				options.Precision, cost.DailyCost,
-				options.Precision, cost.MonthlyCost))
+				formatAmount(cost.HourlyCost, result.Currency, options),
+				formatAmount(cost.DailyCost, result.Currency, options),
+				formatAmount(cost.MonthlyCost, result.Currency, options)))
 		}
 	}
 
@@ -253,7 +250,7 @@ func (f *TableFormatter) sortResources(costs []models.CostEstimate, sortBy strin
 		})
 	case "cost":
 		sort.Slice(costs, func(i, j int) bool {
-			return costs[i].MonthlyCost > costs[j].MonthlyCost // Descending by cost
+			return costs[i].MonthlyCost.Cmp(costs[j].MonthlyCost) > 0 // Descending by cost
 		})
 	case "region":
 		sort.Slice(costs, func(i, j int) bool {
@@ -292,14 +289,71 @@ func (f *TableFormatter) groupResources(costs []models.CostEstimate, groupBy str
 	return groups
 }
 
-func (f *TableFormatter) calculateGroupTotal(costs []models.CostEstimate) float64 {
-	total := 0.0
+func (f *TableFormatter) calculateGroupTotal(costs []models.CostEstimate, timeFrame string) money.Amount {
+	var total money.Amount
 	for _, cost := range costs {
-		total += cost.MonthlyCost
+		total = total.Add(cost.Cost(timeFrame))
 	}
 	return total
 }
 
+// writeSummary writes the total for every time frame, marking the one the
+// result emphasizes
+func (f *TableFormatter) writeSummary(output *strings.Builder, result *models.EstimationResult, options *FormatOptions) {
+	output.WriteString("💰 Cost Summary\n")
+	output.WriteString("---------------\n")
+
+	emphasized := result.EmphasizedTimeFrame()
+	for _, timeFrame := range models.TimeFrames {
+		output.WriteString(fmt.Sprintf("%-13s %s", timeFrameLabel(timeFrame)+" Cost:", formatAmount(result.TotalCost(timeFrame), result.Currency, options)))
+		if timeFrame == models.TimeFrameMonthly && result.Month != "" {
+			output.WriteString(fmt.Sprintf(" (%s, %.0f hours)", result.Month, result.HoursPerMonth))
+		}
+		if timeFrame == emphasized {
+			output.WriteString("  ◀")
+		}
+		output.WriteString("\n")
+	}
+	output.WriteString("\n")
+}
+
+// formatAmount formats an amount in the result currency with the precision of the options
+func formatAmount(amount money.Amount, currency string, options *FormatOptions) string {
+	return money.FormatDecimals(amount, currency, options.Precision)
+}
+
+// RoundColumns rounds the hourly, daily, monthly and annual costs of resources
+// to a number of decimals, one column per time frame in the order of
+// models.TimeFrames. Each column is rounded with money.RoundItems, so the
+// rounded costs of a report add up to its rounded totals.
+func RoundColumns(costs []models.CostEstimate, decimals int) [][]money.Amount {
+	columns := make([][]money.Amount, len(models.TimeFrames))
+	for i, timeFrame := range models.TimeFrames {
+		amounts := make([]money.Amount, len(costs))
+		for j := range costs {
+			amounts[j] = costs[j].Cost(timeFrame)
+		}
+		columns[i] = money.RoundItems(amounts, decimals)
+	}
+	return columns
+}
+
+// currencyLabel returns the currency of a result, with the exchange rate it
+// was converted at if any and a note that details and assumptions stay in USD
+func currencyLabel(result *models.EstimationResult) string {
+	rate := result.ExchangeRate
+	if rate == nil {
+		return result.Currency
+	}
+	return fmt.Sprintf("%s at %.4f per %s, rates effective %s; details and assumptions quote AWS list prices in %s",
+		rate.To, rate.Rate, rate.From, rate.EffectiveDate, rate.From)
+}
+
+// timeFrameLabel returns the label of a time frame, e.g. "Monthly"
+func timeFrameLabel(timeFrame string) string {
+	return strings.ToUpper(timeFrame[:1]) + timeFrame[1:]
+}
+
 func (f *TableFormatter) isImportantDetail(key string) bool {
 	importantKeys := map[string]bool{
 		"instanceType":     true,
@@ -385,6 +439,7 @@ func (f *CSVFormatter) FormatType() string {
 func (f *CSVFormatter) Format(result *models.EstimationResult) (string, error) {
 	var output strings.Builder
 	writer := csv.NewWriter(&output)
+	decimals := money.Decimals(result.Currency)
 
 	// Write header
 	header := []string{
@@ -394,6 +449,7 @@ func (f *CSVFormatter) Format(result *models.EstimationResult) (string, error) {
 		"Hourly Cost",
 		"Daily Cost",
 		"Monthly Cost",
+		"Annual Cost",
 		"Currency",
 		"Generated At",
 	}
@@ -401,18 +457,14 @@ func (f *CSVFormatter) Format(result *models.EstimationResult) (string, error) {
 		return "", fmt.Errorf("failed to write CSV header: %w", err)
 	}
 
-	// Write resource rows
-	for _, cost := range result.ResourceCosts {
-		row := []string{
-			cost.ResourceName,
-			cost.ResourceType,
-			cost.Region,
-			fmt.Sprintf("%.4f", cost.HourlyCost),
-			fmt.Sprintf("%.4f", cost.DailyCost),
-			fmt.Sprintf("%.4f", cost.MonthlyCost),
-			cost.Currency,
-			cost.Timestamp.Format(time.RFC3339),
+	// Write resource rows, rounded so that each column adds up to its total
+	columns := RoundColumns(result.ResourceCosts, decimals)
+	for i, cost := range result.ResourceCosts {
+		row := []string{cost.ResourceName, cost.ResourceType, cost.Region}
+		for _, column := range columns {
+			row = append(row, column[i].StringFixed(decimals))
 		}
+		row = append(row, cost.Currency, cost.Timestamp.Format(time.RFC3339))
 		if err := writer.Write(row); err != nil {
 			return "", fmt.Errorf("failed to write CSV row: %w", err)
 		}
@@ -423,9 +475,10 @@ func (f *CSVFormatter) Format(result *models.EstimationResult) (string, error) {
 		"TOTAL",
 		"",
 		"",
-		fmt.Sprintf("%.4f", result.TotalHourlyCost),
-		fmt.Sprintf("%.4f", result.TotalDailyCost),
-		fmt.Sprintf("%.4f", result.TotalMonthlyCost),
+		result.TotalHourlyCost.StringFixed(decimals),
+		result.TotalDailyCost.StringFixed(decimals),
+		result.TotalMonthlyCost.StringFixed(decimals),
+		result.TotalAnnualCost.StringFixed(decimals),
 		result.Currency,
 		result.GeneratedAt.Format(time.RFC3339),
 	}
@@ -460,10 +513,22 @@ func (f *YAMLFormatter) Format(result *models.EstimationResult) (string, error)
 	var output strings.Builder
 
 	output.WriteString("estimationResult:\n")
-	output.WriteString(fmt.Sprintf("  totalHourlyCost: %.4f\n", result.TotalHourlyCost))
-	output.WriteString(fmt.Sprintf("  totalDailyCost: %.4f\n", result.TotalDailyCost))
-	output.WriteString(fmt.Sprintf("  totalMonthlyCost: %.4f\n", result.TotalMonthlyCost))
+	output.WriteString(fmt.Sprintf("  totalHourlyCost: %s\n", result.TotalHourlyCost.StringFixed(4)))
+	output.WriteString(fmt.Sprintf("  totalDailyCost: %s\n", result.TotalDailyCost.StringFixed(4)))
+	output.WriteString(fmt.Sprintf("  totalMonthlyCost: %s\n", result.TotalMonthlyCost.StringFixed(4)))
+	output.WriteString(fmt.Sprintf("  totalAnnualCost: %s\n", result.TotalAnnualCost.StringFixed(4)))
+	output.WriteString(fmt.Sprintf("  timeFrame: %s\n", result.EmphasizedTimeFrame()))
+	if result.Month != "" {
+		output.WriteString(fmt.Sprintf("  month: %s\n", result.Month))
+	}
 	output.WriteString(fmt.Sprintf("  currency: %s\n", result.Currency))
+	if rate := result.ExchangeRate; rate != nil {
+		output.WriteString("  exchangeRate:\n")
+		output.WriteString(fmt.Sprintf("    from: %s\n", rate.From))
+		output.WriteString(fmt.Sprintf("    to: %s\n", rate.To))
+		output.WriteString(fmt.Sprintf("    rate: %g\n", rate.Rate))
+		output.WriteString(fmt.Sprintf("    effectiveDate: %s\n", rate.EffectiveDate))
+	}
 	output.WriteString(fmt.Sprintf("  generatedAt: %s\n", result.GeneratedAt.Format(time.RFC3339)))
 	output.WriteString("  resourceCosts:\n")
 
@@ -471,9 +536,10 @@ func (f *YAMLFormatter) Format(result *models.EstimationResult) (string, error)
 		output.WriteString(fmt.Sprintf("    - resourceName: %s\n", cost.ResourceName))
 		output.WriteString(fmt.Sprintf("      resourceType: %s\n", cost.ResourceType))
 		output.WriteString(fmt.Sprintf("      region: %s\n", cost.Region))
-		output.WriteString(fmt.Sprintf("      hourlyCost: %.4f\n", cost.HourlyCost))
-		output.WriteString(fmt.Sprintf("      dailyCost: %.4f\n", cost.DailyCost))
-		output.WriteString(fmt.Sprintf("      monthlyCost: %.4f\n", cost.MonthlyCost))
+		output.WriteString(fmt.Sprintf("      hourlyCost: %s\n", cost.HourlyCost.StringFixed(4)))
+		output.WriteString(fmt.Sprintf("      dailyCost: %s\n", cost.DailyCost.StringFixed(4)))
+		output.WriteString(fmt.Sprintf("      monthlyCost: %s\n", cost.MonthlyCost.StringFixed(4)))
+		output.WriteString(fmt.Sprintf("      annualCost: %s\n", cost.AnnualCost.StringFixed(4)))
 		output.WriteString(fmt.Sprintf("      currency: %s\n", cost.Currency))
 		output.WriteString(fmt.Sprintf("      timestamp: %s\n", cost.Timestamp.Format(time.RFC3339)))
 
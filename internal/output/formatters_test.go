@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // Helper function to create test estimation result
@@ -15,9 +16,9 @@ func createTestEstimationResult() *models.EstimationResult {
 	timestamp := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 
 	return &models.EstimationResult{
-		TotalHourlyCost:  1.5,
-		TotalDailyCost:   36.0,
-		TotalMonthlyCost: 1080.0,
+		TotalHourlyCost:  money.NewAmount(1.5),
+		TotalDailyCost:   money.NewAmount(36.0),
+		TotalMonthlyCost: money.NewAmount(1080.0),
 		Currency:         "USD",
 		GeneratedAt:      timestamp,
 		ResourceCosts: []models.CostEstimate{
@@ -25,9 +26,9 @@ func createTestEstimationResult() *models.EstimationResult {
 				ResourceName: "web-server",
 				ResourceType: "EC2",
 				Region:       "us-east-1",
-				HourlyCost:   0.5,
-				DailyCost:    12.0,
-				MonthlyCost:  360.0,
+				HourlyCost:   money.NewAmount(0.5),
+				DailyCost:    money.NewAmount(12.0),
+				MonthlyCost:  money.NewAmount(360.0),
 				Currency:     "USD",
 				Timestamp:    timestamp,
 				Assumptions:  []string{"24/7 usage assumed", "On-demand pricing"},
@@ -40,9 +41,9 @@ func createTestEstimationResult() *models.EstimationResult {
 				ResourceName: "database",
 				ResourceType: "RDS",
 				Region:       "us-east-1",
-				HourlyCost:   1.0,
-				DailyCost:    24.0,
-				MonthlyCost:  720.0,
+				HourlyCost:   money.NewAmount(1.0),
+				DailyCost:    money.NewAmount(24.0),
+				MonthlyCost:  money.NewAmount(720.0),
 				Currency:     "USD",
 				Timestamp:    timestamp,
 				Assumptions:  []string{"Single AZ deployment", "GP2 storage"},
@@ -139,6 +140,32 @@ func TestTableFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestTableFormatter_FormatCurrency(t *testing.T) {
+	formatter := &TableFormatter{}
+	result := createTestEstimationResult()
+	result.Currency = "JPY"
+	result.ExchangeRate = &models.ExchangeRate{From: "USD", To: "JPY", Rate: 150, EffectiveDate: "2026-10-01"}
+
+	output, err := formatter.Format(result)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedContent := []string{
+		"Currency: JPY at 150.0000 per USD, rates effective 2026-10-01; details and assumptions quote AWS list prices in USD",
+		"¥1.50",    // Total hourly cost, with yen precision
+		"¥1080.00", // Total monthly cost
+	}
+	for _, content := range expectedContent {
+		if !strings.Contains(output, content) {
+			t.Errorf("Expected output to contain '%s', but it didn't", content)
+		}
+	}
+	if strings.Contains(output, "$") {
+		t.Errorf("Expected no dollar amounts, got:\n%s", output)
+	}
+}
+
 func TestTableFormatter_FormatWithOptions(t *testing.T) {
 	formatter := &TableFormatter{}
 	result := createTestEstimationResult()
@@ -247,9 +274,9 @@ func TestTableFormatter_FormatWithOptions(t *testing.T) {
 func TestTableFormatter_EmptyResult(t *testing.T) {
 	formatter := &TableFormatter{}
 	result := &models.EstimationResult{
-		TotalHourlyCost:  0,
-		TotalDailyCost:   0,
-		TotalMonthlyCost: 0,
+		TotalHourlyCost:  money.Amount{},
+		TotalDailyCost:   money.Amount{},
+		TotalMonthlyCost: money.Amount{},
 		Currency:         "USD",
 		GeneratedAt:      time.Now(),
 		ResourceCosts:    []models.CostEstimate{},
@@ -296,8 +323,8 @@ func TestJSONFormatter_Format(t *testing.T) {
 	}
 
 	// Verify content
-	if parsed.TotalHourlyCost != result.TotalHourlyCost {
-		t.Errorf("Expected TotalHourlyCost %f, got %f", result.TotalHourlyCost, parsed.TotalHourlyCost)
+	if !parsed.TotalHourlyCost.Equal(result.TotalHourlyCost) {
+		t.Errorf("Expected TotalHourlyCost %s, got %s", result.TotalHourlyCost, parsed.TotalHourlyCost)
 	}
 
 	if len(parsed.ResourceCosts) != len(result.ResourceCosts) {
@@ -337,7 +364,7 @@ func TestCSVFormatter_Format(t *testing.T) {
 	}
 
 	// Check header
-	expectedHeader := "Resource Name,Resource Type,Region,Hourly Cost,Daily Cost,Monthly Cost,Currency,Generated At"
+	expectedHeader := "Resource Name,Resource Type,Region,Hourly Cost,Daily Cost,Monthly Cost,Annual Cost,Currency,Generated At"
 	if lines[0] != expectedHeader {
 		t.Errorf("Expected header '%s', got '%s'", expectedHeader, lines[0])
 	}
@@ -357,6 +384,38 @@ func TestCSVFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestRoundColumns(t *testing.T) {
+	costs := make([]models.CostEstimate, 3)
+	for i, hourly := range []float64{0.33333, 0.33333, 0.33334} {
+		costs[i] = models.CostEstimate{HourlyCost: money.NewAmount(hourly)}
+		costs[i].CalculateCosts()
+	}
+
+	columns := RoundColumns(costs, 4)
+	if len(columns) != len(models.TimeFrames) {
+		t.Fatalf("Expected %d columns, got %d", len(models.TimeFrames), len(columns))
+	}
+
+	// Each hourly cost rounds down to 0.3333 on its own, the total to 1.0000, so
+	// the cost rounding took most off makes up the difference
+	expectedHourly := []string{"0.3333", "0.3333", "0.3334"}
+	for i, expected := range expectedHourly {
+		if got := columns[0][i].StringFixed(4); got != expected {
+			t.Errorf("Expected hourly cost %d to be %s, got %s", i, expected, got)
+		}
+	}
+
+	for i, timeFrame := range models.TimeFrames {
+		var total money.Amount
+		for _, cost := range costs {
+			total = total.Add(cost.Cost(timeFrame))
+		}
+		if sum := money.Sum(columns[i]...); !sum.Equal(total.Round(4)) {
+			t.Errorf("Expected %s costs to add up to %s, got %s", timeFrame, total.StringFixed(4), sum.StringFixed(4))
+		}
+	}
+}
+
 func TestYAMLFormatter_FormatType(t *testing.T) {
 	formatter := NewYAMLFormatter()
 
@@ -580,9 +639,9 @@ func TestTableFormatter_SortResources(t *testing.T) {
 	formatter := &TableFormatter{}
 
 	costs := []models.CostEstimate{
-		{ResourceName: "zebra", ResourceType: "EC2", Region: "us-west-2", MonthlyCost: 100},
-		{ResourceName: "alpha", ResourceType: "RDS", Region: "us-east-1", MonthlyCost: 200},
-		{ResourceName: "beta", ResourceType: "EC2", Region: "us-east-1", MonthlyCost: 50},
+		{ResourceName: "zebra", ResourceType: "EC2", Region: "us-west-2", MonthlyCost: money.NewAmount(100)},
+		{ResourceName: "alpha", ResourceType: "RDS", Region: "us-east-1", MonthlyCost: money.NewAmount(200)},
+		{ResourceName: "beta", ResourceType: "EC2", Region: "us-east-1", MonthlyCost: money.NewAmount(50)},
 	}
 
 	tests := []struct {
@@ -726,16 +785,16 @@ func TestTableFormatter_CalculateGroupTotal(t *testing.T) {
 	formatter := &TableFormatter{}
 
 	costs := []models.CostEstimate{
-		{MonthlyCost: 100.0},
-		{MonthlyCost: 200.0},
-		{MonthlyCost: 50.0},
+		{MonthlyCost: money.NewAmount(100.0)},
+		{MonthlyCost: money.NewAmount(200.0)},
+		{MonthlyCost: money.NewAmount(50.0)},
 	}
 
-	total := formatter.calculateGroupTotal(costs)
-	expected := 350.0
+	total := formatter.calculateGroupTotal(costs, models.TimeFrameMonthly)
+	expected := money.NewAmount(350)
 
-	if total != expected {
-		t.Errorf("Expected total %f, got %f", expected, total)
+	if !total.Equal(expected) {
+		t.Errorf("Expected total %s, got %s", expected, total)
 	}
 }
 
@@ -808,9 +867,9 @@ func TestFormatterFactory_GetSupportedFormats(t *testing.T) {
 func TestCSVFormatter_EmptyResult(t *testing.T) {
 	formatter := &CSVFormatter{}
 	result := &models.EstimationResult{
-		TotalHourlyCost:  0,
-		TotalDailyCost:   0,
-		TotalMonthlyCost: 0,
+		TotalHourlyCost:  money.Amount{},
+		TotalDailyCost:   money.Amount{},
+		TotalMonthlyCost: money.Amount{},
 		Currency:         "USD",
 		GeneratedAt:      time.Now(),
 		ResourceCosts:    []models.CostEstimate{},
@@ -836,9 +895,9 @@ func TestCSVFormatter_EmptyResult(t *testing.T) {
 func TestYAMLFormatter_EmptyResult(t *testing.T) {
 	formatter := &YAMLFormatter{}
 	result := &models.EstimationResult{
-		TotalHourlyCost:  0,
-		TotalDailyCost:   0,
-		TotalMonthlyCost: 0,
+		TotalHourlyCost:  money.Amount{},
+		TotalDailyCost:   money.Amount{},
+		TotalMonthlyCost: money.Amount{},
 		Currency:         "USD",
 		GeneratedAt:      time.Now(),
 		ResourceCosts:    []models.CostEstimate{},
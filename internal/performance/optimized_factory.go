@@ -11,6 +11,7 @@ import (
 	"shylock/internal/estimators"
 	"shylock/internal/interfaces"
 	"shylock/internal/models"
+	"shylock/internal/money"
 )
 
 // OptimizedFactory provides performance-enhanced cost estimation
@@ -97,15 +98,10 @@ func (f *OptimizedFactory) EstimateFromConfigOptimized(ctx context.Context, conf
 // estimateConcurrently processes resources concurrently with batching
 func (f *OptimizedFactory) estimateConcurrently(ctx context.Context, config *models.EstimationConfig) (*models.EstimationResult, error) {
 	result := &models.EstimationResult{
-		Currency:    "USD",
+		Currency:    money.USD, // Converted by ApplyCurrency once totals are known
 		GeneratedAt: time.Now(),
 	}
 
-	// Apply configuration options
-	if config.Options.Currency != "" {
-		result.Currency = config.Options.Currency
-	}
-
 	// Process resources in batches if batching is enabled
 	if f.enableBatching && len(config.Resources) > f.batchSize {
 		return f.estimateInBatches(ctx, config, result)
@@ -114,45 +110,26 @@ func (f *OptimizedFactory) estimateConcurrently(ctx context.Context, config *mod
 	// Process all resources concurrently
 	estimates, errs := f.processResourcesConcurrently(ctx, config.Resources)
 
-	// Collect successful estimates and handle errors
-	var estimationErrors []error
-	var totalHourly, totalDaily, totalMonthly float64
-
-	for i, estimate := range estimates {
-		if errs[i] != nil {
-			estimationErrors = append(estimationErrors, errs[i])
-			continue
-		}
-
+	// Collect successful estimates, failing if any resource could not be estimated
+	if err := estimators.FailedResourcesError(config.Resources, errs); err != nil {
+		return nil, err
+	}
+	for _, estimate := range estimates {
 		if estimate != nil {
 			result.ResourceCosts = append(result.ResourceCosts, *estimate)
-			totalHourly += estimate.HourlyCost
-			totalDaily += estimate.DailyCost
-			totalMonthly += estimate.MonthlyCost
 		}
 	}
 
-	// Check if we have any successful estimates
-	if len(result.ResourceCosts) == 0 {
-		if len(estimationErrors) > 0 {
-			return nil, estimationErrors[0]
-		}
-		return nil, errors.ValidationError("no resources could be estimated").
-			WithSuggestion("Check that all resources have valid configurations")
+	if err := estimators.Finalize(config.Options, f.ExchangeRates(), result); err != nil {
+		return nil, err
 	}
 
-	// Set totals
-	result.TotalHourlyCost = totalHourly
-	result.TotalDailyCost = totalDaily
-	result.TotalMonthlyCost = totalMonthly
-
 	return result, nil
 }
 
 // estimateInBatches processes resources in batches to control memory usage
 func (f *OptimizedFactory) estimateInBatches(ctx context.Context, config *models.EstimationConfig, result *models.EstimationResult) (*models.EstimationResult, error) {
 	var allEstimates []models.CostEstimate
-	var totalHourly, totalDaily, totalMonthly float64
 	var estimationErrors []error
 
 	// Process resources in batches
@@ -166,35 +143,24 @@ func (f *OptimizedFactory) estimateInBatches(ctx context.Context, config *models
 		estimates, errs := f.processResourcesConcurrently(ctx, batch)
 
 		// Collect results from this batch
-		for j, estimate := range estimates {
-			if errs[j] != nil {
-				estimationErrors = append(estimationErrors, errs[j])
-				continue
-			}
-
+		estimationErrors = append(estimationErrors, errs...)
+		for _, estimate := range estimates {
 			if estimate != nil {
 				allEstimates = append(allEstimates, *estimate)
-				totalHourly += estimate.HourlyCost
-				totalDaily += estimate.DailyCost
-				totalMonthly += estimate.MonthlyCost
 			}
 		}
 	}
 
-	// Check if we have any successful estimates
-	if len(allEstimates) == 0 {
-		if len(estimationErrors) > 0 {
-			return nil, estimationErrors[0]
-		}
-		return nil, errors.ValidationError("no resources could be estimated").
-			WithSuggestion("Check that all resources have valid configurations")
+	// Fail if any resource could not be estimated
+	if err := estimators.FailedResourcesError(config.Resources, estimationErrors); err != nil {
+		return nil, err
 	}
 
 	// Set results
 	result.ResourceCosts = allEstimates
-	result.TotalHourlyCost = totalHourly
-	result.TotalDailyCost = totalDaily
-	result.TotalMonthlyCost = totalMonthly
+	if err := estimators.Finalize(config.Options, f.ExchangeRates(), result); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
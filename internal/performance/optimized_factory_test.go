@@ -2,6 +2,7 @@ package performance
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -461,6 +462,38 @@ func TestOptimizedFactory_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestOptimizedFactory_PartialFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OptimizedFactoryConfig
+	}{
+		{name: "concurrent", config: &OptimizedFactoryConfig{MaxConcurrency: 4, BatchSize: 10}},
+		{name: "batched", config: &OptimizedFactoryConfig{MaxConcurrency: 2, EnableBatching: true, BatchSize: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := NewOptimizedFactory(&MockAWSClient{products: createMockProducts()}, tt.config)
+
+			// A resource that cannot be priced must fail the estimate rather
+			// than be left out of the totals
+			testConfig := createTestConfig()
+			testConfig.Resources = append(testConfig.Resources, models.ResourceSpec{
+				Type: "Redshift", Name: "warehouse", Region: "us-east-1",
+				Properties: map[string]interface{}{"nodeType": "ra3.xlplus"},
+			})
+
+			result, err := factory.EstimateFromConfigOptimized(context.Background(), testConfig)
+			if err == nil {
+				t.Fatalf("expected error, got result with %d resources", len(result.ResourceCosts))
+			}
+			if !strings.Contains(err.Error(), "warehouse") {
+				t.Errorf("expected error to name the failed resource, got %v", err)
+			}
+		})
+	}
+}
+
 func TestOptimizedFactory_EmptyConfig(t *testing.T) {
 	mockClient := &MockAWSClient{products: createMockProducts()}
 	factory := NewOptimizedFactory(mockClient, nil)